@@ -36,9 +36,25 @@ func main() {
 	pickRepo := repository.NewPickRepository(db)
 	queueRepo := repository.NewQueueRepository(db)
 	auditRepo := repository.NewAuditRepository(db)
+	projectionRepo := repository.NewProjectionRepository(db)
+	rosterRepo := repository.NewRosterRepository(db)
+	scoringRepo := repository.NewScoringRepository(db)
+	reportRepo := repository.NewReportRepository(db)
+	runRepo := repository.NewRunSettingsRepository(db)
+	rankingRepo := repository.NewRankingRepository(db)
+	tierRepo := repository.NewTierRepository(db)
+	rankSourceRepo := repository.NewRankingSourceRepository(db)
+	seasonRepo := repository.NewSeasonRepository(db)
+	noteRepo := repository.NewNoteRepository(db)
+	mergeRepo := repository.NewMergeRepository(db)
+	nflTeamRepo := repository.NewNFLTeamRepository(db)
+	templateRepo := repository.NewTemplateRepository(db)
+	leagueRepo := repository.NewLeagueRepository(db)
+	platformRepo := repository.NewPlatformRepository(db)
 
 	// Initialize handlers
-	h := handlers.NewHandler(draftRepo, teamRepo, playerRepo, pickRepo, queueRepo, auditRepo)
+	h := handlers.NewHandler(draftRepo, teamRepo, playerRepo, pickRepo, queueRepo, auditRepo,
+		projectionRepo, rosterRepo, scoringRepo, reportRepo, runRepo, rankingRepo, tierRepo, rankSourceRepo, seasonRepo, noteRepo, mergeRepo, nflTeamRepo, templateRepo, leagueRepo, platformRepo)
 
 	// Setup router
 	r := chi.NewRouter()
@@ -55,8 +71,14 @@ func main() {
 	r.Get("/", h.Home)
 	r.Get("/draft/new", h.NewDraft)
 	r.Post("/draft/create", h.CreateDraft)
+	r.Post("/draft/create/template", h.CreateDraftFromTemplate)
+	r.Post("/draft/clone", h.CloneDraft)
+	r.Post("/draft/import", h.ReviewDraftImport)
+	r.Post("/draft/import/confirm", h.ConfirmDraftImport)
+	r.Post("/templates/{templateId}/delete", h.DeleteDraftTemplate)
 	r.Get("/draft/{id}/setup", h.DraftSetup)
 	r.Post("/draft/{id}/update", h.UpdateDraft)
+	r.Post("/draft/{id}/template", h.SaveDraftTemplate)
 	r.Post("/draft/{id}/start", h.StartDraft)
 	r.Post("/draft/{id}/pause", h.PauseDraft)
 	r.Post("/draft/{id}/resume", h.ResumeDraft)
@@ -64,8 +86,13 @@ func main() {
 	r.Delete("/draft/{id}", h.DeleteDraft)
 	r.Get("/draft/{id}", h.GetDraftBoard)
 	r.Get("/draft/{id}/big-board", h.GetBigBoard)
+	r.Get("/draft/{id}/big-board.svg", h.GetBigBoardSVG)
+	r.Get("/draft/{id}/big-board.png", h.GetBigBoardPNG)
 	r.Get("/draft/{id}/players", h.GetAvailablePlayers)
 	r.Get("/draft/{id}/players/search", h.SearchPlayersJSON)
+	r.Get("/draft/{id}/players/{playerId}/note", h.GetPlayerNote)
+	r.Post("/draft/{id}/players/{playerId}/note", h.SavePlayerNote)
+	r.Get("/draft/{id}/notes", h.GetDraftNotes)
 	r.Post("/draft/{id}/pick", h.MakePick)
 	r.Post("/draft/{id}/undo", h.UndoPick)
 	r.Post("/draft/{id}/trade", h.TradePick)
@@ -78,15 +105,77 @@ func main() {
 	r.Post("/draft/{id}/queue", h.AddToQueue)
 	r.Delete("/draft/{id}/queue/{queueId}", h.RemoveFromQueue)
 	r.Post("/players/custom", h.CreateCustomPlayer)
+	r.Get("/players/projections/import", h.ImportProjectionsForm)
+	r.Post("/players/projections/import", h.ImportProjections)
+	r.Get("/players/rankings", h.GetRankingSources)
+	r.Post("/players/rankings", h.CreateRankingSource)
+	r.Post("/players/rankings/{sourceId}/import", h.ImportRankingSource)
+	r.Post("/players/rankings/{sourceId}/delete", h.DeleteRankingSource)
+	r.Get("/players/rankings/consensus", h.GetConsensusRankings)
+	r.Get("/players/rankings/consensus/json", h.GetConsensusRankingsJSON)
+	r.Get("/players/status", h.GetPlayerStatuses)
+	r.Post("/players/status/import", h.ImportPlayerStatuses)
+	r.Post("/players/{playerId}/status", h.UpdatePlayerStatus)
+	r.Post("/players/{playerId}/positions", h.UpdatePlayerPositions)
+	r.Get("/players/duplicates", h.GetDuplicatePlayers)
+	r.Post("/players/duplicates/merge", h.MergeDuplicatePlayers)
+	r.Get("/players/adp", h.GetHistoricalADP)
+	r.Get("/players/adp/json", h.GetHistoricalADPJSON)
+	r.Post("/players/adp/source", h.SaveHistoricalADP)
+	r.Get("/nfl-teams", h.GetNFLTeams)
+	r.Post("/nfl-teams/{abbr}", h.UpdateNFLTeam)
+	r.Get("/seasons", h.GetSeasons)
+	r.Post("/seasons/rollover", h.RolloverSeason)
+	r.Get("/leagues", h.GetLeagues)
+	r.Post("/leagues", h.CreateLeague)
+	r.Get("/leagues/{leagueId}", h.GetLeague)
+	r.Post("/leagues/{leagueId}/drafts", h.AttachLeagueDraft)
+	r.Get("/leagues/{leagueId}/drafts/{draftId}", h.GetLeagueDraftTeams)
+	r.Post("/leagues/{leagueId}/drafts/{draftId}", h.UpdateLeagueDraftTeams)
+	r.Post("/leagues/{leagueId}/drafts/{draftId}/detach", h.DetachLeagueDraft)
+	r.Post("/leagues/{leagueId}/franchises", h.SaveFranchise)
+	r.Get("/leagues/{leagueId}/tendencies", h.GetLeagueTendencies)
+	r.Get("/leagues/{leagueId}/superlatives", h.GetLeagueSuperlatives)
+	r.Get("/leagues/{leagueId}/rookie-draft", h.GetRookieDraft)
+	r.Post("/leagues/{leagueId}/rookie-draft", h.CreateRookieDraft)
+	r.Post("/leagues/{leagueId}/future-picks", h.SaveFuturePick)
+	r.Post("/leagues/{leagueId}/future-picks/{pickId}/delete", h.DeleteFuturePick)
+	r.Post("/leagues/{leagueId}/rookies", h.MarkRookies)
+	r.Post("/draft/{id}/roster-slots", h.UpdateRosterSlots)
+	r.Post("/draft/{id}/run-settings", h.UpdateRunSettings)
+	r.Post("/draft/{id}/rank-source", h.UpdateDraftRankSource)
+	r.Get("/draft/{id}/scoring", h.GetScoringRules)
+	r.Get("/draft/{id}/scoring/json", h.GetScoringRulesJSON)
+	r.Post("/draft/{id}/scoring", h.UpdateScoringRules)
+	r.Post("/draft/{id}/scoring/reset", h.ResetScoringRules)
+	r.Get("/draft/{id}/rankings", h.GetDraftRankings)
+	r.Post("/draft/{id}/rankings", h.UpdateDraftRankings)
+	r.Post("/draft/{id}/rankings/import", h.ImportDraftRankings)
+	r.Post("/draft/{id}/rankings/reset", h.ResetDraftRankings)
+	r.Get("/draft/{id}/tiers", h.GetTiers)
+	r.Post("/draft/{id}/tiers/compute", h.ComputeTiers)
+	r.Post("/draft/{id}/tiers/import", h.ImportTiers)
+	r.Post("/draft/{id}/tiers/reset", h.ResetTiers)
 
 	// Stats routes
 	r.Get("/draft/{id}/stats/franchise", h.GetFranchiseStats)
 	r.Get("/draft/{id}/stats/position", h.GetDraftedByPosition)
 	r.Get("/draft/{id}/stats/value-picks", h.GetValuePicks)
+	r.Get("/draft/{id}/stats/projections", h.GetProjectedStandings)
+	r.Get("/draft/{id}/stats/projections/json", h.GetProjectedStandingsJSON)
+	r.Get("/draft/{id}/stats/byes", h.GetByeWeeks)
+	r.Get("/draft/{id}/stats/byes/json", h.GetByeWeeksJSON)
+	r.Get("/draft/{id}/report", h.GetDraftReport)
+	r.Get("/draft/{id}/report/json", h.GetDraftReportJSON)
 
 	// Export routes
 	r.Get("/draft/{id}/export/csv", h.ExportCSV)
 	r.Get("/draft/{id}/export/json", h.ExportJSON)
+	r.Get("/draft/{id}/export/xlsx", h.ExportXLSX)
+	r.Get("/draft/{id}/export/pdf", h.ExportPDF)
+	r.Get("/draft/{id}/platforms", h.GetPlatformExports)
+	r.Get("/draft/{id}/platforms/{platform}/export", h.ExportPlatform)
+	r.Post("/platforms/ids/import", h.ImportPlatformIDs)
 
 	// SSE route
 	r.Get("/draft/{id}/stream", h.StreamUpdates)
@@ -0,0 +1,67 @@
+package adp
+
+import (
+	"sort"
+
+	"github.com/vibes/draft-board/internal/models"
+)
+
+// Stat summarizes where one player went across a set of completed drafts.
+type Stat struct {
+	PlayerID  int     `json:"player_id"`
+	Rank      int     `json:"rank"`
+	Average   float64 `json:"average"`
+	Min       int     `json:"min"`
+	Max       int     `json:"max"`
+	Count     int     `json:"count"`
+	Frequency float64 `json:"frequency"`
+}
+
+// Build aggregates the picks of completed drafts into ADP stats. Frequency
+// is the share of the drafts that took the player, and players taken in
+// fewer than minCount drafts are left out. Players are ordered by average
+// pick, ties going to the earlier min pick, and Rank is the player's place
+// in that order.
+func Build(picks []models.Pick, minCount int) []Stat {
+	drafts := make(map[int]bool)
+	byPlayer := make(map[int][]int)
+	for _, p := range picks {
+		drafts[p.DraftID] = true
+		byPlayer[p.PlayerID] = append(byPlayer[p.PlayerID], p.OverallPick)
+	}
+
+	stats := make([]Stat, 0, len(byPlayer))
+	for playerID, overall := range byPlayer {
+		if len(overall) < minCount {
+			continue
+		}
+		stat := Stat{PlayerID: playerID, Min: overall[0], Max: overall[0], Count: len(overall)}
+		var sum float64
+		for _, o := range overall {
+			sum += float64(o)
+			if o < stat.Min {
+				stat.Min = o
+			}
+			if o > stat.Max {
+				stat.Max = o
+			}
+		}
+		stat.Average = sum / float64(len(overall))
+		stat.Frequency = float64(len(overall)) / float64(len(drafts))
+		stats = append(stats, stat)
+	}
+
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].Average != stats[j].Average {
+			return stats[i].Average < stats[j].Average
+		}
+		if stats[i].Min != stats[j].Min {
+			return stats[i].Min < stats[j].Min
+		}
+		return stats[i].PlayerID < stats[j].PlayerID
+	})
+	for i := range stats {
+		stats[i].Rank = i + 1
+	}
+	return stats
+}
@@ -0,0 +1,62 @@
+package adp
+
+import (
+	"math"
+	"testing"
+
+	"github.com/vibes/draft-board/internal/models"
+)
+
+func TestBuild(t *testing.T) {
+	picks := []models.Pick{
+		{DraftID: 1, PlayerID: 10, OverallPick: 2},
+		{DraftID: 2, PlayerID: 10, OverallPick: 4},
+		{DraftID: 1, PlayerID: 20, OverallPick: 1},
+		{DraftID: 2, PlayerID: 20, OverallPick: 5},
+		{DraftID: 1, PlayerID: 30, OverallPick: 3},
+		{DraftID: 2, PlayerID: 40, OverallPick: 1},
+	}
+
+	got := Build(picks, 1)
+	if len(got) != 4 {
+		t.Fatalf("Build() returned %d stats, want 4", len(got))
+	}
+
+	tests := []struct {
+		playerID, rank, min, max, count int
+		average, frequency              float64
+	}{
+		{playerID: 40, rank: 1, min: 1, max: 1, count: 1, average: 1, frequency: 0.5},
+		{playerID: 20, rank: 2, min: 1, max: 5, count: 2, average: 3, frequency: 1},
+		{playerID: 10, rank: 3, min: 2, max: 4, count: 2, average: 3, frequency: 1},
+		{playerID: 30, rank: 4, min: 3, max: 3, count: 1, average: 3, frequency: 0.5},
+	}
+	for i, tt := range tests {
+		s := got[i]
+		if s.PlayerID != tt.playerID || s.Rank != tt.rank {
+			t.Errorf("stat %d = player %d rank %d, want player %d rank %d", i, s.PlayerID, s.Rank, tt.playerID, tt.rank)
+			continue
+		}
+		if s.Min != tt.min || s.Max != tt.max || s.Count != tt.count {
+			t.Errorf("player %d min/max/count = %d/%d/%d, want %d/%d/%d",
+				s.PlayerID, s.Min, s.Max, s.Count, tt.min, tt.max, tt.count)
+		}
+		if math.Abs(s.Average-tt.average) > 1e-9 || math.Abs(s.Frequency-tt.frequency) > 1e-9 {
+			t.Errorf("player %d average/frequency = %.3f/%.3f, want %.3f/%.3f",
+				s.PlayerID, s.Average, s.Frequency, tt.average, tt.frequency)
+		}
+	}
+}
+
+func TestBuildMinCount(t *testing.T) {
+	picks := []models.Pick{
+		{DraftID: 1, PlayerID: 10, OverallPick: 2},
+		{DraftID: 2, PlayerID: 10, OverallPick: 4},
+		{DraftID: 2, PlayerID: 20, OverallPick: 1},
+	}
+
+	got := Build(picks, 2)
+	if len(got) != 1 || got[0].PlayerID != 10 || got[0].Rank != 1 {
+		t.Errorf("Build() = %+v, want only player 10 at rank 1", got)
+	}
+}
@@ -0,0 +1,315 @@
+// Package boardimage draws a draft's big board, rounds down and teams
+// across, as an SVG or PNG image for sharing. Both are drawn in pure Go:
+// the PNG uses a built-in bitmap font, so no fonts or browser need be
+// installed.
+package boardimage
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Board is the big board to draw.
+type Board struct {
+	Title string
+	Teams []Team
+	// Rounds holds a row per round with a cell per team, in the order of
+	// Teams; a nil cell is a pick not yet made.
+	Rounds [][]*Cell
+}
+
+// Team is a column of the board. Highlighted teams are drawn brighter and
+// outlined, with the other teams faded.
+type Team struct {
+	Name      string
+	Highlight bool
+}
+
+// Cell is one pick on the board. Color is the RGB hex color of the
+// player's position, such as "3B82F6".
+type Cell struct {
+	Player   string
+	Position string
+	Team     string
+	Color    string
+	Traded   bool
+}
+
+// The board's layout, in SVG units. The PNG is drawn at pngScale times
+// this size.
+const (
+	padding    = 16
+	titleH     = 36
+	headerH    = 32
+	roundW     = 56
+	cellW      = 148
+	cellH      = 44
+	cellInset  = 2
+	stripeW    = 4
+	textInset  = 10
+	maxNameLen = 21
+	pngScale   = 2
+)
+
+// The tokyo-night colors the board page is drawn in.
+var (
+	bgColor      = hex("1A1B26")
+	bgLightColor = hex("24283B")
+	fgColor      = hex("C0CAF5")
+	fgDimColor   = hex("A9B1D6")
+	borderColor  = hex("334155")
+	accentColor  = hex("7AA2F7")
+	errorColor   = hex("F7768E")
+)
+
+func (b *Board) highlighting() bool {
+	for _, t := range b.Teams {
+		if t.Highlight {
+			return true
+		}
+	}
+	return false
+}
+
+func (b *Board) size() (int, int) {
+	return padding*2 + roundW + len(b.Teams)*cellW, padding*2 + titleH + headerH + len(b.Rounds)*cellH
+}
+
+// cellAt returns the top left corner of a team's cell in a round, both
+// counted from 0. A round of -1 is the header.
+func cellAt(round, team int) (int, int) {
+	x := padding + roundW + team*cellW
+	if round < 0 {
+		return x, padding + titleH
+	}
+	return x, padding + titleH + headerH + round*cellH
+}
+
+// faded reports whether a team's column is drawn faded.
+func (b *Board) faded(team int) bool {
+	return b.highlighting() && !b.Teams[team].Highlight
+}
+
+// fill returns the colors a cell is drawn in: its background, its border
+// and the stripe down its left side. Like the position badges, the
+// background is the position's color at 20% opacity.
+func (b *Board) fill(cell *Cell, team int) (color.RGBA, color.RGBA, color.RGBA) {
+	pos := hex(cell.Color)
+	alpha := 0.2
+	if b.faded(team) {
+		alpha = 0.06
+		pos = blend(pos, bgLightColor, 0.35)
+	}
+	return blend(pos, bgLightColor, alpha), blend(pos, bgLightColor, 0.5), pos
+}
+
+// text returns the colors a team's picks are written in.
+func (b *Board) text(team int) (color.RGBA, color.RGBA) {
+	if b.faded(team) {
+		dim := blend(fgDimColor, bgLightColor, 0.4)
+		return dim, dim
+	}
+	return fgColor, fgDimColor
+}
+
+// detail is the second line of a cell, such as "WR - CIN".
+func (c *Cell) detail() string {
+	if c.Team == "" {
+		return c.Position
+	}
+	return c.Position + " - " + c.Team
+}
+
+// SVG writes the board as an SVG image.
+func (b *Board) SVG(w io.Writer) error {
+	width, height := b.size()
+	var s strings.Builder
+	fmt.Fprintf(&s, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" font-family="Helvetica, Arial, sans-serif">`, width, height, width, height)
+	fmt.Fprintf(&s, `<rect width="%d" height="%d" fill="%s"/>`, width, height, css(bgColor))
+	fmt.Fprintf(&s, `<text x="%d" y="%d" font-size="20" font-weight="bold" fill="%s">%s</text>`, padding, padding+22, css(accentColor), escape(b.Title))
+
+	x, y := cellAt(-1, 0)
+	fmt.Fprintf(&s, `<text x="%d" y="%d" font-size="12" font-weight="bold" fill="%s">Round</text>`, padding, y+20, css(fgDimColor))
+	for t, team := range b.Teams {
+		x, _ = cellAt(-1, t)
+		fill, text := bgLightColor, fgColor
+		if team.Highlight {
+			fill, text = accentColor, bgColor
+		} else if b.faded(t) {
+			text = fgDimColor
+		}
+		fmt.Fprintf(&s, `<rect x="%d" y="%d" width="%d" height="%d" rx="4" fill="%s"/>`, x+cellInset, y+cellInset, cellW-2*cellInset, headerH-2*cellInset, css(fill))
+		fmt.Fprintf(&s, `<text x="%d" y="%d" font-size="12" font-weight="bold" fill="%s">%s</text>`, x+textInset, y+20, css(text), escape(fit(team.Name, maxNameLen)))
+	}
+
+	for r, row := range b.Rounds {
+		_, y = cellAt(r, 0)
+		fmt.Fprintf(&s, `<text x="%d" y="%d" font-size="12" fill="%s">%d</text>`, padding, y+26, css(fgDimColor), r+1)
+		for t := range b.Teams {
+			x, _ = cellAt(r, t)
+			var cell *Cell
+			if t < len(row) {
+				cell = row[t]
+			}
+			if cell == nil {
+				fmt.Fprintf(&s, `<rect x="%d" y="%d" width="%d" height="%d" rx="4" fill="none" stroke="%s"/>`, x+cellInset, y+cellInset, cellW-2*cellInset, cellH-2*cellInset, css(borderColor))
+				continue
+			}
+			fill, border, stripe := b.fill(cell, t)
+			name, detail := b.text(t)
+			fmt.Fprintf(&s, `<rect x="%d" y="%d" width="%d" height="%d" rx="4" fill="%s" stroke="%s"/>`, x+cellInset, y+cellInset, cellW-2*cellInset, cellH-2*cellInset, css(fill), css(border))
+			fmt.Fprintf(&s, `<rect x="%d" y="%d" width="%d" height="%d" fill="%s"/>`, x+cellInset, y+cellInset, stripeW, cellH-2*cellInset, css(stripe))
+			fmt.Fprintf(&s, `<text x="%d" y="%d" font-size="12" font-weight="bold" fill="%s">%s</text>`, x+textInset, y+19, css(name), escape(fit(cell.Player, maxNameLen)))
+			fmt.Fprintf(&s, `<text x="%d" y="%d" font-size="10" fill="%s">%s</text>`, x+textInset, y+34, css(detail), escape(cell.detail()))
+			if cell.Traded {
+				fmt.Fprintf(&s, `<text x="%d" y="%d" font-size="9" font-weight="bold" text-anchor="end" fill="%s">TRADED</text>`, x+cellW-textInset, y+34, css(errorColor))
+			}
+		}
+	}
+
+	for t, team := range b.Teams {
+		if team.Highlight {
+			x, y = cellAt(-1, t)
+			fmt.Fprintf(&s, `<rect x="%d" y="%d" width="%d" height="%d" rx="6" fill="none" stroke="%s" stroke-width="2"/>`, x, y, cellW, headerH+len(b.Rounds)*cellH, css(accentColor))
+		}
+	}
+	s.WriteString(`</svg>`)
+
+	_, err := io.WriteString(w, s.String())
+	return err
+}
+
+// PNG writes the board as a PNG image, at twice the SVG's size so text in
+// the bitmap font stays legible.
+func (b *Board) PNG(w io.Writer) error {
+	width, height := b.size()
+	c := &canvas{image.NewRGBA(image.Rect(0, 0, width*pngScale, height*pngScale))}
+	c.rect(0, 0, width, height, bgColor)
+	c.text(padding, padding+4, b.Title, 2, accentColor)
+
+	x, y := cellAt(-1, 0)
+	c.text(padding, y+12, "Round", 1, fgDimColor)
+	for t, team := range b.Teams {
+		x, _ = cellAt(-1, t)
+		fill, text := bgLightColor, fgColor
+		if team.Highlight {
+			fill, text = accentColor, bgColor
+		} else if b.faded(t) {
+			text = fgDimColor
+		}
+		c.rect(x+cellInset, y+cellInset, cellW-2*cellInset, headerH-2*cellInset, fill)
+		c.text(x+textInset, y+12, fit(team.Name, maxNameLen), 1, text)
+	}
+
+	for r, row := range b.Rounds {
+		_, y = cellAt(r, 0)
+		c.text(padding, y+18, strconv.Itoa(r+1), 1, fgDimColor)
+		for t := range b.Teams {
+			x, _ = cellAt(r, t)
+			var cell *Cell
+			if t < len(row) {
+				cell = row[t]
+			}
+			if cell == nil {
+				c.outline(x+cellInset, y+cellInset, cellW-2*cellInset, cellH-2*cellInset, 1, borderColor)
+				continue
+			}
+			fill, border, stripe := b.fill(cell, t)
+			name, detail := b.text(t)
+			c.rect(x+cellInset, y+cellInset, cellW-2*cellInset, cellH-2*cellInset, fill)
+			c.outline(x+cellInset, y+cellInset, cellW-2*cellInset, cellH-2*cellInset, 1, border)
+			c.rect(x+cellInset, y+cellInset, stripeW, cellH-2*cellInset, stripe)
+			c.text(x+textInset, y+10, fit(cell.Player, maxNameLen), 1, name)
+			c.text(x+textInset, y+26, cell.detail(), 1, detail)
+			if cell.Traded {
+				c.text(x+cellW-textInset-len("TRADED")*glyphAdvance, y+26, "TRADED", 1, errorColor)
+			}
+		}
+	}
+
+	for t, team := range b.Teams {
+		if team.Highlight {
+			x, y = cellAt(-1, t)
+			c.outline(x, y, cellW, headerH+len(b.Rounds)*cellH, 2, accentColor)
+		}
+	}
+
+	return png.Encode(w, c.img)
+}
+
+// canvas draws onto an image in SVG units, scaled up by pngScale.
+type canvas struct {
+	img *image.RGBA
+}
+
+func (c *canvas) rect(x, y, w, h int, col color.RGBA) {
+	r := image.Rect(x*pngScale, y*pngScale, (x+w)*pngScale, (y+h)*pngScale)
+	draw.Draw(c.img, r, image.NewUniform(col), image.Point{}, draw.Src)
+}
+
+func (c *canvas) outline(x, y, w, h, stroke int, col color.RGBA) {
+	c.rect(x, y, w, stroke, col)
+	c.rect(x, y+h-stroke, w, stroke, col)
+	c.rect(x, y, stroke, h, col)
+	c.rect(x+w-stroke, y, stroke, h, col)
+}
+
+// text writes s with its top left corner at x, y, each font pixel drawn
+// size units square.
+func (c *canvas) text(x, y int, s string, size int, col color.RGBA) {
+	px := size * pngScale
+	x0, y0 := x*pngScale, y*pngScale
+	for i, r := range []rune(s) {
+		g := glyph(r)
+		for gx, column := range g {
+			for gy := 0; gy < glyphHeight; gy++ {
+				if column&(1<<gy) == 0 {
+					continue
+				}
+				left := x0 + (i*glyphAdvance+gx)*px
+				top := y0 + gy*px
+				draw.Draw(c.img, image.Rect(left, top, left+px, top+px), image.NewUniform(col), image.Point{}, draw.Src)
+			}
+		}
+	}
+}
+
+// fit cuts s to n characters, ending in ".." when cut.
+func fit(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return strings.TrimSpace(string(runes[:n-2])) + ".."
+}
+
+// hex parses an RGB hex color such as "3B82F6"; anything else is gray.
+func hex(s string) color.RGBA {
+	n, err := strconv.ParseUint(strings.TrimPrefix(s, "#"), 16, 32)
+	if err != nil || len(strings.TrimPrefix(s, "#")) != 6 {
+		n = 0x6B7280
+	}
+	return color.RGBA{uint8(n >> 16), uint8(n >> 8), uint8(n), 0xFF}
+}
+
+// blend mixes fg over bg at the given opacity.
+func blend(fg, bg color.RGBA, alpha float64) color.RGBA {
+	mix := func(f, b uint8) uint8 {
+		return uint8(float64(b) + (float64(f)-float64(b))*alpha + 0.5)
+	}
+	return color.RGBA{mix(fg.R, bg.R), mix(fg.G, bg.G), mix(fg.B, bg.B), 0xFF}
+}
+
+func css(c color.RGBA) string {
+	return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+}
+
+func escape(s string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", `"`, "&quot;", "'", "&#39;").Replace(s)
+}
@@ -0,0 +1,111 @@
+package boardimage
+
+import (
+	"bytes"
+	"encoding/xml"
+	"image/color"
+	"image/png"
+	"io"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func testBoard() *Board {
+	return &Board{
+		Title: "Big Board - Home League",
+		Teams: []Team{{Name: "Aces"}, {Name: "Bears & Co", Highlight: true}},
+		Rounds: [][]*Cell{
+			{
+				{Player: "Ja'Marr Chase", Position: "WR", Team: "CIN", Color: "22C55E"},
+				{Player: "Bijan Robinson", Position: "RB", Team: "ATL", Color: "F97316", Traded: true},
+			},
+			{nil, {Player: "Josh Allen", Position: "QB", Team: "BUF", Color: "3B82F6"}},
+		},
+	}
+}
+
+func TestSVG(t *testing.T) {
+	var buf bytes.Buffer
+	if err := testBoard().SVG(&buf); err != nil {
+		t.Fatalf("SVG() error = %v", err)
+	}
+
+	dec := xml.NewDecoder(bytes.NewReader(buf.Bytes()))
+	for {
+		if _, err := dec.Token(); err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatalf("SVG is not well formed: %v", err)
+		}
+	}
+
+	svg := buf.String()
+	width, height := testBoard().size()
+	for _, want := range []string{
+		`width="` + strconv.Itoa(width) + `" height="` + strconv.Itoa(height) + `"`,
+		`Bears &amp; Co`,
+		`Ja&#39;Marr Chase`,
+		`WR - CIN`,
+		`>TRADED</text>`,
+		// The Bears are highlighted: their header is filled with the
+		// accent color and the column outlined in it.
+		`fill="#7aa2f7"/>`,
+		`stroke="#7aa2f7" stroke-width="2"`,
+	} {
+		if !strings.Contains(svg, want) {
+			t.Errorf("SVG missing %s", want)
+		}
+	}
+}
+
+func TestPNG(t *testing.T) {
+	board := testBoard()
+	var buf bytes.Buffer
+	if err := board.PNG(&buf); err != nil {
+		t.Fatalf("PNG() error = %v", err)
+	}
+	img, err := png.Decode(&buf)
+	if err != nil {
+		t.Fatalf("not a PNG: %v", err)
+	}
+
+	width, height := board.size()
+	if got := img.Bounds().Size(); got.X != width*pngScale || got.Y != height*pngScale {
+		t.Errorf("size = %v, want %dx%d", got, width*pngScale, height*pngScale)
+	}
+
+	// The stripe down the left of Josh Allen's cell is the QB color.
+	x, y := cellAt(1, 1)
+	got := color.RGBAModel.Convert(img.At((x+cellInset+1)*pngScale, (y+cellH/2)*pngScale))
+	if want := (color.RGBA{0x3B, 0x82, 0xF6, 0xFF}); got != want {
+		t.Errorf("QB stripe = %v, want %v", got, want)
+	}
+	// The Aces are not highlighted, so Chase's stripe is faded.
+	x, y = cellAt(0, 0)
+	got = color.RGBAModel.Convert(img.At((x+cellInset+1)*pngScale, (y+cellH/2)*pngScale))
+	if green := (color.RGBA{0x22, 0xC5, 0x5E, 0xFF}); got == green {
+		t.Error("faded team drawn at full color")
+	}
+}
+
+func TestGlyph(t *testing.T) {
+	if len([]rune(accented)) != len([]rune(unaccented)) {
+		t.Fatalf("%d accented letters but %d unaccented", len([]rune(accented)), len([]rune(unaccented)))
+	}
+	if glyph('é') != glyph('e') || glyph('Ñ') != glyph('N') {
+		t.Error("accented letters should be drawn unaccented")
+	}
+	if glyph('→') != glyph('?') {
+		t.Error("unknown characters should be drawn as ?")
+	}
+}
+
+func TestFit(t *testing.T) {
+	if got := fit("Josh Allen", 10); got != "Josh Allen" {
+		t.Errorf("fit() = %q", got)
+	}
+	if got := fit("Christian McCaffrey", 12); got != "Christian.." {
+		t.Errorf("fit() = %q, want Christian..", got)
+	}
+}
@@ -0,0 +1,107 @@
+package byes
+
+import (
+	"sort"
+
+	"github.com/vibes/draft-board/internal/lineup"
+	"github.com/vibes/draft-board/internal/models"
+)
+
+// Week is one team's bye exposure in a single NFL week.
+type Week struct {
+	Week int `json:"week"`
+	// Starters counts the team's starters on bye, keyed by position.
+	Starters map[string]int `json:"starters"`
+	// OnBye lists every rostered player on bye, starters and bench.
+	OnBye []int `json:"on_bye"`
+	// EmptySlots counts starting slots the roster cannot fill this week
+	// beyond those it cannot fill at full strength.
+	EmptySlots int  `json:"empty_slots"`
+	Illegal    bool `json:"illegal"`
+}
+
+// Team is one team's bye-week matrix.
+type Team struct {
+	TeamID       int    `json:"team_id"`
+	TeamName     string `json:"team_name"`
+	Weeks        []Week `json:"weeks"`
+	IllegalWeeks []int  `json:"illegal_weeks"`
+}
+
+// StarterCount is the total number of starters on bye.
+func (w Week) StarterCount() int {
+	total := 0
+	for _, n := range w.Starters {
+		total += n
+	}
+	return total
+}
+
+// Weeks returns the distinct bye weeks, in order, among the given players.
+func Weeks(players map[int]*models.Player) []int {
+	seen := make(map[int]bool)
+	var weeks []int
+	for _, p := range players {
+		if p.ByeWeek == nil || seen[*p.ByeWeek] {
+			continue
+		}
+		seen[*p.ByeWeek] = true
+		weeks = append(weeks, *p.ByeWeek)
+	}
+	sort.Ints(weeks)
+	return weeks
+}
+
+// Analyze builds a team's bye matrix for the given weeks. Starters are the
+// roster's best lineup at full strength; a week is illegal when the players
+// left after byes cannot fill a starting slot the full roster can.
+func Analyze(roster []lineup.Entry, players map[int]*models.Player, slots []models.RosterSlot, weeks []int) []Week {
+	full := lineup.Optimal(roster, slots)
+	starting := make(map[int]bool)
+	for _, a := range full.Starters {
+		if a.Entry.PlayerID != 0 {
+			starting[a.Entry.PlayerID] = true
+		}
+	}
+
+	result := make([]Week, 0, len(weeks))
+	for _, week := range weeks {
+		w := Week{Week: week, Starters: make(map[string]int), OnBye: []int{}}
+		available := make([]lineup.Entry, 0, len(roster))
+		for _, e := range roster {
+			player, ok := players[e.PlayerID]
+			if ok && player.ByeWeek != nil && *player.ByeWeek == week {
+				w.OnBye = append(w.OnBye, e.PlayerID)
+				if starting[e.PlayerID] {
+					w.Starters[e.Position]++
+				}
+				continue
+			}
+			available = append(available, e)
+		}
+
+		if len(w.OnBye) > 0 {
+			w.EmptySlots = lineup.Optimal(available, slots).EmptySlots - full.EmptySlots
+			w.Illegal = w.EmptySlots > 0
+		}
+		result = append(result, w)
+	}
+	return result
+}
+
+// ForTeam analyzes a team's roster and collects the weeks it can't field a
+// legal lineup.
+func ForTeam(team models.Team, roster []lineup.Entry, players map[int]*models.Player, slots []models.RosterSlot, weeks []int) Team {
+	t := Team{
+		TeamID:       team.ID,
+		TeamName:     team.TeamName,
+		Weeks:        Analyze(roster, players, slots, weeks),
+		IllegalWeeks: []int{},
+	}
+	for _, w := range t.Weeks {
+		if w.Illegal {
+			t.IllegalWeeks = append(t.IllegalWeeks, w.Week)
+		}
+	}
+	return t
+}
@@ -0,0 +1,51 @@
+package byes
+
+import (
+	"testing"
+
+	"github.com/vibes/draft-board/internal/lineup"
+	"github.com/vibes/draft-board/internal/models"
+)
+
+func intPtr(i int) *int { return &i }
+
+func TestAnalyze(t *testing.T) {
+	players := map[int]*models.Player{
+		1: {ID: 1, Position: "QB", ByeWeek: intPtr(7)},
+		2: {ID: 2, Position: "RB", ByeWeek: intPtr(7)},
+		3: {ID: 3, Position: "RB", ByeWeek: intPtr(9)},
+		4: {ID: 4, Position: "RB", ByeWeek: intPtr(10)},
+		5: {ID: 5, Position: "QB", ByeWeek: intPtr(10)},
+	}
+	roster := []lineup.Entry{
+		{PlayerID: 1, Position: "QB", Points: 300},
+		{PlayerID: 2, Position: "RB", Points: 200},
+		{PlayerID: 3, Position: "RB", Points: 150},
+		{PlayerID: 4, Position: "RB", Points: 50},
+	}
+	slots := []models.RosterSlot{{Slot: "QB", Count: 1}, {Slot: "RB", Count: 2}, {Slot: lineup.Bench, Count: 3}}
+
+	weeks := Analyze(roster, players, slots, Weeks(players))
+	if len(weeks) != 3 {
+		t.Fatalf("len(weeks) = %d, want 3", len(weeks))
+	}
+
+	week7 := weeks[0]
+	if week7.Week != 7 || week7.Starters["QB"] != 1 || week7.Starters["RB"] != 1 {
+		t.Errorf("week 7 starters = %v, want 1 QB and 1 RB", week7.Starters)
+	}
+	// No backup QB, so week 7 can't field a legal lineup
+	if !week7.Illegal || week7.EmptySlots != 1 {
+		t.Errorf("week 7 illegal = %v empty = %d, want true and 1", week7.Illegal, week7.EmptySlots)
+	}
+
+	week9 := weeks[1]
+	if week9.Illegal || week9.StarterCount() != 1 {
+		t.Errorf("week 9 = %+v, want one starter out and a legal lineup", week9)
+	}
+
+	week10 := weeks[2]
+	if week10.StarterCount() != 0 || len(week10.OnBye) != 1 {
+		t.Errorf("week 10 = %+v, want a bench player out only", week10)
+	}
+}
@@ -0,0 +1,66 @@
+package consensus
+
+import (
+	"math"
+	"sort"
+
+	"github.com/vibes/draft-board/internal/models"
+)
+
+// Stat summarizes how the ranking sources agree on one player.
+type Stat struct {
+	PlayerID int     `json:"player_id"`
+	Rank     int     `json:"rank"`
+	Mean     float64 `json:"mean"`
+	StdDev   float64 `json:"std_dev"`
+	Best     int     `json:"best"`
+	Worst    int     `json:"worst"`
+	Sources  int     `json:"sources"`
+}
+
+// Build aggregates one format's ranks from every source into consensus
+// stats. Players are ordered by mean rank, ties going to the better best
+// rank, and Rank is the player's place in that order.
+func Build(ranks []models.SourceRank) []Stat {
+	byPlayer := make(map[int][]int)
+	for _, r := range ranks {
+		byPlayer[r.PlayerID] = append(byPlayer[r.PlayerID], r.Rank)
+	}
+
+	stats := make([]Stat, 0, len(byPlayer))
+	for playerID, values := range byPlayer {
+		stat := Stat{PlayerID: playerID, Best: values[0], Worst: values[0], Sources: len(values)}
+		var sum float64
+		for _, v := range values {
+			sum += float64(v)
+			if v < stat.Best {
+				stat.Best = v
+			}
+			if v > stat.Worst {
+				stat.Worst = v
+			}
+		}
+		stat.Mean = sum / float64(len(values))
+
+		var variance float64
+		for _, v := range values {
+			variance += (float64(v) - stat.Mean) * (float64(v) - stat.Mean)
+		}
+		stat.StdDev = math.Sqrt(variance / float64(len(values)))
+		stats = append(stats, stat)
+	}
+
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].Mean != stats[j].Mean {
+			return stats[i].Mean < stats[j].Mean
+		}
+		if stats[i].Best != stats[j].Best {
+			return stats[i].Best < stats[j].Best
+		}
+		return stats[i].PlayerID < stats[j].PlayerID
+	})
+	for i := range stats {
+		stats[i].Rank = i + 1
+	}
+	return stats
+}
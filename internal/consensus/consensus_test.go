@@ -0,0 +1,53 @@
+package consensus
+
+import (
+	"math"
+	"testing"
+
+	"github.com/vibes/draft-board/internal/models"
+)
+
+func TestBuild(t *testing.T) {
+	ranks := []models.SourceRank{
+		{SourceID: 1, PlayerID: 10, Rank: 1},
+		{SourceID: 2, PlayerID: 10, Rank: 3},
+		{SourceID: 1, PlayerID: 20, Rank: 2},
+		{SourceID: 2, PlayerID: 20, Rank: 1},
+		{SourceID: 3, PlayerID: 20, Rank: 6},
+		{SourceID: 1, PlayerID: 30, Rank: 2},
+	}
+
+	got := Build(ranks)
+	if len(got) != 3 {
+		t.Fatalf("Build() returned %d stats, want 3", len(got))
+	}
+
+	tests := []struct {
+		playerID, rank, best, worst, sources int
+		mean, stddev                         float64
+	}{
+		{playerID: 10, rank: 1, best: 1, worst: 3, sources: 2, mean: 2, stddev: 1},
+		{playerID: 30, rank: 2, best: 2, worst: 2, sources: 1, mean: 2, stddev: 0},
+		{playerID: 20, rank: 3, best: 1, worst: 6, sources: 3, mean: 3, stddev: math.Sqrt(14.0 / 3)},
+	}
+	for i, tt := range tests {
+		s := got[i]
+		if s.PlayerID != tt.playerID || s.Rank != tt.rank {
+			t.Errorf("stat %d = player %d rank %d, want player %d rank %d", i, s.PlayerID, s.Rank, tt.playerID, tt.rank)
+			continue
+		}
+		if s.Best != tt.best || s.Worst != tt.worst || s.Sources != tt.sources {
+			t.Errorf("player %d best/worst/sources = %d/%d/%d, want %d/%d/%d",
+				s.PlayerID, s.Best, s.Worst, s.Sources, tt.best, tt.worst, tt.sources)
+		}
+		if math.Abs(s.Mean-tt.mean) > 1e-9 || math.Abs(s.StdDev-tt.stddev) > 1e-9 {
+			t.Errorf("player %d mean/stddev = %.3f/%.3f, want %.3f/%.3f", s.PlayerID, s.Mean, s.StdDev, tt.mean, tt.stddev)
+		}
+	}
+}
+
+func TestBuildEmpty(t *testing.T) {
+	if got := Build(nil); len(got) != 0 {
+		t.Errorf("Build(nil) = %v, want empty", got)
+	}
+}
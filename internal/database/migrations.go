@@ -3,9 +3,25 @@ package database
 import (
 	"database/sql"
 	"fmt"
+	"strings"
+
+	"github.com/vibes/draft-board/internal/nfl"
 )
 
 func RunMigrations(db *sql.DB) error {
+	// A database last opened by a build with FTS5 has triggers writing to
+	// the search index, which fail on every player write without it. Drop
+	// them before any migration touches players.
+	fts, err := hasFTS5(db)
+	if err != nil {
+		return err
+	}
+	if !fts {
+		if _, err := db.Exec(dropPlayersFTSTriggers); err != nil {
+			return fmt.Errorf("failed to drop player search triggers: %w", err)
+		}
+	}
+
 	migrations := []string{
 		createPlayersTable,
 		createDraftsTable,
@@ -14,6 +30,29 @@ func RunMigrations(db *sql.DB) error {
 		createPositionSettingsTable,
 		createDraftQueueTable,
 		createAuditLogTable,
+		createPlayerProjectionsTable,
+		createRosterSlotsTable,
+		createScoringRulesTable,
+		createDraftReportsTable,
+		createRunSettingsTable,
+		createDraftRankingsTable,
+		createPlayerTiersTable,
+		createRankingSourcesTable,
+		createSourceRanksTable,
+		createDraftRankSourcesTable,
+		createSeasonsTable,
+		createPlayerSeasonsTable,
+		createPlayerNotesTable,
+		createPlayerMergesTable,
+		createNFLTeamsTable,
+		createDraftTemplatesTable,
+		createLeaguesTable,
+		createManagersTable,
+		createFranchisesTable,
+		createPickOwnersTable,
+		createCarriedPlayersTable,
+		createFuturePicksTable,
+		createPlayerPlatformIDsTable,
 		createIndexes,
 	}
 
@@ -23,6 +62,424 @@ func RunMigrations(db *sql.DB) error {
 		}
 	}
 
+	if err := addSeasonColumns(db); err != nil {
+		return err
+	}
+	if err := addPlayerStatusColumns(db); err != nil {
+		return err
+	}
+	if err := addEligiblePositionsColumn(db); err != nil {
+		return err
+	}
+	if err := dropPositionCheck(db); err != nil {
+		return err
+	}
+	if err := addSportColumns(db); err != nil {
+		return err
+	}
+	if err := addLeagueColumns(db); err != nil {
+		return err
+	}
+	if err := addRookieDraftColumns(db); err != nil {
+		return err
+	}
+	if err := addSourceKindColumn(db); err != nil {
+		return err
+	}
+	if err := scopeSourceNames(db); err != nil {
+		return err
+	}
+	if err := dropScoringFormatCheck(db); err != nil {
+		return err
+	}
+	if err := seedNFLTeams(db); err != nil {
+		return err
+	}
+	if _, err := db.Exec(createConsensusRanksView); err != nil {
+		return fmt.Errorf("failed to create consensus ranks view: %w", err)
+	}
+
+	if !fts {
+		return nil
+	}
+	return setupPlayerSearch(db)
+}
+
+// addSeasonColumns adds the season column to tables created before seasons
+// existed. Rows from then are placed in the year they were created.
+func addSeasonColumns(db *sql.DB) error {
+	for _, table := range []string{"drafts", "ranking_sources"} {
+		exists, err := hasColumn(db, table, "season")
+		if err != nil {
+			return err
+		}
+		if !exists {
+			if _, err := db.Exec(`ALTER TABLE ` + table + ` ADD COLUMN season INTEGER NOT NULL DEFAULT 0`); err != nil {
+				return fmt.Errorf("failed to add season to %s: %w", table, err)
+			}
+		}
+		backfill := `UPDATE ` + table + ` SET season = CAST(strftime('%Y', COALESCE(created_at, 'now')) AS INTEGER) WHERE season = 0`
+		if _, err := db.Exec(backfill); err != nil {
+			return fmt.Errorf("failed to backfill season for %s: %w", table, err)
+		}
+	}
+	return nil
+}
+
+// addPlayerStatusColumns adds the status columns to players tables created
+// before players had a status.
+func addPlayerStatusColumns(db *sql.DB) error {
+	columns := []struct{ name, definition string }{
+		{"status", "TEXT NOT NULL DEFAULT 'active'"},
+		{"status_note", "TEXT NOT NULL DEFAULT ''"},
+		{"status_updated_at", "TIMESTAMP"},
+	}
+	for _, column := range columns {
+		exists, err := hasColumn(db, "players", column.name)
+		if err != nil {
+			return err
+		}
+		if exists {
+			continue
+		}
+		if _, err := db.Exec(`ALTER TABLE players ADD COLUMN ` + column.name + ` ` + column.definition); err != nil {
+			return fmt.Errorf("failed to add %s to players: %w", column.name, err)
+		}
+	}
+	return nil
+}
+
+// addEligiblePositionsColumn adds the extra positions column to players
+// tables created when players had a single position.
+func addEligiblePositionsColumn(db *sql.DB) error {
+	exists, err := hasColumn(db, "players", "eligible_positions")
+	if err != nil || exists {
+		return err
+	}
+	if _, err := db.Exec(`ALTER TABLE players ADD COLUMN eligible_positions TEXT NOT NULL DEFAULT ''`); err != nil {
+		return fmt.Errorf("failed to add eligible_positions to players: %w", err)
+	}
+	return nil
+}
+
+// dropPositionCheck rebuilds players tables created when positions were
+// limited to football's by a CHECK constraint. Positions now come from the
+// draft's sport profile. The consensus view and search triggers are
+// recreated afterwards by RunMigrations.
+func dropPositionCheck(db *sql.DB) error {
+	var schema string
+	if err := db.QueryRow(`SELECT sql FROM sqlite_master WHERE type = 'table' AND name = 'players'`).Scan(&schema); err != nil {
+		return fmt.Errorf("failed to read players schema: %w", err)
+	}
+	if !strings.Contains(schema, "CHECK(position IN") {
+		return nil
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	columns := `id, name, team, position, bye_week, dynasty_rank, sf_rank, std_rank, half_ppr_rank, ppr_rank,
+		is_custom, created_at, status, status_note, status_updated_at, eligible_positions`
+	statements := []string{
+		`DROP VIEW IF EXISTS consensus_ranks`,
+		strings.Replace(createPlayersTable, "EXISTS players", "EXISTS players_rebuilt", 1),
+		`INSERT INTO players_rebuilt (` + columns + `) SELECT ` + columns + ` FROM players`,
+		`DROP TABLE players`,
+		`ALTER TABLE players_rebuilt RENAME TO players`,
+		createIndexes,
+	}
+	for _, stmt := range statements {
+		if _, err := tx.Exec(stmt); err != nil {
+			return fmt.Errorf("failed to rebuild players table: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// addSportColumns adds the sport column to drafts and players created
+// before the board handled sports other than football.
+func addSportColumns(db *sql.DB) error {
+	for _, table := range []string{"drafts", "players"} {
+		exists, err := hasColumn(db, table, "sport")
+		if err != nil {
+			return err
+		}
+		if exists {
+			continue
+		}
+		if _, err := db.Exec(`ALTER TABLE ` + table + ` ADD COLUMN sport TEXT NOT NULL DEFAULT 'football'`); err != nil {
+			return fmt.Errorf("failed to add sport to %s: %w", table, err)
+		}
+	}
+	return nil
+}
+
+// addLeagueColumns adds the league column to drafts and the franchise and
+// manager columns to teams created before drafts were grouped into leagues.
+func addLeagueColumns(db *sql.DB) error {
+	columns := []struct{ table, name, definition string }{
+		{"drafts", "league_id", "INTEGER REFERENCES leagues(id) ON DELETE SET NULL"},
+		{"teams", "franchise_id", "INTEGER REFERENCES franchises(id) ON DELETE SET NULL"},
+		{"teams", "manager_id", "INTEGER REFERENCES managers(id) ON DELETE SET NULL"},
+	}
+	for _, column := range columns {
+		exists, err := hasColumn(db, column.table, column.name)
+		if err != nil {
+			return err
+		}
+		if exists {
+			continue
+		}
+		if _, err := db.Exec(`ALTER TABLE ` + column.table + ` ADD COLUMN ` + column.name + ` ` + column.definition); err != nil {
+			return fmt.Errorf("failed to add %s to %s: %w", column.name, column.table, err)
+		}
+	}
+	_, err := db.Exec(`
+		CREATE INDEX IF NOT EXISTS idx_drafts_league ON drafts(league_id, season);
+		CREATE INDEX IF NOT EXISTS idx_teams_franchise ON teams(franchise_id);
+		CREATE INDEX IF NOT EXISTS idx_teams_manager ON teams(manager_id);
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create league indexes: %w", err)
+	}
+	return nil
+}
+
+// addSourceKindColumn adds the kind column to ranking sources created
+// before historical ADP could be saved as a source. Sources from then are
+// treated as imported.
+func addSourceKindColumn(db *sql.DB) error {
+	exists, err := hasColumn(db, "ranking_sources", "kind")
+	if err != nil || exists {
+		return err
+	}
+	if _, err := db.Exec(`ALTER TABLE ranking_sources ADD COLUMN kind TEXT NOT NULL DEFAULT 'imported'`); err != nil {
+		return fmt.Errorf("failed to add kind to ranking_sources: %w", err)
+	}
+	return nil
+}
+
+// scopeSourceNames rebuilds ranking_sources tables created when source
+// names were unique across every season, so each season can have its own
+// source of a name. The consensus view is recreated afterwards by
+// RunMigrations.
+func scopeSourceNames(db *sql.DB) error {
+	var schema string
+	if err := db.QueryRow(`SELECT sql FROM sqlite_master WHERE type = 'table' AND name = 'ranking_sources'`).Scan(&schema); err != nil {
+		return fmt.Errorf("failed to read ranking_sources schema: %w", err)
+	}
+	if !strings.Contains(schema, "name TEXT NOT NULL UNIQUE") {
+		return nil
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	columns := `id, name, created_at, season, kind`
+	statements := []string{
+		`DROP VIEW IF EXISTS consensus_ranks`,
+		strings.Replace(createRankingSourcesTable, "EXISTS ranking_sources", "EXISTS ranking_sources_rebuilt", 1),
+		`INSERT INTO ranking_sources_rebuilt (` + columns + `) SELECT ` + columns + ` FROM ranking_sources`,
+		`DROP TABLE ranking_sources`,
+		`ALTER TABLE ranking_sources_rebuilt RENAME TO ranking_sources`,
+	}
+	for _, stmt := range statements {
+		if _, err := tx.Exec(stmt); err != nil {
+			return fmt.Errorf("failed to rebuild ranking_sources table: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// dropScoringFormatCheck rebuilds drafts tables created when scoring formats
+// were limited to football's. Each sport keys its own formats now, so
+// basketball and baseball drafts and templates saved under football's
+// format names are renamed to theirs.
+func dropScoringFormatCheck(db *sql.DB) error {
+	var schema string
+	if err := db.QueryRow(`SELECT sql FROM sqlite_master WHERE type = 'table' AND name = 'drafts'`).Scan(&schema); err != nil {
+		return fmt.Errorf("failed to read drafts schema: %w", err)
+	}
+	if !strings.Contains(schema, "CHECK(scoring_format IN") {
+		return nil
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	columns := `id, name, num_teams, scoring_format, draft_type, qb_setting, snake_draft, status, max_rounds,
+		commissioner_id, created_at, completed, season, sport, league_id, rookie, previous_draft_id`
+	statements := []string{
+		`DROP VIEW IF EXISTS consensus_ranks`,
+		strings.Replace(createDraftsTable, "EXISTS drafts", "EXISTS drafts_rebuilt", 1),
+		`INSERT INTO drafts_rebuilt (` + columns + `) SELECT ` + columns + ` FROM drafts`,
+		`DROP TABLE drafts`,
+		`ALTER TABLE drafts_rebuilt RENAME TO drafts`,
+		createIndexes,
+		`CREATE INDEX IF NOT EXISTS idx_drafts_league ON drafts(league_id, season)`,
+	}
+	for _, stmt := range statements {
+		if _, err := tx.Exec(stmt); err != nil {
+			return fmt.Errorf("failed to rebuild drafts table: %w", err)
+		}
+	}
+
+	renames := []struct{ sport, from, to string }{
+		{"basketball", "Standard", "Points"},
+		{"basketball", "PPR", "Categories"},
+		{"baseball", "Standard", "Points"},
+		{"baseball", "PPR", "Roto"},
+	}
+	for _, r := range renames {
+		if _, err := tx.Exec(`UPDATE drafts SET scoring_format = ? WHERE sport = ? AND scoring_format = ?`, r.to, r.sport, r.from); err != nil {
+			return fmt.Errorf("failed to rename %s scoring formats: %w", r.sport, err)
+		}
+		_, err := tx.Exec(`
+			UPDATE draft_templates SET config = json_set(config, '$.scoring_format', ?)
+			WHERE json_extract(config, '$.sport') = ? AND json_extract(config, '$.scoring_format') = ?
+		`, r.to, r.sport, r.from)
+		if err != nil {
+			return fmt.Errorf("failed to rename %s template scoring formats: %w", r.sport, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// addRookieDraftColumns adds the rookie class column to players and the
+// rookie draft columns to drafts created before rookie drafts existed.
+func addRookieDraftColumns(db *sql.DB) error {
+	columns := []struct{ table, name, definition string }{
+		{"players", "rookie_season", "INTEGER"},
+		{"drafts", "rookie", "BOOLEAN NOT NULL DEFAULT FALSE"},
+		{"drafts", "previous_draft_id", "INTEGER REFERENCES drafts(id) ON DELETE SET NULL"},
+	}
+	for _, column := range columns {
+		exists, err := hasColumn(db, column.table, column.name)
+		if err != nil {
+			return err
+		}
+		if exists {
+			continue
+		}
+		if _, err := db.Exec(`ALTER TABLE ` + column.table + ` ADD COLUMN ` + column.name + ` ` + column.definition); err != nil {
+			return fmt.Errorf("failed to add %s to %s: %w", column.name, column.table, err)
+		}
+	}
+	if _, err := db.Exec(`CREATE INDEX IF NOT EXISTS idx_players_rookie_season ON players(rookie_season)`); err != nil {
+		return fmt.Errorf("failed to create rookie index: %w", err)
+	}
+	return nil
+}
+
+// seedNFLTeams adds any NFL team missing from the table, then moves football
+// players listed under a team's alias to its canonical abbreviation and
+// gives them their team's bye week. Teams already present keep their edits.
+func seedNFLTeams(db *sql.DB) error {
+	insert := `
+		INSERT OR IGNORE INTO nfl_teams (abbr, name, conference, division, bye_week, aliases)
+		VALUES (?, ?, ?, ?, ?, ?)
+	`
+	for _, team := range nfl.Teams {
+		if _, err := db.Exec(insert, team.Abbr, team.Name, team.Conference, team.Division, team.ByeWeek, team.Aliases); err != nil {
+			return fmt.Errorf("failed to seed NFL team %s: %w", team.Abbr, err)
+		}
+	}
+
+	normalize := `
+		UPDATE players SET team = (
+			SELECT t.abbr FROM nfl_teams t
+			WHERE UPPER(TRIM(players.team)) IN (t.abbr, UPPER(t.name))
+				OR (t.aliases != '' AND ',' || t.aliases || ',' LIKE '%,' || UPPER(TRIM(players.team)) || ',%')
+		)
+		WHERE sport = 'football' AND TRIM(team) != '' AND team NOT IN (SELECT abbr FROM nfl_teams) AND EXISTS (
+			SELECT 1 FROM nfl_teams t
+			WHERE UPPER(TRIM(players.team)) IN (t.abbr, UPPER(t.name))
+				OR (t.aliases != '' AND ',' || t.aliases || ',' LIKE '%,' || UPPER(TRIM(players.team)) || ',%')
+		)
+	`
+	if _, err := db.Exec(normalize); err != nil {
+		return fmt.Errorf("failed to normalize player teams: %w", err)
+	}
+
+	byes := `
+		UPDATE players SET bye_week = (SELECT t.bye_week FROM nfl_teams t WHERE t.abbr = players.team)
+		WHERE sport = 'football' AND EXISTS (
+			SELECT 1 FROM nfl_teams t
+			WHERE t.abbr = players.team AND t.bye_week IS NOT NULL AND t.bye_week IS NOT players.bye_week
+		)
+	`
+	if _, err := db.Exec(byes); err != nil {
+		return fmt.Errorf("failed to set player bye weeks: %w", err)
+	}
+	return nil
+}
+
+func hasColumn(db *sql.DB, table, column string) (bool, error) {
+	rows, err := db.Query(`SELECT name FROM pragma_table_info(?)`, table)
+	if err != nil {
+		return false, fmt.Errorf("failed to read %s columns: %w", table, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return false, fmt.Errorf("failed to scan column: %w", err)
+		}
+		if name == column {
+			return true, nil
+		}
+	}
+	return false, rows.Err()
+}
+
+// hasFTS5 reports whether SQLite was built with FTS5, which the sqlite_fts5
+// build tag turns on.
+func hasFTS5(db *sql.DB) (bool, error) {
+	var n int
+	err := db.QueryRow(`SELECT COUNT(*) FROM pragma_compile_options WHERE compile_options = 'ENABLE_FTS5'`).Scan(&n)
+	if err != nil {
+		return false, fmt.Errorf("failed to read SQLite compile options: %w", err)
+	}
+	return n > 0, nil
+}
+
+// setupPlayerSearch builds the FTS5 player search index and the triggers
+// that keep it in sync with the players table. The index is rebuilt on every
+// start in case players changed while it was unavailable. SQLite builds
+// without FTS5 never get here: RunMigrations drops the triggers, so player
+// writes keep working, and search falls back to LIKE matching.
+func setupPlayerSearch(db *sql.DB) error {
+	if _, err := db.Exec(createPlayersFTSTable); err != nil {
+		return fmt.Errorf("failed to create player search index: %w", err)
+	}
+	if _, err := db.Exec(createPlayersFTSTriggers); err != nil {
+		return fmt.Errorf("failed to create player search triggers: %w", err)
+	}
+	if _, err := db.Exec(rebuildPlayersFTS); err != nil {
+		return fmt.Errorf("failed to rebuild player search index: %w", err)
+	}
 	return nil
 }
 
@@ -31,7 +488,7 @@ CREATE TABLE IF NOT EXISTS players (
     id INTEGER PRIMARY KEY AUTOINCREMENT,
     name TEXT NOT NULL,
     team TEXT NOT NULL,
-    position TEXT NOT NULL CHECK(position IN ('QB', 'RB', 'WR', 'TE', 'K', 'D/ST', 'DL', 'LB', 'DB')),
+    position TEXT NOT NULL,
     bye_week INTEGER CHECK(bye_week BETWEEN 1 AND 18),
     dynasty_rank INTEGER,
     sf_rank INTEGER,
@@ -39,7 +496,13 @@ CREATE TABLE IF NOT EXISTS players (
     half_ppr_rank INTEGER,
     ppr_rank INTEGER,
     is_custom BOOLEAN DEFAULT FALSE,
-    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    status TEXT NOT NULL DEFAULT 'active',
+    status_note TEXT NOT NULL DEFAULT '',
+    status_updated_at TIMESTAMP,
+    eligible_positions TEXT NOT NULL DEFAULT '',
+    sport TEXT NOT NULL DEFAULT 'football',
+    rookie_season INTEGER
 );
 `
 
@@ -48,7 +511,7 @@ CREATE TABLE IF NOT EXISTS drafts (
     id INTEGER PRIMARY KEY AUTOINCREMENT,
     name TEXT NOT NULL,
     num_teams INTEGER NOT NULL CHECK(num_teams BETWEEN 2 AND 14),
-    scoring_format TEXT NOT NULL CHECK(scoring_format IN ('Standard', 'Half-PPR', 'PPR')),
+    scoring_format TEXT NOT NULL,
     draft_type TEXT NOT NULL CHECK(draft_type IN ('Redraft', 'Dynasty')),
     qb_setting TEXT DEFAULT '1QB',
     snake_draft BOOLEAN DEFAULT TRUE,
@@ -56,7 +519,12 @@ CREATE TABLE IF NOT EXISTS drafts (
     max_rounds INTEGER DEFAULT 16,
     commissioner_id TEXT,
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
-    completed BOOLEAN DEFAULT FALSE
+    completed BOOLEAN DEFAULT FALSE,
+    season INTEGER NOT NULL DEFAULT 0,
+    sport TEXT NOT NULL DEFAULT 'football',
+    league_id INTEGER REFERENCES leagues(id) ON DELETE SET NULL,
+    rookie BOOLEAN NOT NULL DEFAULT FALSE,
+    previous_draft_id INTEGER REFERENCES drafts(id) ON DELETE SET NULL
 );
 `
 
@@ -67,6 +535,8 @@ CREATE TABLE IF NOT EXISTS teams (
     team_name TEXT NOT NULL,
     owner_name TEXT,
     draft_position INTEGER NOT NULL,
+    franchise_id INTEGER REFERENCES franchises(id) ON DELETE SET NULL,
+    manager_id INTEGER REFERENCES managers(id) ON DELETE SET NULL,
     FOREIGN KEY (draft_id) REFERENCES drafts(id) ON DELETE CASCADE,
     UNIQUE(draft_id, draft_position),
     UNIQUE(draft_id, team_name)
@@ -129,6 +599,360 @@ CREATE TABLE IF NOT EXISTS audit_log (
 );
 `
 
+const createPlayerProjectionsTable = `
+CREATE TABLE IF NOT EXISTS player_projections (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    player_id INTEGER NOT NULL,
+    stat TEXT NOT NULL,
+    value REAL NOT NULL DEFAULT 0,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (player_id) REFERENCES players(id) ON DELETE CASCADE,
+    UNIQUE(player_id, stat)
+);
+`
+
+const createRosterSlotsTable = `
+CREATE TABLE IF NOT EXISTS roster_slots (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    draft_id INTEGER NOT NULL,
+    slot TEXT NOT NULL,
+    count INTEGER NOT NULL CHECK(count >= 0),
+    FOREIGN KEY (draft_id) REFERENCES drafts(id) ON DELETE CASCADE,
+    UNIQUE(draft_id, slot)
+);
+`
+
+const createScoringRulesTable = `
+CREATE TABLE IF NOT EXISTS scoring_rules (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    draft_id INTEGER NOT NULL,
+    position TEXT NOT NULL DEFAULT '',
+    stat TEXT NOT NULL,
+    points REAL NOT NULL,
+    FOREIGN KEY (draft_id) REFERENCES drafts(id) ON DELETE CASCADE,
+    UNIQUE(draft_id, position, stat)
+);
+`
+
+const createDraftReportsTable = `
+CREATE TABLE IF NOT EXISTS draft_reports (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    draft_id INTEGER NOT NULL UNIQUE,
+    report TEXT NOT NULL,
+    generated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (draft_id) REFERENCES drafts(id) ON DELETE CASCADE
+);
+`
+
+const createRunSettingsTable = `
+CREATE TABLE IF NOT EXISTS run_settings (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    draft_id INTEGER NOT NULL UNIQUE,
+    pick_window INTEGER NOT NULL,
+    threshold INTEGER NOT NULL,
+    tier_gap INTEGER NOT NULL,
+    FOREIGN KEY (draft_id) REFERENCES drafts(id) ON DELETE CASCADE
+);
+`
+
+const createDraftRankingsTable = `
+CREATE TABLE IF NOT EXISTS draft_rankings (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    draft_id INTEGER NOT NULL,
+    player_id INTEGER NOT NULL,
+    rank INTEGER NOT NULL,
+    FOREIGN KEY (draft_id) REFERENCES drafts(id) ON DELETE CASCADE,
+    FOREIGN KEY (player_id) REFERENCES players(id) ON DELETE CASCADE,
+    UNIQUE(draft_id, player_id)
+);
+`
+
+const createPlayerTiersTable = `
+CREATE TABLE IF NOT EXISTS player_tiers (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    source TEXT NOT NULL,
+    player_id INTEGER NOT NULL,
+    position TEXT NOT NULL,
+    tier INTEGER NOT NULL,
+    FOREIGN KEY (player_id) REFERENCES players(id) ON DELETE CASCADE,
+    UNIQUE(source, player_id)
+);
+`
+
+const createRankingSourcesTable = `
+CREATE TABLE IF NOT EXISTS ranking_sources (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    season INTEGER NOT NULL DEFAULT 0,
+    kind TEXT NOT NULL DEFAULT 'imported',
+    UNIQUE(name, season)
+);
+`
+
+const createSourceRanksTable = `
+CREATE TABLE IF NOT EXISTS source_ranks (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    source_id INTEGER NOT NULL,
+    player_id INTEGER NOT NULL,
+    format TEXT NOT NULL,
+    rank INTEGER NOT NULL,
+    FOREIGN KEY (source_id) REFERENCES ranking_sources(id) ON DELETE CASCADE,
+    FOREIGN KEY (player_id) REFERENCES players(id) ON DELETE CASCADE,
+    UNIQUE(source_id, player_id, format)
+);
+`
+
+const createDraftRankSourcesTable = `
+CREATE TABLE IF NOT EXISTS draft_rank_sources (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    draft_id INTEGER NOT NULL UNIQUE,
+    source_id INTEGER,
+    consensus BOOLEAN NOT NULL DEFAULT 0,
+    FOREIGN KEY (draft_id) REFERENCES drafts(id) ON DELETE CASCADE,
+    FOREIGN KEY (source_id) REFERENCES ranking_sources(id) ON DELETE CASCADE
+);
+`
+
+// consensus_ranks orders players within a season and format by their mean
+// rank across every ranking source for that season. It is recreated on
+// every start so its definition can change.
+const createConsensusRanksView = `
+DROP VIEW IF EXISTS consensus_ranks;
+CREATE VIEW consensus_ranks AS
+SELECT sr.player_id, sr.format, s.season, AVG(sr.rank) AS avg_rank, MIN(sr.rank) AS best_rank
+FROM source_ranks sr
+JOIN ranking_sources s ON s.id = sr.source_id
+WHERE s.kind != 'adp'
+GROUP BY sr.player_id, sr.format, s.season;
+`
+
+const createSeasonsTable = `
+CREATE TABLE IF NOT EXISTS seasons (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    year INTEGER NOT NULL UNIQUE,
+    is_current BOOLEAN NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+`
+
+// player_seasons archives each player's team, bye week and ranks for a
+// season once it has been rolled over. The players table holds the current
+// season.
+const createPlayerSeasonsTable = `
+CREATE TABLE IF NOT EXISTS player_seasons (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    player_id INTEGER NOT NULL,
+    season INTEGER NOT NULL,
+    team TEXT NOT NULL,
+    bye_week INTEGER,
+    dynasty_rank INTEGER,
+    sf_rank INTEGER,
+    std_rank INTEGER,
+    half_ppr_rank INTEGER,
+    ppr_rank INTEGER,
+    FOREIGN KEY (player_id) REFERENCES players(id) ON DELETE CASCADE,
+    UNIQUE(player_id, season)
+);
+`
+
+const createPlayerNotesTable = `
+CREATE TABLE IF NOT EXISTS player_notes (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    draft_id INTEGER NOT NULL,
+    team_id INTEGER NOT NULL DEFAULT 0,
+    player_id INTEGER NOT NULL,
+    note TEXT NOT NULL DEFAULT '',
+    tags TEXT NOT NULL DEFAULT '',
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (draft_id) REFERENCES drafts(id) ON DELETE CASCADE,
+    FOREIGN KEY (player_id) REFERENCES players(id),
+    UNIQUE(draft_id, team_id, player_id)
+);
+`
+
+// player_merges records each duplicate player folded into another, keeping
+// the removed row's details since the row itself is gone.
+const createPlayerMergesTable = `
+CREATE TABLE IF NOT EXISTS player_merges (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    kept_player_id INTEGER NOT NULL,
+    merged_player_id INTEGER NOT NULL,
+    merged_name TEXT NOT NULL,
+    merged_team TEXT NOT NULL,
+    merged_position TEXT NOT NULL,
+    picks_moved INTEGER NOT NULL DEFAULT 0,
+    queue_moved INTEGER NOT NULL DEFAULT 0,
+    notes_moved INTEGER NOT NULL DEFAULT 0,
+    merged_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+`
+
+// nfl_teams lists the NFL franchises under their canonical abbreviations.
+// aliases is a comma-separated list of other abbreviations for the team.
+const createNFLTeamsTable = `
+CREATE TABLE IF NOT EXISTS nfl_teams (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    abbr TEXT NOT NULL UNIQUE,
+    name TEXT NOT NULL,
+    conference TEXT NOT NULL,
+    division TEXT NOT NULL,
+    bye_week INTEGER CHECK(bye_week BETWEEN 1 AND 18),
+    aliases TEXT NOT NULL DEFAULT ''
+);
+`
+
+// draft_templates holds saved draft configurations; config is the JSON of a
+// models.DraftConfig.
+const createDraftTemplatesTable = `
+CREATE TABLE IF NOT EXISTS draft_templates (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL,
+    config TEXT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+`
+
+// leagues group a league's drafts across seasons. Each draft in a league
+// belongs to the league season matching its own season.
+const createLeaguesTable = `
+CREATE TABLE IF NOT EXISTS leagues (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL UNIQUE,
+    sport TEXT NOT NULL DEFAULT 'football',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+`
+
+// managers are the people running a league's franchises. A team's manager
+// is who ran it that season; a franchise's is who runs it now.
+const createManagersTable = `
+CREATE TABLE IF NOT EXISTS managers (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    league_id INTEGER NOT NULL,
+    name TEXT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (league_id) REFERENCES leagues(id) ON DELETE CASCADE,
+    UNIQUE(league_id, name)
+);
+`
+
+// franchises persist across a league's seasons; each draft's teams are
+// linked to the franchise they are that season.
+const createFranchisesTable = `
+CREATE TABLE IF NOT EXISTS franchises (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    league_id INTEGER NOT NULL,
+    name TEXT NOT NULL,
+    manager_id INTEGER,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (league_id) REFERENCES leagues(id) ON DELETE CASCADE,
+    FOREIGN KEY (manager_id) REFERENCES managers(id) ON DELETE SET NULL,
+    UNIQUE(league_id, name)
+);
+`
+
+// pick_owners records picks owned by a team other than the one whose turn
+// it is, such as traded future picks carried into a rookie draft.
+const createPickOwnersTable = `
+CREATE TABLE IF NOT EXISTS pick_owners (
+    draft_id INTEGER NOT NULL,
+    overall_pick INTEGER NOT NULL,
+    team_id INTEGER NOT NULL,
+    FOREIGN KEY (draft_id) REFERENCES drafts(id) ON DELETE CASCADE,
+    FOREIGN KEY (team_id) REFERENCES teams(id) ON DELETE CASCADE,
+    PRIMARY KEY (draft_id, overall_pick)
+);
+`
+
+// carried_players are the players a rookie draft's teams already roster,
+// carried over from the league's previous draft.
+const createCarriedPlayersTable = `
+CREATE TABLE IF NOT EXISTS carried_players (
+    draft_id INTEGER NOT NULL,
+    team_id INTEGER NOT NULL,
+    player_id INTEGER NOT NULL,
+    FOREIGN KEY (draft_id) REFERENCES drafts(id) ON DELETE CASCADE,
+    FOREIGN KEY (team_id) REFERENCES teams(id) ON DELETE CASCADE,
+    FOREIGN KEY (player_id) REFERENCES players(id) ON DELETE CASCADE,
+    PRIMARY KEY (draft_id, player_id)
+);
+`
+
+// future_picks records a league's traded picks in drafts not yet created:
+// the season's pick in a round that originally belonged to one franchise
+// and is now owned by another.
+const createFuturePicksTable = `
+CREATE TABLE IF NOT EXISTS future_picks (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    league_id INTEGER NOT NULL,
+    season INTEGER NOT NULL,
+    round INTEGER NOT NULL CHECK(round >= 1),
+    original_franchise_id INTEGER NOT NULL,
+    owner_franchise_id INTEGER NOT NULL,
+    note TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (league_id) REFERENCES leagues(id) ON DELETE CASCADE,
+    FOREIGN KEY (original_franchise_id) REFERENCES franchises(id) ON DELETE CASCADE,
+    FOREIGN KEY (owner_franchise_id) REFERENCES franchises(id) ON DELETE CASCADE,
+    UNIQUE(league_id, season, round, original_franchise_id)
+);
+`
+
+// SearchName returns the SQL expression for a name column as it is indexed
+// for search: lower case, without the punctuation that splits names like
+// Ja'Marr or A.J. into separate words. FTS5 folds the accents itself.
+func SearchName(column string) string {
+	return fmt.Sprintf(`lower(replace(replace(replace(replace(%s, '''', ''), '’', ''), '.', ''), '-', ' '))`, column)
+}
+
+const createPlayersFTSTable = `
+CREATE VIRTUAL TABLE IF NOT EXISTS players_fts USING fts5(
+    name, team, position,
+    tokenize = 'unicode61 remove_diacritics 2'
+);
+`
+
+var createPlayersFTSTriggers = `
+CREATE TRIGGER IF NOT EXISTS players_fts_insert AFTER INSERT ON players BEGIN
+    INSERT INTO players_fts (rowid, name, team, position)
+    VALUES (new.id, ` + SearchName("new.name") + `, new.team, new.position);
+END;
+CREATE TRIGGER IF NOT EXISTS players_fts_update AFTER UPDATE ON players BEGIN
+    DELETE FROM players_fts WHERE rowid = old.id;
+    INSERT INTO players_fts (rowid, name, team, position)
+    VALUES (new.id, ` + SearchName("new.name") + `, new.team, new.position);
+END;
+CREATE TRIGGER IF NOT EXISTS players_fts_delete AFTER DELETE ON players BEGIN
+    DELETE FROM players_fts WHERE rowid = old.id;
+END;
+`
+
+var rebuildPlayersFTS = `
+DELETE FROM players_fts;
+INSERT INTO players_fts (rowid, name, team, position)
+SELECT id, ` + SearchName("name") + `, team, position FROM players;
+`
+
+const dropPlayersFTSTriggers = `
+DROP TRIGGER IF EXISTS players_fts_insert;
+DROP TRIGGER IF EXISTS players_fts_update;
+DROP TRIGGER IF EXISTS players_fts_delete;
+`
+
+// player_platform_ids maps players to their IDs on fantasy hosting
+// sites, which platform exports write in place of our own IDs.
+const createPlayerPlatformIDsTable = `
+CREATE TABLE IF NOT EXISTS player_platform_ids (
+    player_id INTEGER NOT NULL,
+    platform TEXT NOT NULL,
+    platform_id TEXT NOT NULL,
+    FOREIGN KEY (player_id) REFERENCES players(id) ON DELETE CASCADE,
+    PRIMARY KEY (platform, player_id),
+    UNIQUE(platform, platform_id)
+);
+`
+
 const createIndexes = `
 CREATE INDEX IF NOT EXISTS idx_players_position ON players(position);
 CREATE INDEX IF NOT EXISTS idx_players_team ON players(team);
@@ -141,5 +965,13 @@ CREATE INDEX IF NOT EXISTS idx_teams_draft ON teams(draft_id);
 CREATE INDEX IF NOT EXISTS idx_draft_queue_draft ON draft_queue(draft_id);
 CREATE INDEX IF NOT EXISTS idx_audit_log_draft ON audit_log(draft_id);
 CREATE INDEX IF NOT EXISTS idx_drafts_status ON drafts(status);
+CREATE INDEX IF NOT EXISTS idx_player_projections_player ON player_projections(player_id);
+CREATE INDEX IF NOT EXISTS idx_roster_slots_draft ON roster_slots(draft_id);
+CREATE INDEX IF NOT EXISTS idx_scoring_rules_draft ON scoring_rules(draft_id);
+CREATE INDEX IF NOT EXISTS idx_draft_rankings_draft ON draft_rankings(draft_id, rank);
+CREATE INDEX IF NOT EXISTS idx_player_tiers_source ON player_tiers(source, position, tier);
+CREATE INDEX IF NOT EXISTS idx_source_ranks_format ON source_ranks(format, player_id);
+CREATE INDEX IF NOT EXISTS idx_player_seasons_season ON player_seasons(season, player_id);
+CREATE INDEX IF NOT EXISTS idx_player_notes_draft ON player_notes(draft_id, player_id);
 `
 
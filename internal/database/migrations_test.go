@@ -0,0 +1,177 @@
+package database
+
+import (
+	"database/sql"
+	"path/filepath"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// TestRunMigrations_WithoutFTS5 opens a database last used by a build with
+// FTS5: it has the search index and its triggers, which this build can't
+// write to.
+func TestRunMigrations_WithoutFTS5(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "draft-board.db")
+	db, err := NewDB(path)
+	if err != nil {
+		t.Fatalf("NewDB() error = %v", err)
+	}
+	if fts, _ := hasFTS5(db); fts {
+		db.Close()
+		t.Skip("SQLite has FTS5")
+	}
+	if _, err := db.Exec(`INSERT INTO players (name, team, position) VALUES ('Josh Allen', 'BUF', 'QB')`); err != nil {
+		t.Fatalf("failed to add player: %v", err)
+	}
+
+	// This build can't create an FTS5 table, so write the index into the
+	// schema as an FTS5 build leaves it.
+	fake := []string{
+		`PRAGMA writable_schema = ON`,
+		`INSERT INTO sqlite_master (type, name, tbl_name, rootpage, sql)
+		 VALUES ('table', 'players_fts', 'players_fts', 0, 'CREATE VIRTUAL TABLE players_fts USING fts5(name, team, position)')`,
+		`PRAGMA writable_schema = OFF`,
+	}
+	for _, stmt := range fake {
+		if _, err := db.Exec(stmt); err != nil {
+			t.Fatalf("failed to fake search index: %v", err)
+		}
+	}
+	if _, err := db.Exec(createPlayersFTSTriggers); err != nil {
+		t.Fatalf("failed to create search triggers: %v", err)
+	}
+	db.Close()
+
+	db, err = NewDB(path)
+	if err != nil {
+		t.Fatalf("NewDB() on a database from an FTS5 build error = %v", err)
+	}
+	defer db.Close()
+
+	if _, err := db.Exec(`UPDATE players SET team = 'KC' WHERE name = 'Josh Allen'`); err != nil {
+		t.Errorf("updating a player error = %v", err)
+	}
+	if _, err := db.Exec(`INSERT INTO players (name, team, position) VALUES ('Lamar Jackson', 'BAL', 'QB')`); err != nil {
+		t.Errorf("adding a player error = %v", err)
+	}
+	var triggers int
+	if err := db.QueryRow(`SELECT COUNT(*) FROM sqlite_master WHERE type = 'trigger' AND name LIKE 'players_fts_%'`).Scan(&triggers); err != nil {
+		t.Fatal(err)
+	}
+	if triggers != 0 {
+		t.Errorf("%d search triggers left, want 0", triggers)
+	}
+}
+
+// TestRunMigrations_ScopesSourceNames upgrades a ranking_sources table
+// from when source names were unique across seasons.
+func TestRunMigrations_ScopesSourceNames(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "draft-board.db")
+	db, err := sql.Open("sqlite3", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	old := []string{
+		`CREATE TABLE ranking_sources (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			name TEXT NOT NULL UNIQUE,
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+			season INTEGER NOT NULL DEFAULT 0
+		)`,
+		`INSERT INTO ranking_sources (name, season) VALUES ('FantasyPros', 2025)`,
+	}
+	for _, stmt := range old {
+		if _, err := db.Exec(stmt); err != nil {
+			t.Fatalf("failed to create old schema: %v", err)
+		}
+	}
+	db.Close()
+
+	db, err = NewDB(path)
+	if err != nil {
+		t.Fatalf("NewDB() error = %v", err)
+	}
+	defer db.Close()
+
+	var kind string
+	if err := db.QueryRow(`SELECT kind FROM ranking_sources WHERE name = 'FantasyPros' AND season = 2025`).Scan(&kind); err != nil || kind != "imported" {
+		t.Errorf("kept source kind = %q, %v; want imported", kind, err)
+	}
+	if _, err := db.Exec(`INSERT INTO ranking_sources (name, season) VALUES ('FantasyPros', 2026)`); err != nil {
+		t.Errorf("adding the name for a new season error = %v", err)
+	}
+	if _, err := db.Exec(`INSERT INTO ranking_sources (name, season) VALUES ('FantasyPros', 2026)`); err == nil {
+		t.Error("a name should stay unique within a season")
+	}
+	if _, err := db.Exec(`SELECT * FROM consensus_ranks`); err != nil {
+		t.Errorf("consensus view error = %v", err)
+	}
+}
+
+// TestRunMigrations_DropsScoringFormatCheck upgrades a drafts table from
+// when every sport's drafts were stored under football's scoring formats.
+func TestRunMigrations_DropsScoringFormatCheck(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "draft-board.db")
+	db, err := sql.Open("sqlite3", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	old := []string{
+		`CREATE TABLE drafts (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			name TEXT NOT NULL,
+			num_teams INTEGER NOT NULL CHECK(num_teams BETWEEN 2 AND 14),
+			scoring_format TEXT NOT NULL CHECK(scoring_format IN ('Standard', 'Half-PPR', 'PPR')),
+			draft_type TEXT NOT NULL CHECK(draft_type IN ('Redraft', 'Dynasty')),
+			qb_setting TEXT DEFAULT '1QB',
+			snake_draft BOOLEAN DEFAULT TRUE,
+			status TEXT DEFAULT 'setup' CHECK(status IN ('setup', 'active', 'paused', 'completed')),
+			max_rounds INTEGER DEFAULT 16,
+			commissioner_id TEXT,
+			created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+			completed BOOLEAN DEFAULT FALSE,
+			season INTEGER NOT NULL DEFAULT 0,
+			sport TEXT NOT NULL DEFAULT 'football'
+		)`,
+		`CREATE TABLE draft_templates (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			name TEXT NOT NULL,
+			config TEXT NOT NULL,
+			created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+		)`,
+		`INSERT INTO drafts (name, num_teams, scoring_format, draft_type, season, sport) VALUES
+			('Gridiron', 12, 'PPR', 'Redraft', 2025, 'football'),
+			('Hoops', 10, 'PPR', 'Redraft', 2025, 'basketball'),
+			('Diamond', 12, 'PPR', 'Redraft', 2025, 'baseball'),
+			('Diamond Points', 12, 'Standard', 'Redraft', 2025, 'baseball')`,
+		`INSERT INTO draft_templates (name, config) VALUES ('Hoops', '{"sport":"basketball","scoring_format":"PPR"}')`,
+	}
+	for _, stmt := range old {
+		if _, err := db.Exec(stmt); err != nil {
+			t.Fatalf("failed to create old schema: %v", err)
+		}
+	}
+	db.Close()
+
+	db, err = NewDB(path)
+	if err != nil {
+		t.Fatalf("NewDB() error = %v", err)
+	}
+	defer db.Close()
+
+	want := map[string]string{"Gridiron": "PPR", "Hoops": "Categories", "Diamond": "Roto", "Diamond Points": "Points"}
+	for name, format := range want {
+		var got string
+		if err := db.QueryRow(`SELECT scoring_format FROM drafts WHERE name = ?`, name).Scan(&got); err != nil || got != format {
+			t.Errorf("%s scoring format = %q, %v; want %q", name, got, err, format)
+		}
+	}
+	var config string
+	if err := db.QueryRow(`SELECT json_extract(config, '$.scoring_format') FROM draft_templates`).Scan(&config); err != nil || config != "Categories" {
+		t.Errorf("template scoring format = %q, %v; want Categories", config, err)
+	}
+	if _, err := db.Exec(`INSERT INTO drafts (name, num_teams, scoring_format, draft_type) VALUES ('Rink', 10, 'Roto', 'Redraft')`); err != nil {
+		t.Errorf("a sport's own scoring format should be stored: %v", err)
+	}
+}
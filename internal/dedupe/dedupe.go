@@ -0,0 +1,47 @@
+package dedupe
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/vibes/draft-board/internal/models"
+	"github.com/vibes/draft-board/internal/names"
+)
+
+// Key is what two rows of the same player share: the normalized name,
+// position and NFL team.
+func Key(player *models.Player) string {
+	return names.Normalize(player.Name) + "|" + player.Position + "|" + strings.ToUpper(strings.TrimSpace(player.Team))
+}
+
+// Groups finds players entered more than once. Each group lists the rows
+// of one player, the one to keep first: imported players before custom
+// ones, then the oldest. Groups are ordered by name.
+func Groups(players []*models.Player) [][]*models.Player {
+	byKey := make(map[string][]*models.Player)
+	var keys []string
+	for _, player := range players {
+		key := Key(player)
+		if _, ok := byKey[key]; !ok {
+			keys = append(keys, key)
+		}
+		byKey[key] = append(byKey[key], player)
+	}
+	sort.Strings(keys)
+
+	var groups [][]*models.Player
+	for _, key := range keys {
+		group := byKey[key]
+		if len(group) < 2 {
+			continue
+		}
+		sort.SliceStable(group, func(i, j int) bool {
+			if group[i].IsCustom != group[j].IsCustom {
+				return !group[i].IsCustom
+			}
+			return group[i].ID < group[j].ID
+		})
+		groups = append(groups, group)
+	}
+	return groups
+}
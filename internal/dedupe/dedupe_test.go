@@ -0,0 +1,42 @@
+package dedupe
+
+import (
+	"testing"
+
+	"github.com/vibes/draft-board/internal/models"
+)
+
+func TestGroups(t *testing.T) {
+	players := []*models.Player{
+		{ID: 1, Name: "Ja'Marr Chase", Team: "CIN", Position: "WR", IsCustom: true},
+		{ID: 2, Name: "Josh Allen", Team: "BUF", Position: "QB"},
+		{ID: 3, Name: "JaMarr Chase", Team: "cin", Position: "WR"},
+		{ID: 4, Name: "Ja'Marr Chase", Team: "CIN", Position: "WR", IsCustom: true},
+		{ID: 5, Name: "Josh Allen", Team: "JAX", Position: "LB"},
+		{ID: 6, Name: "Chase Brown", Team: "CIN", Position: "RB"},
+	}
+
+	got := Groups(players)
+	if len(got) != 1 {
+		t.Fatalf("Groups() returned %d groups, want 1", len(got))
+	}
+	want := []int{3, 1, 4}
+	if len(got[0]) != len(want) {
+		t.Fatalf("group = %d players, want %d", len(got[0]), len(want))
+	}
+	for i, id := range want {
+		if got[0][i].ID != id {
+			t.Errorf("group[%d] = player %d, want %d", i, got[0][i].ID, id)
+		}
+	}
+}
+
+func TestGroupsNone(t *testing.T) {
+	players := []*models.Player{
+		{ID: 1, Name: "Josh Allen", Team: "BUF", Position: "QB"},
+		{ID: 2, Name: "Josh Allen", Team: "JAX", Position: "LB"},
+	}
+	if got := Groups(players); len(got) != 0 {
+		t.Errorf("Groups() = %v, want none", got)
+	}
+}
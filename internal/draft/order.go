@@ -0,0 +1,92 @@
+package draft
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+
+	"github.com/vibes/draft-board/internal/models"
+)
+
+// Orders for the teams of a draft created from a template or another draft.
+const (
+	OrderKeep    = "keep"
+	OrderReverse = "reverse"
+	OrderRandom  = "random"
+)
+
+// Reorder returns the teams with new draft positions. OrderKeep keeps the
+// positions they had. OrderReverse gives the first pick to the team that
+// finished last in standings, a list of team names best first; teams not
+// in it pick after those that are, in their old order. OrderRandom
+// shuffles the order with rng.
+func Reorder(teams []models.ConfigTeam, order string, standings []string, rng *rand.Rand) ([]models.ConfigTeam, error) {
+	sorted := make([]models.ConfigTeam, len(teams))
+	copy(sorted, teams)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].DraftPosition < sorted[j].DraftPosition
+	})
+
+	switch order {
+	case OrderKeep, "":
+	case OrderReverse:
+		finish := make(map[string]int, len(standings))
+		for i, name := range standings {
+			finish[name] = i + 1
+		}
+		sort.SliceStable(sorted, func(i, j int) bool {
+			fi, fj := finish[sorted[i].TeamName], finish[sorted[j].TeamName]
+			if fi == 0 || fj == 0 {
+				return fi != 0 && fj == 0
+			}
+			return fi > fj
+		})
+	case OrderRandom:
+		rng.Shuffle(len(sorted), func(i, j int) {
+			sorted[i], sorted[j] = sorted[j], sorted[i]
+		})
+	default:
+		return nil, fmt.Errorf("unknown draft order %q", order)
+	}
+
+	for i := range sorted {
+		sorted[i].DraftPosition = i + 1
+	}
+	return sorted, nil
+}
+
+// Standings returns the teams' names in the order they finished, best
+// first, as Reorder takes them. Finish gives each team's place by team ID,
+// 1 for the champion.
+func Standings(teams []models.Team, finish map[int]int) ([]string, error) {
+	if err := checkFinish(teams, finish); err != nil {
+		return nil, err
+	}
+	ordered := make([]models.Team, len(teams))
+	copy(ordered, teams)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return finish[ordered[i].ID] < finish[ordered[j].ID]
+	})
+	names := make([]string, len(ordered))
+	for i, team := range ordered {
+		names[i] = team.TeamName
+	}
+	return names, nil
+}
+
+// checkFinish checks that every team has its own place from 1 to the
+// number of teams.
+func checkFinish(teams []models.Team, finish map[int]int) error {
+	places := make(map[int]bool, len(teams))
+	for _, team := range teams {
+		place := finish[team.ID]
+		if place < 1 || place > len(teams) {
+			return fmt.Errorf("%s needs a finish from 1 to %d", team.TeamName, len(teams))
+		}
+		if places[place] {
+			return fmt.Errorf("more than one team finished %d", place)
+		}
+		places[place] = true
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package draft
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/vibes/draft-board/internal/models"
+)
+
+func teamNames(teams []models.ConfigTeam) []string {
+	names := make([]string, len(teams))
+	for i, team := range teams {
+		if team.DraftPosition != i+1 {
+			return nil
+		}
+		names[i] = team.TeamName
+	}
+	return names
+}
+
+func TestReorder(t *testing.T) {
+	teams := []models.ConfigTeam{
+		{TeamName: "Bravo", DraftPosition: 2},
+		{TeamName: "Alpha", DraftPosition: 1},
+		{TeamName: "Delta", DraftPosition: 4},
+		{TeamName: "Charlie", DraftPosition: 3},
+	}
+
+	tests := []struct {
+		name      string
+		order     string
+		standings []string
+		want      []string
+	}{
+		{"keep", OrderKeep, nil, []string{"Alpha", "Bravo", "Charlie", "Delta"}},
+		{"reverse", OrderReverse, []string{"Charlie", "Alpha", "Delta", "Bravo"}, []string{"Bravo", "Delta", "Alpha", "Charlie"}},
+		{"reverse with unranked", OrderReverse, []string{"Delta", "Bravo"}, []string{"Bravo", "Delta", "Alpha", "Charlie"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Reorder(teams, tt.order, tt.standings, nil)
+			if err != nil {
+				t.Fatalf("Reorder() error = %v", err)
+			}
+			if names := teamNames(got); !reflect.DeepEqual(names, tt.want) {
+				t.Errorf("Reorder() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if teams[0].TeamName != "Bravo" || teams[0].DraftPosition != 2 {
+		t.Error("Reorder() changed its input")
+	}
+}
+
+func TestReorderRandom(t *testing.T) {
+	teams := []models.ConfigTeam{
+		{TeamName: "Alpha", DraftPosition: 1},
+		{TeamName: "Bravo", DraftPosition: 2},
+		{TeamName: "Charlie", DraftPosition: 3},
+	}
+	got, err := Reorder(teams, OrderRandom, nil, rand.New(rand.NewSource(1)))
+	if err != nil {
+		t.Fatalf("Reorder() error = %v", err)
+	}
+	names := teamNames(got)
+	if len(names) != 3 {
+		t.Fatalf("Reorder() = %v, want positions 1 to 3", got)
+	}
+	seen := make(map[string]bool)
+	for _, name := range names {
+		seen[name] = true
+	}
+	if len(seen) != 3 {
+		t.Errorf("Reorder() lost a team: %v", names)
+	}
+
+	if _, err := Reorder(teams, "sideways", nil, nil); err == nil {
+		t.Error("Reorder() should reject unknown orders")
+	}
+}
+
+func TestStandings(t *testing.T) {
+	teams := []models.Team{
+		{ID: 10, TeamName: "Alpha"},
+		{ID: 11, TeamName: "Bravo"},
+		{ID: 12, TeamName: "Charlie"},
+	}
+	got, err := Standings(teams, map[int]int{10: 2, 11: 3, 12: 1})
+	if err != nil {
+		t.Fatalf("Standings() error = %v", err)
+	}
+	if want := []string{"Charlie", "Alpha", "Bravo"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Standings() = %v, want %v", got, want)
+	}
+
+	for _, finish := range []map[int]int{
+		{10: 1, 11: 2},
+		{10: 1, 11: 1, 12: 2},
+		{10: 1, 11: 2, 12: 4},
+	} {
+		if _, err := Standings(teams, finish); err == nil {
+			t.Errorf("Standings(%v) should fail", finish)
+		}
+	}
+}
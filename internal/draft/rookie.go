@@ -0,0 +1,65 @@
+package draft
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/vibes/draft-board/internal/models"
+)
+
+// PlanRookieDraft lays out a dynasty league's rookie draft from its
+// previous draft. Finish gives each previous team's place in last season's
+// standings, 1 for the champion; the last-place team picks first in every
+// round. Each team carries over its roster from rosters, keyed by previous
+// team ID. Trades of the season's picks within the draft's rounds move
+// those picks to the franchise that owns them.
+func PlanRookieDraft(teams []models.Team, finish map[int]int, rosters map[int][]int, season, rounds int, trades []models.FuturePick) (*models.RookieDraftPlan, error) {
+	if err := checkFinish(teams, finish); err != nil {
+		return nil, err
+	}
+
+	ordered := make([]models.Team, len(teams))
+	copy(ordered, teams)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return finish[ordered[i].ID] > finish[ordered[j].ID]
+	})
+
+	plan := &models.RookieDraftPlan{
+		Rosters: make(map[int][]int),
+		Owners:  make(map[int]int),
+	}
+	byFranchise := make(map[int]int)
+	for i, team := range ordered {
+		position := i + 1
+		plan.Teams = append(plan.Teams, models.Team{
+			TeamName:      team.TeamName,
+			OwnerName:     team.OwnerName,
+			DraftPosition: position,
+			FranchiseID:   team.FranchiseID,
+			ManagerID:     team.ManagerID,
+		})
+		if len(rosters[team.ID]) > 0 {
+			plan.Rosters[position] = rosters[team.ID]
+		}
+		if team.FranchiseID != nil {
+			byFranchise[*team.FranchiseID] = position
+		}
+	}
+
+	for _, trade := range trades {
+		if trade.Season != season || trade.Round > rounds || trade.OriginalFranchiseID == trade.OwnerFranchiseID {
+			continue
+		}
+		original, ok := byFranchise[trade.OriginalFranchiseID]
+		if !ok {
+			return nil, fmt.Errorf("no team for franchise %d, which traded its round %d pick", trade.OriginalFranchiseID, trade.Round)
+		}
+		owner, ok := byFranchise[trade.OwnerFranchiseID]
+		if !ok {
+			return nil, fmt.Errorf("no team for franchise %d, which owns a traded round %d pick", trade.OwnerFranchiseID, trade.Round)
+		}
+		plan.Owners[(trade.Round-1)*len(teams)+original] = owner
+	}
+
+	return plan, nil
+}
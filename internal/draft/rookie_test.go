@@ -0,0 +1,78 @@
+package draft
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/vibes/draft-board/internal/models"
+)
+
+func intPtr(i int) *int { return &i }
+
+func rookieTeams() []models.Team {
+	return []models.Team{
+		{ID: 1, TeamName: "Aces", OwnerName: "Ann", DraftPosition: 1, FranchiseID: intPtr(10), ManagerID: intPtr(100)},
+		{ID: 2, TeamName: "Bears", OwnerName: "Bob", DraftPosition: 2, FranchiseID: intPtr(20)},
+		{ID: 3, TeamName: "Colts", OwnerName: "Cy", DraftPosition: 3, FranchiseID: intPtr(30)},
+	}
+}
+
+func TestPlanRookieDraft(t *testing.T) {
+	finish := map[int]int{1: 1, 2: 3, 3: 2}
+	rosters := map[int][]int{1: {501, 502}, 2: {503}}
+	trades := []models.FuturePick{
+		// The Bears' round 2 pick now belongs to the Aces.
+		{Season: 2026, Round: 2, OriginalFranchiseID: 20, OwnerFranchiseID: 10},
+		// Other seasons and rounds past the draft's are left alone.
+		{Season: 2027, Round: 1, OriginalFranchiseID: 30, OwnerFranchiseID: 10},
+		{Season: 2026, Round: 5, OriginalFranchiseID: 30, OwnerFranchiseID: 10},
+	}
+
+	plan, err := PlanRookieDraft(rookieTeams(), finish, rosters, 2026, 3, trades)
+	if err != nil {
+		t.Fatalf("PlanRookieDraft() error = %v", err)
+	}
+
+	var order []string
+	for i, team := range plan.Teams {
+		if team.DraftPosition != i+1 {
+			t.Fatalf("team %s at position %d, want %d", team.TeamName, team.DraftPosition, i+1)
+		}
+		order = append(order, team.TeamName)
+	}
+	if want := []string{"Bears", "Colts", "Aces"}; !reflect.DeepEqual(order, want) {
+		t.Errorf("order = %v, want %v", order, want)
+	}
+	if plan.Teams[2].ManagerID == nil || *plan.Teams[2].ManagerID != 100 {
+		t.Errorf("Aces lost their manager: %+v", plan.Teams[2])
+	}
+
+	if want := map[int][]int{3: {501, 502}, 1: {503}}; !reflect.DeepEqual(plan.Rosters, want) {
+		t.Errorf("rosters = %v, want %v", plan.Rosters, want)
+	}
+	// The Bears pick first, so their round 2 pick is 4th overall.
+	if want := map[int]int{4: 3}; !reflect.DeepEqual(plan.Owners, want) {
+		t.Errorf("owners = %v, want %v", plan.Owners, want)
+	}
+}
+
+func TestPlanRookieDraftErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		finish map[int]int
+		trades []models.FuturePick
+	}{
+		{"missing finish", map[int]int{1: 1, 2: 2}, nil},
+		{"shared finish", map[int]int{1: 1, 2: 1, 3: 2}, nil},
+		{"finish out of range", map[int]int{1: 1, 2: 2, 3: 4}, nil},
+		{"traded pick of a franchise not in the draft", map[int]int{1: 1, 2: 2, 3: 3},
+			[]models.FuturePick{{Season: 2026, Round: 1, OriginalFranchiseID: 99, OwnerFranchiseID: 10}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := PlanRookieDraft(rookieTeams(), tt.finish, nil, 2026, 3, tt.trades); err == nil {
+				t.Error("PlanRookieDraft() should fail")
+			}
+		})
+	}
+}
@@ -0,0 +1,368 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/vibes/draft-board/internal/adp"
+	"github.com/vibes/draft-board/internal/models"
+	"github.com/vibes/draft-board/internal/repository"
+	"github.com/vibes/draft-board/internal/sport"
+)
+
+// adpPageSize is how many players the historical ADP page lists.
+const adpPageSize = 300
+
+// historyFilter reads the historical ADP filters from the request. Dates
+// and sports that don't parse are ignored.
+func historyFilter(r *http.Request) (repository.HistoryFilter, int) {
+	filter := repository.HistoryFilter{
+		ScoringFormat: r.FormValue("scoring_format"),
+		DraftType:     r.FormValue("draft_type"),
+	}
+	if key := r.FormValue("sport"); key != "" {
+		if p, ok := sport.Lookup(key); ok {
+			filter.Sport = p.Key
+		}
+	}
+	filter.NumTeams, _ = strconv.Atoi(r.FormValue("num_teams"))
+	for _, d := range []struct {
+		value string
+		field *string
+	}{{r.FormValue("from"), &filter.From}, {r.FormValue("to"), &filter.To}} {
+		if _, err := time.Parse("2006-01-02", d.value); err == nil {
+			*d.field = d.value
+		}
+	}
+
+	minCount, err := strconv.Atoi(r.FormValue("min_count"))
+	if err != nil || minCount < 1 {
+		minCount = 1
+	}
+	return filter, minCount
+}
+
+// historyQuery encodes the filters back into a query string.
+func historyQuery(filter repository.HistoryFilter, minCount int) string {
+	values := url.Values{}
+	values.Set("sport", filter.Sport)
+	values.Set("scoring_format", filter.ScoringFormat)
+	values.Set("draft_type", filter.DraftType)
+	if filter.NumTeams > 0 {
+		values.Set("num_teams", strconv.Itoa(filter.NumTeams))
+	}
+	values.Set("from", filter.From)
+	values.Set("to", filter.To)
+	values.Set("min_count", strconv.Itoa(minCount))
+	return values.Encode()
+}
+
+// historyLabel names a scoring format the way its sport does, with the
+// sport's name for drafts other than football.
+func historyLabel(sportKey, scoringFormat string) string {
+	p := sport.Get(sportKey)
+	if p.Key == sport.Football {
+		return p.FormatLabel(scoringFormat)
+	}
+	return p.Name + " " + p.FormatLabel(scoringFormat)
+}
+
+// GetHistoricalADP shows ADP from the completed drafts matching the filters
+func (h *Handler) GetHistoricalADP(w http.ResponseWriter, r *http.Request) {
+	filter, minCount := historyFilter(r)
+	picks, err := h.pickRepo.GetCompleted(filter)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	drafts, err := h.draftRepo.List()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	type group struct {
+		Sport         string
+		ScoringFormat string
+		DraftType     string
+		NumTeams      int
+		Drafts        int
+	}
+	groupIndex := make(map[string]*group)
+	var groups []*group
+	for _, d := range drafts {
+		if !d.IsCompleted() || d.Rookie {
+			continue
+		}
+		key := fmt.Sprintf("%s|%s|%s|%d", d.Sport, d.ScoringFormat, d.DraftType, d.NumTeams)
+		if groupIndex[key] == nil {
+			groupIndex[key] = &group{Sport: d.Sport, ScoringFormat: d.ScoringFormat, DraftType: d.DraftType, NumTeams: d.NumTeams}
+			groups = append(groups, groupIndex[key])
+		}
+		groupIndex[key].Drafts++
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		if groups[i].Drafts != groups[j].Drafts {
+			return groups[i].Drafts > groups[j].Drafts
+		}
+		if groups[i].Sport != groups[j].Sport {
+			return groups[i].Sport < groups[j].Sport
+		}
+		if groups[i].ScoringFormat != groups[j].ScoringFormat {
+			return groups[i].ScoringFormat < groups[j].ScoringFormat
+		}
+		if groups[i].DraftType != groups[j].DraftType {
+			return groups[i].DraftType < groups[j].DraftType
+		}
+		return groups[i].NumTeams < groups[j].NumTeams
+	})
+
+	draftIDs := make(map[int]bool)
+	for _, pick := range picks {
+		draftIDs[pick.DraftID] = true
+	}
+	stats := adp.Build(picks, minCount)
+	if len(stats) > adpPageSize {
+		stats = stats[:adpPageSize]
+	}
+
+	selected := func(match bool) string {
+		if match {
+			return "selected"
+		}
+		return ""
+	}
+	inputClass := "px-4 py-2 bg-tokyo-night-bg border border-tokyo-night-border rounded-lg text-tokyo-night-fg focus:outline-none focus:border-tokyo-night-accent"
+	numTeams := ""
+	if filter.NumTeams > 0 {
+		numTeams = strconv.Itoa(filter.NumTeams)
+	}
+	var sportOptions, formatOptions strings.Builder
+	for _, p := range sport.Profiles {
+		sportOptions.WriteString(fmt.Sprintf(`<option value="%s" %s>%s</option>`, p.Key, selected(filter.Sport == p.Key), p.Name))
+		if filter.Sport != "" && filter.Sport != p.Key {
+			continue
+		}
+		formatOptions.WriteString(fmt.Sprintf(`<optgroup label="%s">`, p.Name))
+		for _, column := range p.ScoringFormats() {
+			formatOptions.WriteString(fmt.Sprintf(`<option value="%s" %s>%s</option>`,
+				column.ScoringFormat, selected(filter.ScoringFormat == column.ScoringFormat), column.Label))
+		}
+		formatOptions.WriteString(`</optgroup>`)
+	}
+
+	var content strings.Builder
+	content.WriteString(fmt.Sprintf(`
+		<div class="mb-8">
+			<a href="/players/rankings" class="text-tokyo-night-fg-dim hover:text-tokyo-night-accent mb-4 inline-block">← Back to Ranking Sources</a>
+			<h1 class="text-4xl font-bold mb-2 text-tokyo-night-accent">Historical ADP</h1>
+			<p class="text-tokyo-night-fg-dim">Where players actually went in your completed drafts</p>
+		</div>
+		<form method="GET" action="/players/adp" class="mb-6 flex flex-wrap items-end gap-4">
+			<div>
+				<label class="block text-sm font-medium mb-2 text-tokyo-night-fg">Sport</label>
+				<select name="sport" class="%s">
+					<option value="">Any</option>
+					%s
+				</select>
+			</div>
+			<div>
+				<label class="block text-sm font-medium mb-2 text-tokyo-night-fg">Scoring</label>
+				<select name="scoring_format" class="%s">
+					<option value="">Any</option>
+					%s
+				</select>
+			</div>
+			<div>
+				<label class="block text-sm font-medium mb-2 text-tokyo-night-fg">Type</label>
+				<select name="draft_type" class="%s">
+					<option value="">Any</option>
+					<option value="Redraft" %s>Redraft</option>
+					<option value="Dynasty" %s>Dynasty</option>
+				</select>
+			</div>
+			<div>
+				<label class="block text-sm font-medium mb-2 text-tokyo-night-fg">Teams</label>
+				<input type="number" name="num_teams" value="%s" min="2" max="32" placeholder="Any" class="%s w-24">
+			</div>
+			<div>
+				<label class="block text-sm font-medium mb-2 text-tokyo-night-fg">From</label>
+				<input type="date" name="from" value="%s" class="%s">
+			</div>
+			<div>
+				<label class="block text-sm font-medium mb-2 text-tokyo-night-fg">To</label>
+				<input type="date" name="to" value="%s" class="%s">
+			</div>
+			<div>
+				<label class="block text-sm font-medium mb-2 text-tokyo-night-fg">Min drafts</label>
+				<input type="number" name="min_count" value="%d" min="1" class="%s w-24">
+			</div>
+			<button type="submit" class="px-4 py-2 bg-tokyo-night-accent hover:bg-tokyo-night-accent-hover text-white rounded-lg font-semibold transition-colors">Filter</button>
+			<a href="/players/adp/json?%s" class="px-4 py-2 bg-tokyo-night-bg-light hover:bg-tokyo-night-bg border border-tokyo-night-border rounded-lg transition-colors">JSON</a>
+		</form>
+	`, inputClass, sportOptions.String(), inputClass, formatOptions.String(),
+		inputClass, selected(filter.DraftType == "Redraft"), selected(filter.DraftType == "Dynasty"),
+		numTeams, inputClass, filter.From, inputClass, filter.To, inputClass, minCount, inputClass,
+		historyQuery(filter, minCount)))
+
+	if len(groups) > 0 {
+		content.WriteString(`<div class="mb-6 flex flex-wrap gap-2">`)
+		for _, g := range groups {
+			query := historyQuery(repository.HistoryFilter{
+				Sport: g.Sport, ScoringFormat: g.ScoringFormat, DraftType: g.DraftType, NumTeams: g.NumTeams,
+				From: filter.From, To: filter.To,
+			}, minCount)
+			content.WriteString(fmt.Sprintf(`
+				<a href="/players/adp?%s" class="px-3 py-1 text-sm bg-tokyo-night-bg-light hover:bg-tokyo-night-bg border border-tokyo-night-border rounded-lg transition-colors">
+					%s %s, %d teams <span class="text-tokyo-night-fg-dim">(%d)</span>
+				</a>
+			`, query, template.HTMLEscapeString(historyLabel(g.Sport, g.ScoringFormat)), template.HTMLEscapeString(g.DraftType), g.NumTeams, g.Drafts))
+		}
+		content.WriteString(`</div>`)
+	}
+
+	content.WriteString(fmt.Sprintf(`
+		<p class="mb-4 text-tokyo-night-fg-dim">%d completed drafts match</p>
+		<div class="overflow-x-auto mb-8">
+			<table class="w-full border-collapse bg-tokyo-night-bg-light rounded-lg overflow-hidden">
+				<thead>
+					<tr class="bg-tokyo-night-bg-dark">
+						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">Rank</th>
+						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">Player</th>
+						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">ADP</th>
+						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">Min</th>
+						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">Max</th>
+						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">Drafted</th>
+					</tr>
+				</thead>
+				<tbody>
+	`, len(draftIDs)))
+	for _, stat := range stats {
+		player, err := h.playerRepo.GetByID(stat.PlayerID)
+		if err != nil {
+			continue
+		}
+		content.WriteString(fmt.Sprintf(`
+			<tr class="hover:bg-tokyo-night-bg-dark transition-colors">
+				<td class="px-4 py-2 border-b border-tokyo-night-border font-medium text-tokyo-night-fg">%d</td>
+				<td class="px-4 py-2 border-b border-tokyo-night-border">%s <span class="font-medium text-tokyo-night-fg">%s</span> <span class="text-sm text-tokyo-night-fg-dim">%s</span></td>
+				<td class="px-4 py-2 border-b border-tokyo-night-border text-tokyo-night-fg">%.1f</td>
+				<td class="px-4 py-2 border-b border-tokyo-night-border text-tokyo-night-success">%d</td>
+				<td class="px-4 py-2 border-b border-tokyo-night-border text-tokyo-night-error">%d</td>
+				<td class="px-4 py-2 border-b border-tokyo-night-border text-tokyo-night-fg-dim">%d (%.0f%%)</td>
+			</tr>
+		`, stat.Rank, getPlayerPositionBadges(player), template.HTMLEscapeString(player.Name), player.Team,
+			stat.Average, stat.Min, stat.Max, stat.Count, stat.Frequency*100))
+	}
+	if len(stats) == 0 {
+		content.WriteString(`<tr><td colspan="6" class="px-4 py-8 text-center text-tokyo-night-fg-dim">No completed drafts match these filters</td></tr>`)
+	}
+	content.WriteString(`</tbody></table></div>`)
+
+	if filter.Sport != "" && filter.ScoringFormat != "" && filter.DraftType != "" && len(stats) > 0 {
+		format := models.RankFormat(filter.DraftType, filter.ScoringFormat)
+		name := fmt.Sprintf("League ADP %s %s", historyLabel(filter.Sport, filter.ScoringFormat), filter.DraftType)
+		if filter.NumTeams > 0 {
+			name += fmt.Sprintf(" %d-team", filter.NumTeams)
+		}
+		content.WriteString(fmt.Sprintf(`
+			<div class="max-w-2xl bg-tokyo-night-bg-light rounded-lg border border-tokyo-night-border p-6">
+				<h2 class="text-2xl font-semibold mb-2 text-tokyo-night-fg">Save as Ranking Source</h2>
+				<p class="text-sm text-tokyo-night-fg-dim mb-4">
+					Saves this ADP as the %s ranks of a ranking source drafts can choose. Saving again under
+					the same name refreshes it; the name can't be one of your imported sources. ADP sources
+					are left out of the consensus.
+				</p>
+				<form method="POST" action="/players/adp/source?%s" class="flex flex-wrap gap-4">
+					<input type="text" name="name" value="%s" required class="%s flex-1">
+					<button type="submit" class="px-4 py-2 bg-tokyo-night-accent hover:bg-tokyo-night-accent-hover text-white rounded-lg font-semibold transition-colors">Save Source</button>
+				</form>
+			</div>
+		`, sport.Get(filter.Sport).RankLabel(format), historyQuery(filter, minCount), template.HTMLEscapeString(name), inputClass))
+	}
+
+	renderTemplate(w, content.String(), "Historical ADP")
+}
+
+// GetHistoricalADPJSON returns the historical ADP for the filters as JSON
+func (h *Handler) GetHistoricalADPJSON(w http.ResponseWriter, r *http.Request) {
+	filter, minCount := historyFilter(r)
+	picks, err := h.pickRepo.GetCompleted(filter)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(adp.Build(picks, minCount))
+}
+
+// SaveHistoricalADP stores the historical ADP for the filters as the ranks
+// of a named ranking source for the current season, creating it or
+// refreshing the season's ADP source of that name. Imported sources are
+// never overwritten.
+func (h *Handler) SaveHistoricalADP(w http.ResponseWriter, r *http.Request) {
+	name := strings.TrimSpace(r.FormValue("name"))
+	if name == "" {
+		http.Error(w, "Source name is required", http.StatusBadRequest)
+		return
+	}
+	filter, minCount := historyFilter(r)
+	if filter.Sport == "" || filter.ScoringFormat == "" || filter.DraftType == "" {
+		http.Error(w, "Choose a sport, scoring format and draft type", http.StatusBadRequest)
+		return
+	}
+
+	picks, err := h.pickRepo.GetCompleted(filter)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	stats := adp.Build(picks, minCount)
+	if len(stats) == 0 {
+		http.Error(w, "No completed drafts match these filters", http.StatusBadRequest)
+		return
+	}
+
+	season, err := h.seasonRepo.Current()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	source, err := h.rankSourceRepo.GetByName(name, season)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if source != nil && source.Kind != models.SourceKindADP {
+		http.Error(w, fmt.Sprintf("%q holds imported rankings; save the ADP under another name", name), http.StatusBadRequest)
+		return
+	}
+	if source == nil {
+		source = &models.RankingSource{Name: name, Season: season, Kind: models.SourceKindADP}
+		if err := h.rankSourceRepo.Create(source); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	format := models.RankFormat(filter.DraftType, filter.ScoringFormat)
+	ranks := make([]models.SourceRank, len(stats))
+	for i, stat := range stats {
+		ranks[i] = models.SourceRank{SourceID: source.ID, PlayerID: stat.PlayerID, Format: format, Rank: stat.Rank}
+	}
+	if err := h.rankSourceRepo.ReplaceRanks(source.ID, ranks); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	h.tierRepo.DeleteForSource(sourceTierSource(source.ID, format))
+
+	http.Redirect(w, r, "/players/rankings", http.StatusSeeOther)
+}
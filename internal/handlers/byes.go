@@ -0,0 +1,200 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/vibes/draft-board/internal/byes"
+	"github.com/vibes/draft-board/internal/lineup"
+	"github.com/vibes/draft-board/internal/models"
+	"github.com/vibes/draft-board/internal/sport"
+)
+
+// byeAnalysis builds the bye-week matrix for every team in the draft.
+// Starters are ranked by projected points, falling back to draft order for
+// players without projections.
+func (h *Handler) byeAnalysis(draft *models.Draft) ([]byes.Team, []int, map[int]*models.Player, error) {
+	teams, err := h.teamRepo.GetByDraft(draft.ID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	picks, err := h.pickRepo.GetByDraft(draft.ID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	projs, err := h.projectionRepo.GetAll()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	rules := h.scoringRules(draft)
+
+	players := make(map[int]*models.Player)
+	rosters := make(map[int][]lineup.Entry)
+	playerRepo := h.players(draft)
+	for _, pick := range picks {
+		player, err := playerRepo.GetByID(pick.PlayerID)
+		if err != nil {
+			continue
+		}
+		players[player.ID] = player
+		entry := lineup.Entry{PlayerID: player.ID, Position: player.Position, Positions: player.Positions(), Points: -float64(pick.OverallPick)}
+		if proj, ok := projs[player.ID]; ok {
+			entry.Points = rules.Points(player.Position, proj.Stats)
+		}
+		rosters[pick.TeamID] = append(rosters[pick.TeamID], entry)
+	}
+
+	weeks := byes.Weeks(players)
+	slots := h.rosterSlots(draft)
+	result := make([]byes.Team, 0, len(teams))
+	for _, team := range teams {
+		result = append(result, byes.ForTeam(team, rosters[team.ID], players, slots, weeks))
+	}
+	return result, weeks, players, nil
+}
+
+// GetByeWeeks shows each team's starters on bye by week and position
+func (h *Handler) GetByeWeeks(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	draftID, err := strconv.Atoi(idStr)
+	if err != nil {
+		http.Error(w, "Invalid draft ID", http.StatusBadRequest)
+		return
+	}
+
+	draft, err := h.draftRepo.GetByID(draftID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	teams, weeks, players, err := h.byeAnalysis(draft)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var content strings.Builder
+	content.WriteString(fmt.Sprintf(`
+		<div class="mb-8">
+			<a href="/draft/%d" class="text-tokyo-night-fg-dim hover:text-tokyo-night-accent mb-4 inline-block">← Back to Draft</a>
+			<h1 class="text-4xl font-bold mb-2 text-tokyo-night-accent">Bye Week Analysis</h1>
+			<p class="text-tokyo-night-fg-dim">Starters on bye by week and position. Highlighted weeks can't field a legal lineup.</p>
+		</div>
+	`, draftID))
+
+	content.WriteString(draftReloadScript(draft))
+
+	if len(weeks) == 0 {
+		content.WriteString(`
+			<div class="bg-tokyo-night-bg-light rounded-lg p-8 text-center border border-tokyo-night-border">
+				<p class="text-tokyo-night-fg-dim">No drafted players have bye weeks yet.</p>
+			</div>
+		`)
+		renderTemplate(w, content.String(), "Bye Week Analysis")
+		return
+	}
+
+	positions := sport.Get(draft.Sport).PositionAbbrs()
+	for _, team := range teams {
+		used := make(map[string]bool)
+		for _, wk := range team.Weeks {
+			for pos := range wk.Starters {
+				used[pos] = true
+			}
+		}
+
+		summary := `<span class="text-tokyo-night-success">Legal lineup every week</span>`
+		if len(team.IllegalWeeks) > 0 {
+			var ws []string
+			for _, wk := range team.IllegalWeeks {
+				ws = append(ws, strconv.Itoa(wk))
+			}
+			summary = fmt.Sprintf(`<span class="text-tokyo-night-error">Short in week %s</span>`, strings.Join(ws, ", "))
+		}
+
+		content.WriteString(fmt.Sprintf(`
+			<div class="bg-tokyo-night-bg-light rounded-lg p-6 border border-tokyo-night-border mb-6">
+				<div class="flex justify-between items-center mb-4">
+					<h2 class="text-2xl font-semibold text-tokyo-night-fg">%s</h2>
+					<div class="text-sm">%s</div>
+				</div>
+				<div class="overflow-x-auto">
+					<table class="w-full border-collapse">
+						<thead>
+							<tr class="bg-tokyo-night-bg-dark">
+								<th class="px-3 py-2 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">Pos</th>
+		`, template.HTMLEscapeString(team.TeamName), summary))
+		for _, wk := range team.Weeks {
+			class := "text-tokyo-night-fg"
+			if wk.Illegal {
+				class = "text-tokyo-night-error"
+			}
+			content.WriteString(fmt.Sprintf(`<th class="px-3 py-2 text-center font-semibold border-b border-tokyo-night-border %s">Wk %d</th>`, class, wk.Week))
+		}
+		content.WriteString(`</tr></thead><tbody>`)
+
+		for _, pos := range positions {
+			if !used[pos] {
+				continue
+			}
+			content.WriteString(fmt.Sprintf(`<tr><td class="px-3 py-2 border-b border-tokyo-night-border">%s</td>`, getPositionBadge(pos)))
+			for _, wk := range team.Weeks {
+				cell := `<span class="text-tokyo-night-fg-dim">·</span>`
+				if n := wk.Starters[pos]; n > 0 {
+					cell = fmt.Sprintf(`<span class="font-semibold text-tokyo-night-warning">%d</span>`, n)
+				}
+				content.WriteString(fmt.Sprintf(`<td class="px-3 py-2 text-center border-b border-tokyo-night-border">%s</td>`, cell))
+			}
+			content.WriteString(`</tr>`)
+		}
+
+		content.WriteString(`<tr><td class="px-3 py-2 text-sm text-tokyo-night-fg-dim">On bye</td>`)
+		for _, wk := range team.Weeks {
+			var names []string
+			for _, id := range wk.OnBye {
+				if p, ok := players[id]; ok {
+					names = append(names, template.HTMLEscapeString(p.Name))
+				}
+			}
+			class := "text-tokyo-night-fg-dim"
+			if wk.Illegal {
+				class = "text-tokyo-night-error bg-tokyo-night-bg-dark"
+			}
+			content.WriteString(fmt.Sprintf(`<td class="px-3 py-2 text-center text-xs %s">%s</td>`, class, strings.Join(names, "<br>")))
+		}
+		content.WriteString(`</tr></tbody></table></div></div>`)
+	}
+
+	renderTemplate(w, content.String(), "Bye Week Analysis")
+}
+
+// GetByeWeeksJSON returns each team's bye-week matrix as JSON
+func (h *Handler) GetByeWeeksJSON(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	draftID, err := strconv.Atoi(idStr)
+	if err != nil {
+		http.Error(w, "Invalid draft ID", http.StatusBadRequest)
+		return
+	}
+
+	draft, err := h.draftRepo.GetByID(draftID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	teams, _, _, err := h.byeAnalysis(draft)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(teams)
+}
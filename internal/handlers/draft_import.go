@@ -0,0 +1,370 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vibes/draft-board/internal/importer"
+	"github.com/vibes/draft-board/internal/models"
+	"github.com/vibes/draft-board/internal/sport"
+	"github.com/vibes/draft-board/internal/validation"
+)
+
+// importCandidates is how many near matches the review screen offers for a
+// player name that matches no one exactly.
+const importCandidates = 5
+
+// draftImportSection is the form on the new draft page to bring in a draft
+// held on paper or on another site.
+func (h *Handler) draftImportSection() string {
+	season, _ := h.seasonRepo.Current()
+	inputClass := "w-full px-4 py-2 bg-tokyo-night-bg border border-tokyo-night-border rounded-lg text-tokyo-night-fg focus:outline-none focus:border-tokyo-night-accent"
+	return fmt.Sprintf(`
+		<div class="mt-8 bg-tokyo-night-bg-light rounded-lg border border-tokyo-night-border p-6">
+			<h2 class="text-2xl font-semibold mb-2 text-tokyo-night-fg">Import a Finished Draft</h2>
+			<p class="text-sm text-tokyo-night-fg-dim mb-4">
+				Brings a draft held on paper or on another site into the history. Takes the CSV or JSON this app exports;
+				a CSV needs <code>team</code> and <code>player</code> columns, and may add <code>round</code>, <code>overall pick</code>,
+				<code>position</code>, <code>nfl team</code> and <code>adp rank</code>. Names that match no player exactly are shown for review before anything is saved.
+			</p>
+			<form method="POST" action="/draft/import" enctype="multipart/form-data" class="space-y-4">
+				<input type="file" name="file" accept=".csv,.json" required class="%s">
+				<input type="text" name="name" placeholder="Draft name (defaults to the file's)" maxlength="100" class="%s">
+				<div class="grid gap-4 md:grid-cols-2">
+					<div>
+						<label class="block text-sm font-medium mb-2 text-tokyo-night-fg">Season</label>
+						<input type="number" name="season" value="%d" min="1900" max="2999" required class="%s">
+					</div>
+					<div>
+						<label class="block text-sm font-medium mb-2 text-tokyo-night-fg">Sport</label>
+						<select name="sport" class="%s">%s</select>
+					</div>
+					<div>
+						<label class="block text-sm font-medium mb-2 text-tokyo-night-fg">Scoring Format</label>
+						<select name="scoring_format" class="%s">%s</select>
+					</div>
+					<div>
+						<label class="block text-sm font-medium mb-2 text-tokyo-night-fg">Draft Type</label>
+						<select name="draft_type" class="%s">
+							<option value="Redraft" selected>Redraft</option>
+							<option value="Dynasty">Dynasty</option>
+						</select>
+					</div>
+					<div>
+						<label class="block text-sm font-medium mb-2 text-tokyo-night-fg">Drafted On</label>
+						<input type="date" name="date" class="%s">
+					</div>
+				</div>
+				<p class="text-xs text-tokyo-night-fg-dim">A JSON export's own sport, scoring format, draft type and date take precedence. Without a date the draft is dated today.</p>
+				<button type="submit" class="w-full px-6 py-3 bg-tokyo-night-accent hover:bg-tokyo-night-accent-hover text-white rounded-lg font-semibold transition-colors">
+					Review Import
+				</button>
+			</form>
+		</div>
+	`, inputClass, inputClass, season, inputClass, inputClass, sportOptions(), inputClass, scoringFormatOptions(), inputClass, inputClass)
+}
+
+// importedDraft is the completed draft an import creates, dated the day
+// the file says it was held.
+func importedDraft(file *importer.DraftFile) (*models.Draft, error) {
+	draft := &models.Draft{
+		Name:           file.Name,
+		NumTeams:       len(file.Teams),
+		ScoringFormat:  file.ScoringFormat,
+		DraftType:      file.DraftType,
+		Sport:          strings.ToLower(file.Sport),
+		QBSetting:      "1QB",
+		SnakeDraft:     true,
+		Status:         "completed",
+		MaxRounds:      file.Rounds(),
+		CommissionerID: uuid.New().String(),
+		Completed:      true,
+		Season:         file.Season,
+	}
+	if file.Date != "" {
+		date, err := time.Parse("2006-01-02", file.Date)
+		if err != nil {
+			return nil, fmt.Errorf("invalid draft date %q", file.Date)
+		}
+		draft.CreatedAt = date
+	}
+	return draft, validation.ValidateDraft(draft)
+}
+
+// importMatcher matches an imported draft's players against the players of
+// its sport as they were in its season.
+func (h *Handler) importMatcher(draft *models.Draft) (*importer.Matcher, error) {
+	players, err := h.players(draft).List()
+	if err != nil {
+		return nil, err
+	}
+	key := sport.Get(draft.Sport).Key
+	var sportPlayers []*models.Player
+	for _, p := range players {
+		if sport.Get(p.Sport).Key == key {
+			sportPlayers = append(sportPlayers, p)
+		}
+	}
+	return importer.NewMatcher(sportPlayers).WithTeams(h.nflTeams()), nil
+}
+
+// ReviewDraftImport reads an uploaded draft and shows how its players were
+// matched, with a choice for every name that did not match exactly
+func (h *Handler) ReviewDraftImport(w http.ResponseWriter, r *http.Request) {
+	upload, header, err := r.FormFile("file")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	defer upload.Close()
+
+	var file *importer.DraftFile
+	if strings.EqualFold(filepath.Ext(header.Filename), ".json") {
+		file, err = importer.ParseDraftJSON(upload)
+	} else {
+		file, err = importer.ParseDraftCSV(upload)
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if name := strings.TrimSpace(r.FormValue("name")); name != "" || file.Name == "" {
+		file.Name = name
+	}
+	if file.Sport == "" {
+		file.Sport = r.FormValue("sport")
+	}
+	if file.ScoringFormat == "" {
+		file.ScoringFormat = r.FormValue("scoring_format")
+	}
+	if file.DraftType == "" {
+		file.DraftType = r.FormValue("draft_type")
+	}
+	if file.Date == "" {
+		file.Date = r.FormValue("date")
+	}
+	if file.Season, err = strconv.Atoi(r.FormValue("season")); err != nil {
+		http.Error(w, "Invalid season", http.StatusBadRequest)
+		return
+	}
+
+	draft, err := importedDraft(file)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	matcher, err := h.importMatcher(draft)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	data, err := json.Marshal(file)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var rows strings.Builder
+	exact, near, unmatched := 0, 0, 0
+	selectClass := "px-2 py-1 bg-tokyo-night-bg border border-tokyo-night-border rounded text-tokyo-night-fg focus:outline-none focus:border-tokyo-night-accent"
+	for i, pick := range file.Picks {
+		var choice string
+		rowClass := "hover:bg-tokyo-night-bg"
+		if player := matcher.Match(0, pick.Name, pick.Position, pick.Team); player != nil {
+			exact++
+			choice = fmt.Sprintf(`<span class="text-tokyo-night-success">%s %s</span> <span class="text-sm text-tokyo-night-fg-dim">%s</span>`,
+				getPlayerPositionBadges(player), template.HTMLEscapeString(player.Name), player.Team)
+		} else {
+			candidates := matcher.Closest(pick.Name, pick.Position, importCandidates)
+			if len(candidates) > 0 {
+				near++
+			} else {
+				unmatched++
+			}
+			var options strings.Builder
+			for _, c := range candidates {
+				options.WriteString(fmt.Sprintf(`<option value="%d">%s (%s, %s)</option>`,
+					c.ID, template.HTMLEscapeString(c.Name), c.PositionLabel(), c.Team))
+			}
+			options.WriteString(`<option value="new">Add as a custom player</option>`)
+			choice = fmt.Sprintf(`<select name="player_%d" class="%s">%s</select>`, i, selectClass, options.String())
+			rowClass = "bg-tokyo-night-warning/10"
+		}
+
+		rows.WriteString(fmt.Sprintf(`
+			<tr class="%s">
+				<td class="px-4 py-2 border-b border-tokyo-night-border">%d.%02d</td>
+				<td class="px-4 py-2 border-b border-tokyo-night-border">%d</td>
+				<td class="px-4 py-2 border-b border-tokyo-night-border">%s</td>
+				<td class="px-4 py-2 border-b border-tokyo-night-border">%s <span class="text-sm text-tokyo-night-fg-dim">%s %s</span></td>
+				<td class="px-4 py-2 border-b border-tokyo-night-border">%s</td>
+			</tr>
+		`, rowClass, pick.Round, (pick.OverallPick-1)%draft.NumTeams+1, pick.OverallPick,
+			template.HTMLEscapeString(pick.TeamName), template.HTMLEscapeString(pick.Name),
+			template.HTMLEscapeString(pick.Position), template.HTMLEscapeString(pick.Team), choice))
+	}
+
+	var teams []string
+	for _, t := range file.Teams {
+		teams = append(teams, fmt.Sprintf("%d. %s", t.DraftPosition, template.HTMLEscapeString(t.Name)))
+	}
+
+	held := fmt.Sprintf("%d season", draft.Season)
+	if !draft.CreatedAt.IsZero() {
+		held += ", drafted " + draft.CreatedAt.Format("Jan 2, 2006")
+	}
+
+	var content strings.Builder
+	content.WriteString(fmt.Sprintf(`
+		<div class="mb-8">
+			<a href="/draft/new" class="text-tokyo-night-fg-dim hover:text-tokyo-night-accent mb-4 inline-block">← Back</a>
+			<h1 class="text-4xl font-bold mb-2 text-tokyo-night-accent">Review Import</h1>
+			<p class="text-tokyo-night-fg-dim mb-2">%s · %s · %s %s %s · %d teams, %d rounds</p>
+			<p class="text-tokyo-night-fg-dim mb-2">Draft order: %s</p>
+			<p class="text-tokyo-night-fg">
+				<span class="text-tokyo-night-success">%d matched</span> ·
+				<span class="text-tokyo-night-warning">%d close matches to confirm</span> ·
+				<span class="text-tokyo-night-error">%d not found</span>
+			</p>
+		</div>
+		<form method="POST" action="/draft/import/confirm">
+			<input type="hidden" name="data" value="%s">
+			<div class="overflow-x-auto mb-6">
+				<table class="w-full border-collapse bg-tokyo-night-bg-light rounded-lg overflow-hidden">
+					<thead>
+						<tr class="bg-tokyo-night-bg-dark">
+							<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">Pick</th>
+							<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">Overall</th>
+							<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">Team</th>
+							<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">In the File</th>
+							<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">Player</th>
+						</tr>
+					</thead>
+					<tbody>%s</tbody>
+				</table>
+			</div>
+			<button type="submit" class="px-6 py-3 bg-tokyo-night-accent hover:bg-tokyo-night-accent-hover text-white rounded-lg font-semibold transition-colors">
+				Import Draft
+			</button>
+		</form>
+	`, template.HTMLEscapeString(draft.Name), held, sport.Get(draft.Sport).Name, draft.ScoringFormat, draft.DraftType,
+		draft.NumTeams, draft.MaxRounds, strings.Join(teams, ", "), exact, near, unmatched,
+		template.HTMLEscapeString(string(data)), rows.String()))
+
+	renderTemplate(w, content.String(), "Review Import")
+}
+
+// ConfirmDraftImport creates the reviewed draft with its teams and picks,
+// capturing each pick's ADP rank
+func (h *Handler) ConfirmDraftImport(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var file importer.DraftFile
+	if err := json.Unmarshal([]byte(r.FormValue("data")), &file); err != nil {
+		http.Error(w, "Invalid import data", http.StatusBadRequest)
+		return
+	}
+	draft, err := importedDraft(&file)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	matcher, err := h.importMatcher(draft)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	profile := sport.Get(draft.Sport)
+	teamIndex := h.nflTeams()
+	adpRank := h.adpRanker(draft)
+
+	picks := make([]models.ImportedPick, 0, len(file.Picks))
+	pickedAt := make(map[int]int)
+	for i, row := range file.Picks {
+		pick := models.ImportedPick{
+			Round:         row.Round,
+			OverallPick:   row.OverallPick,
+			DraftPosition: file.DraftPosition(row.TeamName),
+			ADPRank:       row.ADPRank,
+		}
+
+		var player *models.Player
+		switch choice := r.FormValue(fmt.Sprintf("player_%d", i)); choice {
+		case "":
+			player = matcher.Match(0, row.Name, row.Position, row.Team)
+		case "new":
+			positions := models.ParsePositions(row.Position)
+			if len(positions) == 0 {
+				http.Error(w, fmt.Sprintf("pick %d: %s needs a position to be added as a custom player", row.OverallPick, row.Name), http.StatusBadRequest)
+				return
+			}
+			if err := validation.ValidatePosition(profile, positions[0]); err != nil {
+				http.Error(w, fmt.Sprintf("pick %d: %v", row.OverallPick, err), http.StatusBadRequest)
+				return
+			}
+			eligible, err := eligiblePositions(profile, row.Position, positions[0])
+			if err != nil {
+				http.Error(w, fmt.Sprintf("pick %d: %v", row.OverallPick, err), http.StatusBadRequest)
+				return
+			}
+			pick.NewPlayer = &models.Player{
+				Name:              row.Name,
+				Team:              strings.ToUpper(row.Team),
+				Position:          positions[0],
+				EligiblePositions: eligible,
+				IsCustom:          true,
+				Sport:             profile.Key,
+			}
+			if profile.Key == sport.Football {
+				pick.NewPlayer.Team = teamIndex.Canonical(row.Team)
+				if team := teamIndex.Lookup(pick.NewPlayer.Team); team != nil {
+					pick.NewPlayer.ByeWeek = team.ByeWeek
+				}
+			}
+		default:
+			if id, err := strconv.Atoi(choice); err == nil {
+				player = matcher.Match(id, "", "", "")
+			}
+		}
+
+		if pick.NewPlayer == nil {
+			if player == nil {
+				http.Error(w, fmt.Sprintf("pick %d: choose a player for %s", row.OverallPick, row.Name), http.StatusBadRequest)
+				return
+			}
+			if earlier, ok := pickedAt[player.ID]; ok {
+				http.Error(w, fmt.Sprintf("%s is picked at both %d and %d", player.Name, earlier, row.OverallPick), http.StatusBadRequest)
+				return
+			}
+			pickedAt[player.ID] = row.OverallPick
+			pick.PlayerID = player.ID
+			if pick.ADPRank == nil {
+				pick.ADPRank = adpRank(player)
+			}
+		}
+		picks = append(picks, pick)
+	}
+
+	teams := make([]models.Team, 0, len(file.Teams))
+	for _, t := range file.Teams {
+		teams = append(teams, models.Team{TeamName: t.Name, OwnerName: t.Owner, DraftPosition: t.DraftPosition})
+	}
+
+	if err := h.draftRepo.Import(draft, teams, picks, profile.DefaultSlots(draft.QBSetting)); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	http.Redirect(w, r, fmt.Sprintf("/draft/%d", draft.ID), http.StatusSeeOther)
+}
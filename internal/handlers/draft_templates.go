@@ -0,0 +1,370 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	draftorder "github.com/vibes/draft-board/internal/draft"
+	"github.com/vibes/draft-board/internal/models"
+	"github.com/vibes/draft-board/internal/sport"
+	"github.com/vibes/draft-board/internal/validation"
+)
+
+// draftConfig captures the draft's settings, teams, roster slots, custom
+// scoring rules and run alert settings.
+func (h *Handler) draftConfig(draft *models.Draft) (*models.DraftConfig, error) {
+	teams, err := h.teamRepo.GetByDraft(draft.ID)
+	if err != nil {
+		return nil, err
+	}
+	rules, err := h.scoringRepo.GetByDraft(draft.ID)
+	if err != nil {
+		return nil, err
+	}
+	runs, err := h.runRepo.GetByDraft(draft.ID)
+	if err != nil {
+		return nil, err
+	}
+	return models.NewDraftConfig(draft, teams, h.rosterSlots(draft), rules, runs), nil
+}
+
+// SaveDraftTemplate saves the draft's configuration as a named template
+func (h *Handler) SaveDraftTemplate(w http.ResponseWriter, r *http.Request) {
+	draftID, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		http.Error(w, "Invalid draft ID", http.StatusBadRequest)
+		return
+	}
+
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	draft, err := h.draftRepo.GetByID(draftID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	name := strings.TrimSpace(r.FormValue("name"))
+	if name == "" {
+		name = draft.Name
+	}
+
+	cfg, err := h.draftConfig(draft)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	data, err := json.Marshal(cfg)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err := h.templateRepo.Create(&models.DraftTemplate{Name: name, Config: string(data)}); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	http.Redirect(w, r, fmt.Sprintf("/draft/%d/setup", draftID), http.StatusSeeOther)
+}
+
+// CreateDraftFromTemplate creates a draft with a saved template's
+// configuration, keeping or shuffling its draft order
+func (h *Handler) CreateDraftFromTemplate(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	templateID, err := strconv.Atoi(r.FormValue("template_id"))
+	if err != nil {
+		http.Error(w, "Invalid template ID", http.StatusBadRequest)
+		return
+	}
+	saved, err := h.templateRepo.GetByID(templateID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	var cfg models.DraftConfig
+	if err := json.Unmarshal([]byte(saved.Config), &cfg); err != nil {
+		http.Error(w, "Invalid template: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if draft := h.createDraftFromConfig(w, r, &cfg, r.FormValue("order"), nil); draft != nil {
+		http.Redirect(w, r, fmt.Sprintf("/draft/%d/setup", draft.ID), http.StatusSeeOther)
+	}
+}
+
+// CloneDraft creates a draft with a previous draft's configuration. Its
+// order can be kept, randomized, or reversed from how the previous
+// season finished so the last-place team picks first; the commissioner
+// enters the finish on a second form. A clone of a league's draft joins
+// the league.
+func (h *Handler) CloneDraft(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	draftID, err := strconv.Atoi(r.FormValue("draft_id"))
+	if err != nil {
+		http.Error(w, "Invalid draft ID", http.StatusBadRequest)
+		return
+	}
+	previous, err := h.draftRepo.GetByID(draftID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	cfg, err := h.draftConfig(previous)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var standings []string
+	if r.FormValue("order") == draftorder.OrderReverse {
+		teams, err := h.teamRepo.GetByDraft(previous.ID)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		finish := make(map[int]int, len(teams))
+		entered := false
+		for _, team := range teams {
+			value := r.FormValue(fmt.Sprintf("finish_%d", team.ID))
+			entered = entered || value != ""
+			finish[team.ID], _ = strconv.Atoi(value)
+		}
+		if !entered {
+			renderTemplate(w, cloneFinishForm(previous, teams, strings.TrimSpace(r.FormValue("name"))), "Clone Draft")
+			return
+		}
+		if standings, err = draftorder.Standings(teams, finish); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	draft := h.createDraftFromConfig(w, r, cfg, r.FormValue("order"), standings)
+	if draft == nil {
+		return
+	}
+	if previous.LeagueID != nil {
+		if err := h.leagueRepo.AttachDraft(*previous.LeagueID, draft.ID); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	http.Redirect(w, r, fmt.Sprintf("/draft/%d/setup", draft.ID), http.StatusSeeOther)
+}
+
+// cloneFinishForm asks for the previous season's finish of each of a
+// draft's teams, to reverse into the clone's draft order.
+func cloneFinishForm(previous *models.Draft, teams []models.Team, name string) string {
+	inputClass := "px-4 py-2 bg-tokyo-night-bg border border-tokyo-night-border rounded-lg text-tokyo-night-fg focus:outline-none focus:border-tokyo-night-accent"
+
+	var content strings.Builder
+	content.WriteString(fmt.Sprintf(`
+		<div class="max-w-2xl mx-auto">
+			<div class="mb-8">
+				<a href="/draft/new" class="text-tokyo-night-fg-dim hover:text-tokyo-night-accent mb-4 inline-block">← Back</a>
+				<h1 class="text-4xl font-bold mb-2 text-tokyo-night-accent">Clone %s</h1>
+				<p class="text-tokyo-night-fg-dim">Enter how last season finished. The last-place team picks first.</p>
+			</div>
+			<form method="POST" action="/draft/clone" class="bg-tokyo-night-bg-light rounded-lg border border-tokyo-night-border p-6 space-y-4">
+				<input type="hidden" name="draft_id" value="%d">
+				<input type="hidden" name="order" value="%s">
+				<div>
+					<label class="block text-sm font-medium mb-2 text-tokyo-night-fg">Name</label>
+					<input type="text" name="name" value="%s" required maxlength="100" class="w-full %s">
+				</div>
+				<div>
+					<label class="block text-sm font-medium mb-2 text-tokyo-night-fg">Last season's finish</label>
+					<p class="text-xs text-tokyo-night-fg-dim mb-2">1 for the champion, %d for last place</p>
+					<div class="grid gap-2 md:grid-cols-2">
+	`, template.HTMLEscapeString(previous.Name), previous.ID, draftorder.OrderReverse, template.HTMLEscapeString(name), inputClass, len(teams)))
+	for _, team := range teams {
+		content.WriteString(fmt.Sprintf(`
+						<label class="flex items-center justify-between gap-2 text-tokyo-night-fg">
+							<span>%s</span>
+							<input type="number" name="finish_%d" min="1" max="%d" required class="w-20 %s">
+						</label>
+		`, template.HTMLEscapeString(team.TeamName), team.ID, len(teams), inputClass))
+	}
+	content.WriteString(`
+					</div>
+				</div>
+				<button type="submit" class="px-4 py-2 bg-tokyo-night-accent hover:bg-tokyo-night-accent-hover text-white rounded-lg font-semibold transition-colors">Clone Draft</button>
+			</form>
+		</div>
+	`)
+	return content.String()
+}
+
+// createDraftFromConfig reorders the configuration's teams and creates the
+// draft named in the form with it. It returns nil after writing the error
+// response when the draft cannot be created.
+func (h *Handler) createDraftFromConfig(w http.ResponseWriter, r *http.Request, cfg *models.DraftConfig, order string, standings []string) *models.Draft {
+	teams, err := draftorder.Reorder(cfg.Teams, order, standings, rand.New(rand.NewSource(time.Now().UnixNano())))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return nil
+	}
+	cfg.Teams = teams
+
+	draft := cfg.Draft(strings.TrimSpace(r.FormValue("name")))
+	draft.CommissionerID = uuid.New().String()
+
+	season, err := h.seasonRepo.Current()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return nil
+	}
+	draft.Season = season
+
+	if err := validation.ValidateDraft(draft); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return nil
+	}
+
+	if err := h.draftRepo.CreateFromConfig(draft, cfg); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return nil
+	}
+
+	return draft
+}
+
+// DeleteDraftTemplate deletes a saved template
+func (h *Handler) DeleteDraftTemplate(w http.ResponseWriter, r *http.Request) {
+	templateID, err := strconv.Atoi(chi.URLParam(r, "templateId"))
+	if err != nil {
+		http.Error(w, "Invalid template ID", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.templateRepo.Delete(templateID); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	http.Redirect(w, r, "/draft/new", http.StatusSeeOther)
+}
+
+// draftTemplatesSection lists the saved templates and previous drafts new
+// drafts can start from.
+func (h *Handler) draftTemplatesSection() string {
+	templates, _ := h.templateRepo.List()
+	drafts, _ := h.draftRepo.List()
+	if len(templates) == 0 && len(drafts) == 0 {
+		return ""
+	}
+
+	inputClass := "w-full px-4 py-2 bg-tokyo-night-bg border border-tokyo-night-border rounded-lg text-tokyo-night-fg focus:outline-none focus:border-tokyo-night-accent"
+	buttonClass := "w-full px-4 py-2 bg-tokyo-night-accent hover:bg-tokyo-night-accent-hover text-white rounded-lg font-semibold transition-colors"
+
+	var content strings.Builder
+	content.WriteString(`
+		<div class="mt-8 bg-tokyo-night-bg-light rounded-lg border border-tokyo-night-border p-6">
+			<h2 class="text-2xl font-semibold mb-2 text-tokyo-night-fg">Start from a Template or Previous Draft</h2>
+			<p class="text-sm text-tokyo-night-fg-dim mb-4">Copies the settings, teams, roster slots and scoring so nothing has to be entered again.</p>
+	`)
+
+	if len(templates) > 0 {
+		var options strings.Builder
+		for _, t := range templates {
+			options.WriteString(fmt.Sprintf(`<option value="%d">%s</option>`, t.ID, template.HTMLEscapeString(t.Name)))
+		}
+		content.WriteString(fmt.Sprintf(`
+			<form method="POST" action="/draft/create/template" class="space-y-4 mb-6">
+				<h3 class="text-lg font-semibold text-tokyo-night-fg">From a Template</h3>
+				<select name="template_id" class="%s">%s</select>
+				<input type="text" name="name" required placeholder="Draft name" class="%s">
+				<select name="order" class="%s">
+					<option value="keep">Keep the saved draft order</option>
+					<option value="random">Randomize the draft order</option>
+				</select>
+				<button type="submit" class="%s">Create from Template</button>
+			</form>
+		`, inputClass, options.String(), inputClass, inputClass, buttonClass))
+	}
+
+	if len(drafts) > 0 {
+		var options strings.Builder
+		for _, d := range drafts {
+			options.WriteString(fmt.Sprintf(`<option value="%d">%s (%d, %s)</option>`,
+				d.ID, template.HTMLEscapeString(d.Name), d.Season, sport.Get(d.Sport).Name))
+		}
+		content.WriteString(fmt.Sprintf(`
+			<form method="POST" action="/draft/clone" class="space-y-4 mb-6">
+				<h3 class="text-lg font-semibold text-tokyo-night-fg">From a Previous Draft</h3>
+				<select name="draft_id" class="%s">%s</select>
+				<input type="text" name="name" required placeholder="Draft name" class="%s">
+				<select name="order" class="%s">
+					<option value="keep">Keep the previous draft order</option>
+					<option value="reverse">Reverse last season's finish (last place picks first)</option>
+					<option value="random">Randomize the draft order</option>
+				</select>
+				<button type="submit" class="%s">Clone Draft</button>
+			</form>
+		`, inputClass, options.String(), inputClass, inputClass, buttonClass))
+	}
+
+	if len(templates) > 0 {
+		content.WriteString(`<h3 class="text-lg font-semibold mb-2 text-tokyo-night-fg">Saved Templates</h3><div class="space-y-2">`)
+		for _, t := range templates {
+			var cfg models.DraftConfig
+			json.Unmarshal([]byte(t.Config), &cfg)
+			content.WriteString(fmt.Sprintf(`
+				<div class="flex items-center justify-between p-3 bg-tokyo-night-bg rounded border border-tokyo-night-border">
+					<div>
+						<span class="font-semibold text-tokyo-night-fg">%s</span>
+						<span class="text-sm text-tokyo-night-fg-dim ml-2">%s · %d teams · %s</span>
+					</div>
+					<form method="POST" action="/templates/%d/delete" onsubmit="return confirm('Delete this template?')">
+						<button type="submit" class="text-sm text-tokyo-night-error hover:underline">Delete</button>
+					</form>
+				</div>
+			`, template.HTMLEscapeString(t.Name), sport.Get(cfg.Sport).Name, len(cfg.Teams), cfg.ScoringFormat, t.ID))
+		}
+		content.WriteString(`</div>`)
+	}
+
+	content.WriteString(`</div>`)
+	return content.String()
+}
+
+// saveTemplateForm saves the draft's configuration as a template.
+func saveTemplateForm(draft *models.Draft) string {
+	return fmt.Sprintf(`
+		<div class="mt-8 bg-tokyo-night-bg-light rounded-lg border border-tokyo-night-border p-6">
+			<h2 class="text-2xl font-semibold mb-4 text-tokyo-night-fg">Save as Template</h2>
+			<p class="text-sm text-tokyo-night-fg-dim mb-4">Saves the settings, teams, roster slots, scoring and run alerts to start next year's draft from.</p>
+			<form method="POST" action="/draft/%d/template" class="flex flex-wrap items-end gap-4">
+				<input type="text" name="name" value="%s" maxlength="100"
+					class="flex-1 px-4 py-2 bg-tokyo-night-bg border border-tokyo-night-border rounded-lg text-tokyo-night-fg focus:outline-none focus:border-tokyo-night-accent">
+				<button type="submit"
+					class="px-4 py-2 bg-tokyo-night-accent hover:bg-tokyo-night-accent-hover text-white rounded-lg font-semibold transition-colors">
+					Save Template
+				</button>
+			</form>
+		</div>
+	`, draft.ID, template.HTMLEscapeString(draft.Name))
+}
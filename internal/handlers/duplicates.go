@@ -0,0 +1,174 @@
+package handlers
+
+import (
+	"fmt"
+	"html/template"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/vibes/draft-board/internal/dedupe"
+	"github.com/vibes/draft-board/internal/models"
+)
+
+// GetDuplicatePlayers lists players entered more than once, each group with
+// a form to merge it into one player, and the merges made so far
+func (h *Handler) GetDuplicatePlayers(w http.ResponseWriter, r *http.Request) {
+	players, err := h.playerRepo.List()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	merges, err := h.mergeRepo.List()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	groups := dedupe.Groups(players)
+
+	var content strings.Builder
+	content.WriteString(fmt.Sprintf(`
+		<div class="mb-8">
+			<a href="/" class="text-tokyo-night-fg-dim hover:text-tokyo-night-accent mb-4 inline-block">← Back</a>
+			<h1 class="text-4xl font-bold mb-2 text-tokyo-night-accent">Duplicate Players</h1>
+			<p class="text-tokyo-night-fg-dim">%d players share a name, position and team with another. Merging moves picks, queue entries and notes to the player kept and deletes the others.</p>
+		</div>
+	`, countGrouped(groups)))
+
+	if len(groups) == 0 {
+		content.WriteString(`<div class="bg-tokyo-night-bg-light rounded-lg border border-tokyo-night-border p-8 mb-8 text-center text-tokyo-night-fg-dim">No duplicate players</div>`)
+	}
+	for _, group := range groups {
+		var ids []string
+		for _, player := range group {
+			ids = append(ids, strconv.Itoa(player.ID))
+		}
+		content.WriteString(fmt.Sprintf(`
+			<form method="POST" action="/players/duplicates/merge" class="bg-tokyo-night-bg-light rounded-lg border border-tokyo-night-border p-4 mb-4">
+				<input type="hidden" name="ids" value="%s">
+				<div class="flex items-center justify-between mb-3">
+					<h2 class="text-lg font-semibold text-tokyo-night-fg">%s%s <span class="text-sm text-tokyo-night-fg-dim">%s</span></h2>
+					<button type="submit" onclick="return confirm('Merge these players into the one selected?')"
+						class="px-4 py-2 bg-tokyo-night-accent hover:bg-tokyo-night-accent-hover text-white rounded-lg text-sm font-semibold transition-colors">Merge</button>
+				</div>
+				<table class="w-full text-sm">
+		`, strings.Join(ids, ","), getPlayerPositionBadges(group[0]), template.HTMLEscapeString(group[0].Name), group[0].Team))
+		for i, player := range group {
+			checked := ""
+			if i == 0 {
+				checked = "checked"
+			}
+			source := "Imported"
+			if player.IsCustom {
+				source = "Custom"
+			}
+			content.WriteString(fmt.Sprintf(`
+					<tr class="border-t border-tokyo-night-border">
+						<td class="py-2 w-16"><label class="flex items-center gap-2 text-tokyo-night-fg-dim"><input type="radio" name="keep" value="%d" %s> Keep</label></td>
+						<td class="py-2 text-tokyo-night-fg">#%d %s%s</td>
+						<td class="py-2 text-tokyo-night-fg-dim">%s</td>
+						<td class="py-2 text-tokyo-night-fg-dim">Added %s</td>
+					</tr>
+			`, player.ID, checked, player.ID, template.HTMLEscapeString(player.Name), getStatusBadge(player),
+				source, player.CreatedAt.Format("Jan 2, 2006")))
+		}
+		content.WriteString(`</table></form>`)
+	}
+
+	content.WriteString(`
+		<h2 class="text-2xl font-semibold mt-8 mb-4 text-tokyo-night-fg">Merge History</h2>
+		<div class="overflow-x-auto">
+			<table class="w-full border-collapse bg-tokyo-night-bg-light rounded-lg overflow-hidden">
+				<thead>
+					<tr class="bg-tokyo-night-bg-dark">
+						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">Merged</th>
+						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">Into</th>
+						<th class="px-4 py-3 text-right font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">Picks</th>
+						<th class="px-4 py-3 text-right font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">Queue</th>
+						<th class="px-4 py-3 text-right font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">Notes</th>
+						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">When</th>
+					</tr>
+				</thead>
+				<tbody>
+	`)
+	for _, m := range merges {
+		content.WriteString(fmt.Sprintf(`
+					<tr class="hover:bg-tokyo-night-bg-dark transition-colors text-sm">
+						<td class="px-4 py-2 border-b border-tokyo-night-border text-tokyo-night-fg">#%d %s <span class="text-tokyo-night-fg-dim">%s %s</span></td>
+						<td class="px-4 py-2 border-b border-tokyo-night-border text-tokyo-night-fg-dim">#%d</td>
+						<td class="px-4 py-2 border-b border-tokyo-night-border text-right">%d</td>
+						<td class="px-4 py-2 border-b border-tokyo-night-border text-right">%d</td>
+						<td class="px-4 py-2 border-b border-tokyo-night-border text-right">%d</td>
+						<td class="px-4 py-2 border-b border-tokyo-night-border text-tokyo-night-fg-dim">%s</td>
+					</tr>
+		`, m.MergedPlayerID, template.HTMLEscapeString(m.MergedName), m.MergedPosition, m.MergedTeam,
+			m.KeptPlayerID, m.PicksMoved, m.QueueMoved, m.NotesMoved, m.MergedAt.Format("Jan 2 15:04")))
+	}
+	if len(merges) == 0 {
+		content.WriteString(`<tr><td colspan="6" class="px-4 py-8 text-center text-tokyo-night-fg-dim">No merges yet</td></tr>`)
+	}
+	content.WriteString(`</tbody></table></div>`)
+
+	renderTemplate(w, content.String(), "Duplicate Players")
+}
+
+func countGrouped(groups [][]*models.Player) int {
+	n := 0
+	for _, group := range groups {
+		n += len(group)
+	}
+	return n
+}
+
+// MergeDuplicatePlayers merges a group of duplicates into the player kept
+func (h *Handler) MergeDuplicatePlayers(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	keepID, err := strconv.Atoi(r.FormValue("keep"))
+	if err != nil {
+		http.Error(w, "Invalid player ID", http.StatusBadRequest)
+		return
+	}
+
+	keep, err := h.playerRepo.GetByID(keepID)
+	if err != nil || keep == nil {
+		http.Error(w, "Player not found", http.StatusNotFound)
+		return
+	}
+
+	var mergeIDs []int
+	for _, raw := range strings.Split(r.FormValue("ids"), ",") {
+		id, err := strconv.Atoi(strings.TrimSpace(raw))
+		if err != nil {
+			http.Error(w, "Invalid player ID", http.StatusBadRequest)
+			return
+		}
+		if id == keepID {
+			continue
+		}
+		player, err := h.playerRepo.GetByID(id)
+		if err != nil || player == nil {
+			http.Error(w, "Player not found", http.StatusNotFound)
+			return
+		}
+		if dedupe.Key(player) != dedupe.Key(keep) {
+			http.Error(w, fmt.Sprintf("%s is not a duplicate of %s", player.Name, keep.Name), http.StatusBadRequest)
+			return
+		}
+		mergeIDs = append(mergeIDs, id)
+	}
+	if len(mergeIDs) == 0 {
+		http.Error(w, "No players to merge", http.StatusBadRequest)
+		return
+	}
+
+	if _, err := h.mergeRepo.Merge(keepID, mergeIDs); err != nil {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+
+	http.Redirect(w, r, "/players/duplicates", http.StatusSeeOther)
+}
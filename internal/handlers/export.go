@@ -0,0 +1,457 @@
+package handlers
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/vibes/draft-board/internal/boardimage"
+	"github.com/vibes/draft-board/internal/models"
+	"github.com/vibes/draft-board/internal/sport"
+	"github.com/vibes/draft-board/internal/xlsx"
+)
+
+// draftExport is a draft's teams and picks with their players looked up,
+// as the file exports lay them out.
+type draftExport struct {
+	draft   *models.Draft
+	profile *sport.Profile
+	teams   []models.Team
+	picks   []models.Pick
+	players map[int]*models.Player
+	rounds  int
+}
+
+// collectExport loads what the file exports need of a draft. Picks whose
+// player has since been deleted are kept, with no player.
+func (h *Handler) collectExport(draft *models.Draft) (*draftExport, error) {
+	teams, err := h.teamRepo.GetByDraft(draft.ID)
+	if err != nil {
+		return nil, err
+	}
+	picks, err := h.pickRepo.GetByDraft(draft.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	e := &draftExport{
+		draft:   draft,
+		profile: sport.Get(draft.Sport),
+		teams:   teams,
+		picks:   picks,
+		players: make(map[int]*models.Player),
+		rounds:  draft.MaxRounds,
+	}
+	playerRepo := h.players(draft)
+	for _, pick := range picks {
+		if player, err := playerRepo.GetByID(pick.PlayerID); err == nil {
+			e.players[pick.PlayerID] = player
+		}
+		e.rounds = max(e.rounds, pick.Round)
+	}
+	return e, nil
+}
+
+// team returns the team with the given ID, or nil.
+func (e *draftExport) team(id int) *models.Team {
+	for i := range e.teams {
+		if e.teams[i].ID == id {
+			return &e.teams[i]
+		}
+	}
+	return nil
+}
+
+// board returns the picks by round and then by the team that made them,
+// as GetBigBoard lays them out.
+func (e *draftExport) board() map[int]map[int]*models.Pick {
+	board := make(map[int]map[int]*models.Pick)
+	for i := range e.picks {
+		pick := &e.picks[i]
+		if board[pick.Round] == nil {
+			board[pick.Round] = make(map[int]*models.Pick)
+		}
+		board[pick.Round][pick.TeamID] = pick
+	}
+	return board
+}
+
+// roster returns a team's picks grouped by position in the sport's order,
+// and in pick order within a position. Positions the sport does not list
+// come last.
+func (e *draftExport) roster(teamID int) []models.Pick {
+	order := make(map[string]int)
+	for i, pos := range e.profile.PositionAbbrs() {
+		order[pos] = i + 1
+	}
+	rank := func(pick models.Pick) int {
+		if player := e.players[pick.PlayerID]; player != nil && order[player.Position] > 0 {
+			return order[player.Position]
+		}
+		return len(order) + 1
+	}
+
+	var roster []models.Pick
+	for _, pick := range e.picks {
+		if pick.TeamID == teamID {
+			roster = append(roster, pick)
+		}
+	}
+	sort.SliceStable(roster, func(i, j int) bool {
+		return rank(roster[i]) < rank(roster[j])
+	})
+	return roster
+}
+
+// tint mixes a hex color over white, as a Tailwind color at the given
+// opacity looks on a white page.
+func tint(hex string, alpha float64) string {
+	n, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil || len(hex) != 6 {
+		return "FFFFFF"
+	}
+	mix := func(c uint64) uint64 {
+		return uint64(255 - (255-float64(c))*alpha + 0.5)
+	}
+	return fmt.Sprintf("%02X%02X%02X", mix(n>>16&0xFF), mix(n>>8&0xFF), mix(n&0xFF))
+}
+
+// GetBigBoardSVG renders the big board as an SVG image. A team ID in the
+// team query parameter highlights that team's picks.
+func (h *Handler) GetBigBoardSVG(w http.ResponseWriter, r *http.Request) {
+	h.bigBoardImage(w, r, "svg")
+}
+
+// GetBigBoardPNG renders the big board as a PNG image, highlighting a team
+// as GetBigBoardSVG does.
+func (h *Handler) GetBigBoardPNG(w http.ResponseWriter, r *http.Request) {
+	h.bigBoardImage(w, r, "png")
+}
+
+func (h *Handler) bigBoardImage(w http.ResponseWriter, r *http.Request, format string) {
+	idStr := chi.URLParam(r, "id")
+	draftID, err := strconv.Atoi(idStr)
+	if err != nil {
+		http.Error(w, "Invalid draft ID", http.StatusBadRequest)
+		return
+	}
+
+	draft, err := h.draftRepo.GetByID(draftID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	highlight := 0
+	if teamStr := r.URL.Query().Get("team"); teamStr != "" {
+		highlight, err = strconv.Atoi(teamStr)
+		if err != nil {
+			http.Error(w, "Invalid team ID", http.StatusBadRequest)
+			return
+		}
+	}
+
+	export, err := h.collectExport(draft)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if highlight != 0 && export.team(highlight) == nil {
+		http.Error(w, "Team not found in this draft", http.StatusBadRequest)
+		return
+	}
+
+	board := &boardimage.Board{Title: "Big Board - " + draft.Name}
+	for _, team := range export.teams {
+		board.Teams = append(board.Teams, boardimage.Team{Name: team.TeamName, Highlight: team.ID == highlight})
+	}
+	picks := export.board()
+	for round := 1; round <= export.rounds; round++ {
+		row := make([]*boardimage.Cell, len(export.teams))
+		for i, team := range export.teams {
+			pick, ok := picks[round][team.ID]
+			if !ok {
+				continue
+			}
+			if player := export.players[pick.PlayerID]; player != nil {
+				row[i] = &boardimage.Cell{
+					Player:   player.Name,
+					Position: player.PositionLabel(),
+					Team:     player.Team,
+					Color:    sport.PositionHex(player.Position),
+					Traded:   pick.IsTraded,
+				}
+			}
+		}
+		board.Rounds = append(board.Rounds, row)
+	}
+
+	var buf bytes.Buffer
+	contentType := "image/svg+xml"
+	if format == "png" {
+		contentType = "image/png"
+		err = board.PNG(&buf)
+	} else {
+		err = board.SVG(&buf)
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("inline; filename=draft-%d-board.%s", draftID, format))
+	w.Write(buf.Bytes())
+}
+
+// ExportXLSX exports the draft as an Excel workbook: the board, each
+// team's roster, every pick against ADP, and the franchise and position
+// stats.
+func (h *Handler) ExportXLSX(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	draftID, err := strconv.Atoi(idStr)
+	if err != nil {
+		http.Error(w, "Invalid draft ID", http.StatusBadRequest)
+		return
+	}
+
+	draft, err := h.draftRepo.GetByID(draftID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	export, err := h.collectExport(draft)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	wb := xlsx.New()
+	header := wb.Style(xlsx.Style{Bold: true, Color: "FFFFFF", Fill: "1F2335", Border: true})
+	plain := wb.Style(xlsx.Style{Border: true})
+	steal := wb.Style(xlsx.Style{Color: "15803D", Border: true})
+	reach := wb.Style(xlsx.Style{Color: "B91C1C", Border: true})
+	// Positions are filled as their badges are drawn: the position's
+	// color at 20% opacity.
+	positionStyle := func(position string, wrap bool) int {
+		return wb.Style(xlsx.Style{Fill: tint(sport.PositionHex(position), 0.2), Border: true, Wrap: wrap})
+	}
+	valueStyle := func(diff int) int {
+		switch {
+		case diff > 0:
+			return steal
+		case diff < 0:
+			return reach
+		default:
+			return plain
+		}
+	}
+	headerRow := func(sheet *xlsx.Sheet, titles ...string) {
+		cells := make([]xlsx.Cell, len(titles))
+		for i, title := range titles {
+			cells[i] = xlsx.Text(title).Styled(header)
+		}
+		sheet.AddRow(cells...)
+		sheet.Freeze(1, 0)
+	}
+
+	// Board: a row per round and a column per team, as on the big board.
+	board := wb.AddSheet("Board")
+	titles := []string{"Round"}
+	for _, team := range export.teams {
+		titles = append(titles, team.TeamName)
+	}
+	headerRow(board, titles...)
+	board.Freeze(1, 1)
+	board.SetWidth(0, 8)
+	picks := export.board()
+	for round := 1; round <= export.rounds; round++ {
+		cells := []xlsx.Cell{xlsx.Int(round).Styled(plain)}
+		for _, team := range export.teams {
+			pick, ok := picks[round][team.ID]
+			var player *models.Player
+			if ok {
+				player = export.players[pick.PlayerID]
+			}
+			if player == nil {
+				cells = append(cells, xlsx.Empty().Styled(plain))
+				continue
+			}
+			text := fmt.Sprintf("%s\n%s - %s", player.Name, player.PositionLabel(), player.Team)
+			if pick.IsTraded {
+				text += "\nTRADED"
+			}
+			cells = append(cells, xlsx.Text(text).Styled(positionStyle(player.Position, true)))
+		}
+		board.AddRow(cells...).Height = 32
+	}
+	for i := range export.teams {
+		board.SetWidth(i+1, 22)
+	}
+
+	// Rosters: a column per team, each grouped by position.
+	rosters := wb.AddSheet("Rosters")
+	headerRow(rosters, titles[1:]...)
+	var lists [][]models.Pick
+	longest := 0
+	for i, team := range export.teams {
+		lists = append(lists, export.roster(team.ID))
+		longest = max(longest, len(lists[i]))
+		rosters.SetWidth(i, 28)
+	}
+	for row := 0; row < longest; row++ {
+		var cells []xlsx.Cell
+		for _, roster := range lists {
+			if row >= len(roster) {
+				cells = append(cells, xlsx.Empty())
+				continue
+			}
+			pick := roster[row]
+			player := export.players[pick.PlayerID]
+			if player == nil {
+				cells = append(cells, xlsx.Text(fmt.Sprintf("Pick %d", pick.OverallPick)).Styled(plain))
+				continue
+			}
+			cells = append(cells, xlsx.Text(fmt.Sprintf("%s - %s, %s (pick %d)", player.PositionLabel(), player.Name, player.Team, pick.OverallPick)).
+				Styled(positionStyle(player.Position, false)))
+		}
+		rosters.AddRow(cells...)
+	}
+
+	// Picks: every pick, with its value against ADP as on the value picks
+	// page. A positive value is a player taken later than their ADP.
+	pickSheet := wb.AddSheet("Picks")
+	proTeam := "Pro Team"
+	if export.profile.Key == sport.Football {
+		proTeam = "NFL Team"
+	}
+	headerRow(pickSheet, "Overall", "Round", "Team", "Player", "Position", proTeam, "ADP Rank", "Value")
+	for col, width := range []float64{8, 7, 20, 24, 10, 8, 10, 8} {
+		pickSheet.SetWidth(col, width)
+	}
+	for _, pick := range export.picks {
+		teamName := ""
+		if team := export.team(pick.TeamID); team != nil {
+			teamName = team.TeamName
+		}
+		name, position, pro := "", "", ""
+		positionCell := xlsx.Text("").Styled(plain)
+		if player := export.players[pick.PlayerID]; player != nil {
+			name, position, pro = player.Name, player.PositionLabel(), player.Team
+			positionCell = xlsx.Text(position).Styled(positionStyle(player.Position, false))
+		}
+		cells := []xlsx.Cell{
+			xlsx.Int(pick.OverallPick).Styled(plain),
+			xlsx.Int(pick.Round).Styled(plain),
+			xlsx.Text(teamName).Styled(plain),
+			xlsx.Text(name).Styled(plain),
+			positionCell,
+			xlsx.Text(pro).Styled(plain),
+		}
+		if pick.ADPRank != nil {
+			diff := *pick.ADPRank - pick.OverallPick
+			cells = append(cells, xlsx.Int(*pick.ADPRank).Styled(plain), xlsx.Int(diff).Styled(valueStyle(diff)))
+		} else {
+			cells = append(cells, xlsx.Empty().Styled(plain), xlsx.Empty().Styled(plain))
+		}
+		pickSheet.AddRow(cells...)
+	}
+
+	// Franchises: picks by the pro team of the player, as on the
+	// franchise stats page.
+	stats, positions := h.franchiseStats(draft, export.picks)
+	franchises := wb.AddSheet("Franchises")
+	titles = append([]string{proTeam, "Name", "Total"}, positions...)
+	headerRow(franchises, append(titles, "Other")...)
+	franchises.SetWidth(1, 24)
+	for _, stat := range stats {
+		name := ""
+		if stat.Team != nil {
+			name = stat.Team.Name
+		}
+		cells := []xlsx.Cell{xlsx.Text(stat.TeamAbbr).Styled(plain), xlsx.Text(name).Styled(plain), xlsx.Int(stat.TotalCount).Styled(plain)}
+		for _, pos := range positions {
+			cells = append(cells, xlsx.Int(stat.Counts[pos]).Styled(plain))
+		}
+		franchises.AddRow(append(cells, xlsx.Int(stat.OtherCount).Styled(plain))...)
+	}
+
+	// Positions: how many of each position went, how early, and to whom.
+	type positionStat struct {
+		count, first, total, valued, value int
+		byTeam                             map[int]int
+	}
+	byPosition := make(map[string]*positionStat)
+	for _, pick := range export.picks {
+		player := export.players[pick.PlayerID]
+		if player == nil {
+			continue
+		}
+		stat := byPosition[player.Position]
+		if stat == nil {
+			stat = &positionStat{first: pick.OverallPick, byTeam: make(map[int]int)}
+			byPosition[player.Position] = stat
+		}
+		stat.count++
+		stat.total += pick.OverallPick
+		stat.byTeam[pick.TeamID]++
+		if pick.ADPRank != nil {
+			stat.valued++
+			stat.value += *pick.ADPRank - pick.OverallPick
+		}
+	}
+	positionSheet := wb.AddSheet("Positions")
+	titles = []string{"Position", "Drafted", "First Pick", "Average Pick", "Average Value"}
+	for _, team := range export.teams {
+		titles = append(titles, team.TeamName)
+	}
+	headerRow(positionSheet, titles...)
+	for col := range titles {
+		positionSheet.SetWidth(col, 14)
+	}
+	for _, pos := range export.profile.PositionAbbrs() {
+		stat := byPosition[pos]
+		if stat == nil {
+			// Optional positions such as IDP only get a row once one is
+			// drafted.
+			if export.profile.IsOptional(pos) {
+				continue
+			}
+			stat = &positionStat{}
+		}
+		cells := []xlsx.Cell{xlsx.Text(pos).Styled(positionStyle(pos, false)), xlsx.Int(stat.count).Styled(plain)}
+		if stat.count > 0 {
+			cells = append(cells, xlsx.Int(stat.first).Styled(plain), xlsx.Number(round1(float64(stat.total)/float64(stat.count))).Styled(plain))
+		} else {
+			cells = append(cells, xlsx.Empty().Styled(plain), xlsx.Empty().Styled(plain))
+		}
+		if stat.valued > 0 {
+			avg := round1(float64(stat.value) / float64(stat.valued))
+			cells = append(cells, xlsx.Number(avg).Styled(valueStyle(stat.value)))
+		} else {
+			cells = append(cells, xlsx.Empty().Styled(plain))
+		}
+		for _, team := range export.teams {
+			cells = append(cells, xlsx.Int(stat.byTeam[team.ID]).Styled(plain))
+		}
+		positionSheet.AddRow(cells...)
+	}
+
+	var buf bytes.Buffer
+	if err := wb.Write(&buf); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=draft-%d.xlsx", draftID))
+	w.Write(buf.Bytes())
+}
+
+// round1 rounds to one decimal place.
+func round1(f float64) float64 {
+	return math.Round(f*10) / 10
+}
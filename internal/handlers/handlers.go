@@ -3,6 +3,7 @@ package handlers
 import (
 	"encoding/json"
 	"fmt"
+	"log"
 	"net/http"
 	"strconv"
 	"strings"
@@ -13,6 +14,7 @@ import (
 	"github.com/vibes/draft-board/internal/models"
 	"github.com/vibes/draft-board/internal/repository"
 	"github.com/vibes/draft-board/internal/snake"
+	"github.com/vibes/draft-board/internal/sport"
 	"github.com/vibes/draft-board/internal/validation"
 )
 
@@ -29,6 +31,22 @@ type Handler struct {
 	queueRepo  *repository.QueueRepository
 	auditRepo  *repository.AuditRepository
 
+	projectionRepo *repository.ProjectionRepository
+	rosterRepo     *repository.RosterRepository
+	scoringRepo    *repository.ScoringRepository
+	reportRepo     *repository.ReportRepository
+	runRepo        *repository.RunSettingsRepository
+	rankingRepo    *repository.RankingRepository
+	tierRepo       *repository.TierRepository
+	rankSourceRepo *repository.RankingSourceRepository
+	seasonRepo     *repository.SeasonRepository
+	noteRepo       *repository.NoteRepository
+	mergeRepo      *repository.MergeRepository
+	nflTeamRepo    *repository.NFLTeamRepository
+	templateRepo   *repository.TemplateRepository
+	leagueRepo     *repository.LeagueRepository
+	platformRepo   *repository.PlatformRepository
+
 	// SSE: map of draft ID to channels
 	sseClients map[int]map[chan SSEEvent]bool
 	sseMutex   sync.RWMutex
@@ -41,6 +59,21 @@ func NewHandler(
 	pickRepo *repository.PickRepository,
 	queueRepo *repository.QueueRepository,
 	auditRepo *repository.AuditRepository,
+	projectionRepo *repository.ProjectionRepository,
+	rosterRepo *repository.RosterRepository,
+	scoringRepo *repository.ScoringRepository,
+	reportRepo *repository.ReportRepository,
+	runRepo *repository.RunSettingsRepository,
+	rankingRepo *repository.RankingRepository,
+	tierRepo *repository.TierRepository,
+	rankSourceRepo *repository.RankingSourceRepository,
+	seasonRepo *repository.SeasonRepository,
+	noteRepo *repository.NoteRepository,
+	mergeRepo *repository.MergeRepository,
+	nflTeamRepo *repository.NFLTeamRepository,
+	templateRepo *repository.TemplateRepository,
+	leagueRepo *repository.LeagueRepository,
+	platformRepo *repository.PlatformRepository,
 ) *Handler {
 	return &Handler{
 		draftRepo:  draftRepo,
@@ -49,6 +82,23 @@ func NewHandler(
 		pickRepo:   pickRepo,
 		queueRepo:  queueRepo,
 		auditRepo:  auditRepo,
+
+		projectionRepo: projectionRepo,
+		rosterRepo:     rosterRepo,
+		scoringRepo:    scoringRepo,
+		reportRepo:     reportRepo,
+		runRepo:        runRepo,
+		rankingRepo:    rankingRepo,
+		tierRepo:       tierRepo,
+		rankSourceRepo: rankSourceRepo,
+		seasonRepo:     seasonRepo,
+		noteRepo:       noteRepo,
+		mergeRepo:      mergeRepo,
+		nflTeamRepo:    nflTeamRepo,
+		templateRepo:   templateRepo,
+		leagueRepo:     leagueRepo,
+		platformRepo:   platformRepo,
+
 		sseClients: make(map[int]map[chan SSEEvent]bool),
 	}
 }
@@ -76,28 +126,24 @@ func getPositionBadge(position string) string {
 		return "-"
 	}
 
-	// Map positions to color classes
-	positionColors := map[string]string{
-		"QB":   "bg-blue-500/20 text-blue-300 border-blue-500/50",
-		"RB":   "bg-orange-500/20 text-orange-300 border-orange-500/50",
-		"WR":   "bg-green-500/20 text-green-300 border-green-500/50",
-		"TE":   "bg-purple-500/20 text-purple-300 border-purple-500/50",
-		"K":    "bg-yellow-500/20 text-yellow-300 border-yellow-500/50",
-		"D/ST": "bg-gray-500/20 text-gray-300 border-gray-500/50",
-		"DL":   "bg-indigo-500/20 text-indigo-300 border-indigo-500/50",
-		"LB":   "bg-blue-600/20 text-blue-300 border-blue-600/50",
-		"DB":   "bg-emerald-500/20 text-emerald-300 border-emerald-500/50",
-	}
-
-	colorClass := positionColors[position]
-	if colorClass == "" {
-		// Default color for unknown positions
-		colorClass = "bg-gray-500/20 text-gray-300 border-gray-500/50"
-	}
+	// Sport profiles pick each position's color; unknown positions are gray
+	color := sport.PositionColor(position)
+	family := strings.Split(color, "-")[0]
+	colorClass := fmt.Sprintf("bg-%s/20 text-%s-300 border-%s/50", color, family, color)
 
 	return fmt.Sprintf(`<span class="px-2 py-1 rounded text-xs font-semibold border %s">%s</span>`, colorClass, position)
 }
 
+// getPlayerPositionBadges shows a badge for each position the player is
+// eligible at, primary first.
+func getPlayerPositionBadges(player *models.Player) string {
+	badges := make([]string, 0, 2)
+	for _, pos := range player.Positions() {
+		badges = append(badges, getPositionBadge(pos))
+	}
+	return strings.Join(badges, " ")
+}
+
 func (h *Handler) Home(w http.ResponseWriter, r *http.Request) {
 	drafts, err := h.draftRepo.List()
 	if err != nil {
@@ -109,11 +155,32 @@ func (h *Handler) Home(w http.ResponseWriter, r *http.Request) {
 	content.WriteString(`
 		<div class="mb-8">
 			<h1 class="text-4xl font-bold mb-2 text-tokyo-night-accent">Fantasy Draft Board</h1>
-			<p class="text-tokyo-night-fg-dim">Manage your offline fantasy football drafts</p>
+			<p class="text-tokyo-night-fg-dim">Manage your offline fantasy football, basketball and baseball drafts</p>
 		</div>
 		<a href="/draft/new" class="inline-block mb-6 px-6 py-3 bg-tokyo-night-accent hover:bg-tokyo-night-accent-hover text-white rounded-lg font-semibold transition-colors">
 			Create New Draft
 		</a>
+		<a href="/players/projections/import" class="inline-block mb-6 ml-2 px-6 py-3 bg-tokyo-night-bg-light hover:bg-tokyo-night-bg border border-tokyo-night-border rounded-lg font-semibold transition-colors">
+			Import Projections
+		</a>
+		<a href="/players/rankings" class="inline-block mb-6 ml-2 px-6 py-3 bg-tokyo-night-bg-light hover:bg-tokyo-night-bg border border-tokyo-night-border rounded-lg font-semibold transition-colors">
+			Ranking Sources
+		</a>
+		<a href="/seasons" class="inline-block mb-6 ml-2 px-6 py-3 bg-tokyo-night-bg-light hover:bg-tokyo-night-bg border border-tokyo-night-border rounded-lg font-semibold transition-colors">
+			Seasons
+		</a>
+		<a href="/leagues" class="inline-block mb-6 ml-2 px-6 py-3 bg-tokyo-night-bg-light hover:bg-tokyo-night-bg border border-tokyo-night-border rounded-lg font-semibold transition-colors">
+			Leagues
+		</a>
+		<a href="/players/status" class="inline-block mb-6 ml-2 px-6 py-3 bg-tokyo-night-bg-light hover:bg-tokyo-night-bg border border-tokyo-night-border rounded-lg font-semibold transition-colors">
+			Player Status
+		</a>
+		<a href="/players/duplicates" class="inline-block mb-6 ml-2 px-6 py-3 bg-tokyo-night-bg-light hover:bg-tokyo-night-bg border border-tokyo-night-border rounded-lg font-semibold transition-colors">
+			Duplicates
+		</a>
+		<a href="/nfl-teams" class="inline-block mb-6 ml-2 px-6 py-3 bg-tokyo-night-bg-light hover:bg-tokyo-night-bg border border-tokyo-night-border rounded-lg font-semibold transition-colors">
+			NFL Teams
+		</a>
 		<div class="mt-8">
 			<h2 class="text-2xl font-semibold mb-4 text-tokyo-night-fg">Your Drafts</h2>
 	`)
@@ -139,9 +206,11 @@ func (h *Handler) Home(w http.ResponseWriter, r *http.Request) {
 					<div class="flex items-center gap-4 text-sm text-tokyo-night-fg-dim">
 						<span class="%s">%s</span>
 						<span>%d teams</span>
+						<span>%d</span>
+						<span>%s</span>
 					</div>
 				</a>
-			`, d.ID, d.Name, statusColor, d.Status, d.NumTeams))
+			`, d.ID, d.Name, statusColor, d.Status, d.NumTeams, d.Season, sport.Get(d.Sport).Name))
 		}
 		content.WriteString(`</div>`)
 	}
@@ -174,13 +243,18 @@ func (h *Handler) NewDraft(w http.ResponseWriter, r *http.Request) {
 						<input type="number" name="num_teams" min="2" max="14" value="10" required 
 							class="w-full px-4 py-2 bg-tokyo-night-bg border border-tokyo-night-border rounded-lg text-tokyo-night-fg focus:outline-none focus:border-tokyo-night-accent">
 					</div>
+					<div>
+						<label class="block text-sm font-medium mb-2 text-tokyo-night-fg">Sport</label>
+						<select name="sport" required 
+							class="w-full px-4 py-2 bg-tokyo-night-bg border border-tokyo-night-border rounded-lg text-tokyo-night-fg focus:outline-none focus:border-tokyo-night-accent">
+							` + sportOptions() + `
+						</select>
+					</div>
 					<div>
 						<label class="block text-sm font-medium mb-2 text-tokyo-night-fg">Scoring Format</label>
 						<select name="scoring_format" required 
 							class="w-full px-4 py-2 bg-tokyo-night-bg border border-tokyo-night-border rounded-lg text-tokyo-night-fg focus:outline-none focus:border-tokyo-night-accent">
-							<option value="Standard">Standard</option>
-							<option value="Half-PPR">Half-PPR</option>
-							<option value="PPR" selected>PPR</option>
+							` + scoringFormatOptions() + `
 						</select>
 					</div>
 					<div>
@@ -202,11 +276,45 @@ func (h *Handler) NewDraft(w http.ResponseWriter, r *http.Request) {
 					</button>
 				</form>
 			</div>
-		</div>
 	`)
+	content.WriteString(h.draftTemplatesSection())
+	content.WriteString(h.draftImportSection())
+	content.WriteString(`</div>`)
 	renderTemplate(w, content.String(), "Create New Draft")
 }
 
+// sportOptions lists every sport profile for the new draft form, football
+// selected.
+func sportOptions() string {
+	var options strings.Builder
+	for _, p := range sport.Profiles {
+		selected := ""
+		if p == sport.FootballProfile {
+			selected = " selected"
+		}
+		options.WriteString(fmt.Sprintf(`<option value="%s"%s>%s</option>`, p.Key, selected, p.Name))
+	}
+	return options.String()
+}
+
+// scoringFormatOptions groups each sport's scoring formats under its name,
+// PPR selected.
+func scoringFormatOptions() string {
+	var options strings.Builder
+	for _, p := range sport.Profiles {
+		options.WriteString(fmt.Sprintf(`<optgroup label="%s">`, p.Name))
+		for _, column := range p.ScoringFormats() {
+			selected := ""
+			if p == sport.FootballProfile && column.ScoringFormat == "PPR" {
+				selected = " selected"
+			}
+			options.WriteString(fmt.Sprintf(`<option value="%s"%s>%s</option>`, column.ScoringFormat, selected, column.Label))
+		}
+		options.WriteString(`</optgroup>`)
+	}
+	return options.String()
+}
+
 func (h *Handler) CreateDraft(w http.ResponseWriter, r *http.Request) {
 	if err := r.ParseForm(); err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest)
@@ -224,6 +332,7 @@ func (h *Handler) CreateDraft(w http.ResponseWriter, r *http.Request) {
 		NumTeams:       numTeams,
 		ScoringFormat:  r.FormValue("scoring_format"),
 		DraftType:      r.FormValue("draft_type"),
+		Sport:          strings.ToLower(r.FormValue("sport")),
 		QBSetting:      "1QB",
 		SnakeDraft:     true,
 		Status:         "setup",
@@ -232,6 +341,13 @@ func (h *Handler) CreateDraft(w http.ResponseWriter, r *http.Request) {
 		Completed:      false,
 	}
 
+	season, err := h.seasonRepo.Current()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	draft.Season = season
+
 	if err := validation.ValidateDraft(draft); err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
@@ -242,6 +358,11 @@ func (h *Handler) CreateDraft(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if err := h.rosterRepo.ReplaceForDraft(draft.ID, sport.Get(draft.Sport).DefaultSlots(draft.QBSetting)); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
 	http.Redirect(w, r, fmt.Sprintf("/draft/%d/setup", draft.ID), http.StatusSeeOther)
 }
 
@@ -336,6 +457,24 @@ func (h *Handler) DraftSetup(w http.ResponseWriter, r *http.Request) {
 			</div>
 		`)
 	}
+	content.WriteString(h.rosterSlotsForm(draft))
+	content.WriteString(h.runSettingsForm(draft))
+	content.WriteString(h.rankSourceForm(draft))
+	content.WriteString(saveTemplateForm(draft))
+	content.WriteString(fmt.Sprintf(`
+		<div class="mt-8 flex items-center gap-4">
+			<a href="/draft/%d/scoring" class="px-4 py-2 bg-tokyo-night-bg-light hover:bg-tokyo-night-bg border border-tokyo-night-border rounded-lg transition-colors">
+				Scoring Rules
+			</a>
+			<span class="text-sm text-tokyo-night-fg-dim">Using %s scoring</span>
+			<a href="/draft/%d/rankings" class="px-4 py-2 bg-tokyo-night-bg-light hover:bg-tokyo-night-bg border border-tokyo-night-border rounded-lg transition-colors">
+				Custom Rankings
+			</a>
+			<a href="/draft/%d/tiers" class="px-4 py-2 bg-tokyo-night-bg-light hover:bg-tokyo-night-bg border border-tokyo-night-border rounded-lg transition-colors">
+				Tiers
+			</a>
+		</div>
+	`, id, h.scoringLabel(draft), id, id))
 	content.WriteString(`</div>`)
 
 	renderTemplate(w, content.String(), "Setup Draft: "+draft.Name)
@@ -535,6 +674,13 @@ func (h *Handler) CompleteDraft(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// A completed draft keeps its first report, so completing it again
+	// changes nothing.
+	if draft.IsCompleted() {
+		http.Redirect(w, r, fmt.Sprintf("/draft/%d", id), http.StatusSeeOther)
+		return
+	}
+
 	draft.Status = "completed"
 	draft.Completed = true
 	if err := h.draftRepo.Update(draft); err != nil {
@@ -543,6 +689,9 @@ func (h *Handler) CompleteDraft(w http.ResponseWriter, r *http.Request) {
 	}
 
 	h.auditRepo.Log(id, "complete", nil, "Draft completed")
+	if err := h.storeReport(draft); err != nil {
+		log.Printf("failed to store report for draft %d: %v", id, err)
+	}
 
 	http.Redirect(w, r, fmt.Sprintf("/draft/%d", id), http.StatusSeeOther)
 }
@@ -559,6 +708,8 @@ func (h *Handler) DeleteDraft(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
+	h.tierRepo.DeleteForSource(draftTierSource(id))
+	h.noteRepo.DeleteByDraft(id)
 
 	http.Redirect(w, r, "/", http.StatusSeeOther)
 }
@@ -592,6 +743,7 @@ func (h *Handler) GetDraftBoard(w http.ResponseWriter, r *http.Request) {
 	pickCount, _ := h.pickRepo.CountByDraft(id)
 	currentPick := pickCount + 1
 
+	owners, _ := h.pickRepo.Owners(id)
 	var currentTeam *models.Team
 	if draft.IsActive() {
 		snakeTeams := make([]snake.Team, len(teams))
@@ -601,7 +753,7 @@ func (h *Handler) GetDraftBoard(w http.ResponseWriter, r *http.Request) {
 				DraftPosition: t.DraftPosition,
 			}
 		}
-		if team, err := snake.CalculateCurrentTeam(currentPick, draft.NumTeams, snakeTeams); err == nil {
+		if team, err := snake.CalculatePickOwner(currentPick, draft.NumTeams, snakeTeams, draft.Snakes(), owners); err == nil {
 			for _, t := range teams {
 				if t.ID == team.ID {
 					currentTeam = &t
@@ -611,6 +763,11 @@ func (h *Handler) GetDraftBoard(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	seasonLabel := strconv.Itoa(draft.Season) + " season"
+	if draft.Rookie {
+		seasonLabel = strconv.Itoa(draft.Season) + " rookie draft"
+	}
+
 	var content strings.Builder
 	content.WriteString(`
 		<div class="mb-8">
@@ -618,6 +775,7 @@ func (h *Handler) GetDraftBoard(w http.ResponseWriter, r *http.Request) {
 				<div>
 					<a href="/" class="text-tokyo-night-fg-dim hover:text-tokyo-night-accent mb-2 inline-block">← Back</a>
 					<h1 class="text-4xl font-bold mb-2 text-tokyo-night-accent">` + draft.Name + `</h1>
+					<p class="text-tokyo-night-fg-dim">` + seasonLabel + `</p>
 				</div>
 			</div>
 			<div class="flex flex-wrap items-center gap-4 mb-6">
@@ -669,6 +827,19 @@ func (h *Handler) GetDraftBoard(w http.ResponseWriter, r *http.Request) {
 						setTimeout(() => location.reload(), 1000);
 					});
 					
+					eventSource.addEventListener('position-run', function(event) {
+						const alert = JSON.parse(event.data);
+						const container = document.getElementById('run-alerts');
+						if (!container) return;
+						const div = document.createElement('div');
+						div.className = 'flex items-center gap-3 px-4 py-2 bg-tokyo-night-bg-light border border-tokyo-night-warning rounded-lg';
+						const span = document.createElement('span');
+						span.className = 'text-tokyo-night-warning font-semibold';
+						span.textContent = alert.message;
+						div.appendChild(span);
+						container.prepend(div);
+					});
+					
 					eventSource.addEventListener('connected', function(event) {
 						console.log('SSE: Connected to stream', event.data);
 					});
@@ -692,6 +863,14 @@ func (h *Handler) GetDraftBoard(w http.ResponseWriter, r *http.Request) {
 		`, id))
 	}
 
+	if !draft.IsCompleted() {
+		content.WriteString(runAlertsBanner(h.pickAlerts(draft)))
+		content.WriteString(h.topTierPanel(draft))
+	}
+	if currentTeam != nil {
+		content.WriteString(suggestedPicksPanel(id, currentTeam, h.suggestedPicks(draft, currentTeam, picks)))
+	}
+
 	// Draft board log
 	content.WriteString(`<div class="overflow-x-auto mb-8" id="draft-board">`)
 	content.WriteString(`<table class="w-full border-collapse bg-tokyo-night-bg-light rounded-lg overflow-hidden">`)
@@ -706,6 +885,8 @@ func (h *Handler) GetDraftBoard(w http.ResponseWriter, r *http.Request) {
 
 	// Create map of picks by overall pick number
 	pickMap := make(map[int]*models.Pick)
+	playerRepo := h.players(draft)
+	notes, _ := h.noteRepo.GetByDraft(id)
 	for i := range picks {
 		pick := &picks[i]
 		pickMap[pick.OverallPick] = pick
@@ -737,11 +918,18 @@ func (h *Handler) GetDraftBoard(w http.ResponseWriter, r *http.Request) {
 
 		// Determine which team should pick at this slot
 		var teamName string
-		if team, err := snake.CalculateCurrentTeam(pickNum, draft.NumTeams, snakeTeams); err == nil {
+		if team, err := snake.CalculatePickOwner(pickNum, draft.NumTeams, snakeTeams, draft.Snakes(), owners); err == nil {
 			if t, ok := teamMap[team.ID]; ok {
 				teamName = t.TeamName
 			}
 		}
+		if _, traded := owners[pickNum]; traded {
+			if team, err := snake.CalculatePickOwner(pickNum, draft.NumTeams, snakeTeams, draft.Snakes(), nil); err == nil {
+				if t, ok := teamMap[team.ID]; ok {
+					teamName += fmt.Sprintf(` <span class="text-xs text-tokyo-night-fg-dim">(from %s)</span>`, t.TeamName)
+				}
+			}
+		}
 
 		content.WriteString(fmt.Sprintf(`<tr class="%s"%s>`, rowClass, rowID))
 		content.WriteString(fmt.Sprintf(`<td class="px-2 py-2 font-medium text-tokyo-night-fg border-b border-tokyo-night-border w-16">%d</td>`, round))
@@ -750,10 +938,11 @@ func (h *Handler) GetDraftBoard(w http.ResponseWriter, r *http.Request) {
 
 		// Check if there's a pick for this slot
 		if pick, ok := pickMap[pickNum]; ok {
-			player, _ := h.playerRepo.GetByID(pick.PlayerID)
+			player, _ := playerRepo.GetByID(pick.PlayerID)
 			if player != nil {
-				content.WriteString(fmt.Sprintf(`<td class="px-4 py-2 font-medium text-tokyo-night-fg border-b border-tokyo-night-border w-64">%s</td>`, player.Name))
-				content.WriteString(fmt.Sprintf(`<td class="px-4 py-2 border-b border-tokyo-night-border">%s</td>`, getPositionBadge(player.Position)))
+				content.WriteString(fmt.Sprintf(`<td class="px-4 py-2 font-medium text-tokyo-night-fg border-b border-tokyo-night-border w-64">%s%s</td>`,
+					player.Name, noteChips(teamNotes(notes, pick.TeamID, player.ID))))
+				content.WriteString(fmt.Sprintf(`<td class="px-4 py-2 border-b border-tokyo-night-border">%s</td>`, getPlayerPositionBadges(player)+getStatusBadge(player)))
 				content.WriteString(fmt.Sprintf(`<td class="px-4 py-2 text-tokyo-night-fg-dim border-b border-tokyo-night-border">%s</td>`, player.Team))
 			} else {
 				content.WriteString(`<td class="px-4 py-2 text-tokyo-night-fg-dim border-b border-tokyo-night-border">-</td>`)
@@ -791,6 +980,9 @@ func (h *Handler) GetDraftBoard(w http.ResponseWriter, r *http.Request) {
 		content.WriteString(`</tr>`)
 	}
 	content.WriteString(`</tbody></table></div>`)
+	if draft.Rookie {
+		content.WriteString(h.carriedRostersPanel(draft, teams))
+	}
 
 	// Scroll to active pick row on page load
 	if draft.IsActive() || draft.IsPaused() {
@@ -1014,12 +1206,30 @@ func (h *Handler) GetDraftBoard(w http.ResponseWriter, r *http.Request) {
 			<a href="/draft/` + fmt.Sprintf("%d", id) + `/stats/value-picks" class="px-4 py-2 bg-tokyo-night-bg-light hover:bg-tokyo-night-bg border border-tokyo-night-border rounded-lg transition-colors">
 				Value Picks
 			</a>
+			<a href="/draft/` + fmt.Sprintf("%d", id) + `/stats/projections" class="px-4 py-2 bg-tokyo-night-bg-light hover:bg-tokyo-night-bg border border-tokyo-night-border rounded-lg transition-colors">
+				Projected Standings
+			</a>
+			<a href="/draft/` + fmt.Sprintf("%d", id) + `/stats/byes" class="px-4 py-2 bg-tokyo-night-bg-light hover:bg-tokyo-night-bg border border-tokyo-night-border rounded-lg transition-colors">
+				Bye Weeks
+			</a>
+			<a href="/draft/` + fmt.Sprintf("%d", id) + `/report" class="px-4 py-2 bg-tokyo-night-bg-light hover:bg-tokyo-night-bg border border-tokyo-night-border rounded-lg transition-colors">
+				Report Card
+			</a>
 			<a href="/draft/` + fmt.Sprintf("%d", id) + `/export/csv" class="px-4 py-2 bg-tokyo-night-bg-light hover:bg-tokyo-night-bg border border-tokyo-night-border rounded-lg transition-colors">
 				Export CSV
 			</a>
 			<a href="/draft/` + fmt.Sprintf("%d", id) + `/export/json" class="px-4 py-2 bg-tokyo-night-bg-light hover:bg-tokyo-night-bg border border-tokyo-night-border rounded-lg transition-colors">
 				Export JSON
 			</a>
+			<a href="/draft/` + fmt.Sprintf("%d", id) + `/export/xlsx" class="px-4 py-2 bg-tokyo-night-bg-light hover:bg-tokyo-night-bg border border-tokyo-night-border rounded-lg transition-colors">
+				Export Excel
+			</a>
+			<a href="/draft/` + fmt.Sprintf("%d", id) + `/export/pdf" class="px-4 py-2 bg-tokyo-night-bg-light hover:bg-tokyo-night-bg border border-tokyo-night-border rounded-lg transition-colors">
+				Print Recap (PDF)
+			</a>
+			<a href="/draft/` + fmt.Sprintf("%d", id) + `/platforms" class="px-4 py-2 bg-tokyo-night-bg-light hover:bg-tokyo-night-bg border border-tokyo-night-border rounded-lg transition-colors">
+				Platform Export
+			</a>
 		</div>
 	`)
 
@@ -1087,17 +1297,35 @@ func (h *Handler) GetAvailablePlayers(w http.ResponseWriter, r *http.Request) {
 	positions := r.URL.Query()["position"]
 	search := r.URL.Query().Get("search")
 	includeDrafted := r.URL.Query().Get("show_drafted") == "on"
+	tag := r.URL.Query().Get("tag")
+	noteSearch := r.URL.Query().Get("notes")
+	notesTeamID, _ := strconv.Atoi(r.URL.Query().Get("team"))
+	statusFilter := r.URL.Query().Get("status")
 
 	filters := repository.PlayerFilters{
 		Positions:      positions,
 		Search:         search,
 		DraftType:      draft.DraftType,
 		ScoringFormat:  draft.ScoringFormat,
+		Sport:          draft.Sport,
+		RookieSeason:   draft.RookieSeason(),
 		IncludeDrafted: includeDrafted,
 		Limit:          100,
+		Tag:            tag,
+		NoteSearch:     noteSearch,
+		NotesTeamID:    notesTeamID,
+	}
+	switch statusFilter {
+	case "healthy":
+		filters.Statuses = []string{models.StatusActive}
+	case "injured":
+		filters.Statuses = []string{models.StatusQuestionable, models.StatusOut, models.StatusIR, models.StatusSuspended}
+	case "":
+	default:
+		filters.Statuses = []string{statusFilter}
 	}
 
-	players, err := h.playerRepo.GetAvailable(id, filters)
+	players, err := h.players(draft).GetAvailable(id, filters)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
@@ -1106,12 +1334,16 @@ func (h *Handler) GetAvailablePlayers(w http.ResponseWriter, r *http.Request) {
 	// Get drafted player IDs to mark them
 	draftedPlayerIDs := make(map[int]bool)
 	if includeDrafted {
-		picks, _ := h.pickRepo.GetByDraft(id)
-		for _, pick := range picks {
-			draftedPlayerIDs[pick.PlayerID] = true
+		picked, _ := h.pickRepo.GetDraftedPlayerIDs(id)
+		for _, playerID := range picked {
+			draftedPlayerIDs[playerID] = true
 		}
 	}
 
+	notes, _ := h.noteRepo.GetByDraft(id)
+	playerNotes := notesByPlayer(notes, notesTeamID)
+	teams, _ := h.teamRepo.GetByDraft(id)
+
 	// Build set of selected positions for checked state
 	selectedPositions := make(map[string]bool)
 	for _, pos := range positions {
@@ -1134,7 +1366,7 @@ func (h *Handler) GetAvailablePlayers(w http.ResponseWriter, r *http.Request) {
 						hx-target="#players-page-content"
 						hx-select="#players-page-content"
 						hx-push-url="true"
-						hx-include="[name='position'], [name='show_drafted']"
+						hx-include="[name='position'], [name='show_drafted'], [name='tag'], [name='notes'], [name='team'], [name='status']"
 						class="w-full px-4 py-2 bg-tokyo-night-bg-light border border-tokyo-night-border rounded-lg text-tokyo-night-fg focus:outline-none focus:border-tokyo-night-accent">
 				</div>
 				<div class="mb-4">
@@ -1142,8 +1374,7 @@ func (h *Handler) GetAvailablePlayers(w http.ResponseWriter, r *http.Request) {
 					<div id="position-filters" class="flex flex-wrap gap-2">
 	`)
 
-	allPositions := []string{"QB", "RB", "WR", "TE", "K", "D/ST"}
-	for _, pos := range allPositions {
+	for _, pos := range sport.Get(draft.Sport).PositionAbbrs() {
 		checked := ""
 		if selectedPositions[pos] {
 			checked = "checked"
@@ -1156,7 +1387,7 @@ func (h *Handler) GetAvailablePlayers(w http.ResponseWriter, r *http.Request) {
 					hx-target="#players-page-content"
 					hx-select="#players-page-content"
 					hx-push-url="true"
-					hx-include="[name='search'], [name='position'], [name='show_drafted']"
+					hx-include="[name='search'], [name='position'], [name='show_drafted'], [name='tag'], [name='notes'], [name='team'], [name='status']"
 					class="sr-only">
 				<span class="text-sm font-medium">%s</span>
 			</label>
@@ -1178,11 +1409,14 @@ func (h *Handler) GetAvailablePlayers(w http.ResponseWriter, r *http.Request) {
 							hx-target="#players-page-content"
 							hx-select="#players-page-content"
 							hx-push-url="true"
-							hx-include="[name='search'], [name='position'], [name='show_drafted']"
+							hx-include="[name='search'], [name='position'], [name='show_drafted'], [name='tag'], [name='notes'], [name='team'], [name='status']"
 							class="w-4 h-4 text-tokyo-night-accent bg-tokyo-night-bg-light border-tokyo-night-border rounded focus:ring-tokyo-night-accent">
 						<span class="ml-2 text-sm text-tokyo-night-fg">Show drafted players</span>
 					</label>
 				</div>
+				<div class="mb-4 flex flex-wrap items-end gap-4">
+	` + statusFilterSelect(id, statusFilter) + noteFilters(id, teams, notes, tag, noteSearch, notesTeamID) + `
+				</div>
 				<button type="submit" class="px-6 py-2 bg-tokyo-night-accent hover:bg-tokyo-night-accent-hover text-white rounded-lg font-semibold transition-colors">
 					Search
 				</button>
@@ -1203,12 +1437,22 @@ func (h *Handler) GetAvailablePlayers(w http.ResponseWriter, r *http.Request) {
 				<tbody>
 	`)
 
+	adpRank := h.adpRanker(draft)
+	playerTiers, _, _ := h.playerTiers(draft)
+	lastTier := make(map[string]int)
 	for _, player := range players {
 		isDrafted := draftedPlayerIDs[player.ID]
 		rank := "-"
-		if r := player.GetADPRank(draft.DraftType, draft.ScoringFormat); r != nil {
+		if r := adpRank(player); r != nil {
 			rank = fmt.Sprintf("%d", *r)
 		}
+		if tier, ok := playerTiers[player.ID]; ok {
+			if last, seen := lastTier[player.Position]; seen && tier != last {
+				content.WriteString(tierSeparator(player.Position, tier))
+			}
+			lastTier[player.Position] = tier
+			rank += fmt.Sprintf(` <span class="text-xs text-tokyo-night-fg-dim">T%d</span>`, tier)
+		}
 		bye := "-"
 		if player.ByeWeek != nil {
 			bye = fmt.Sprintf("%d", *player.ByeWeek)
@@ -1227,11 +1471,15 @@ func (h *Handler) GetAvailablePlayers(w http.ResponseWriter, r *http.Request) {
 		if isDrafted {
 			content.WriteString(` <span class="text-xs text-tokyo-night-fg-dim">(Drafted)</span>`)
 		}
+		content.WriteString(getStatusBadge(player))
+		content.WriteString(noteChips(playerNotes[player.ID]))
 		content.WriteString(`</td>`)
 		content.WriteString(fmt.Sprintf(`<td class="px-4 py-2 border-b border-tokyo-night-border text-tokyo-night-fg-dim">%s</td>`, player.Team))
-		content.WriteString(fmt.Sprintf(`<td class="px-4 py-2 border-b border-tokyo-night-border text-tokyo-night-fg-dim">%s</td>`, player.Position))
+		content.WriteString(fmt.Sprintf(`<td class="px-4 py-2 border-b border-tokyo-night-border text-tokyo-night-fg-dim">%s</td>`, player.PositionLabel()))
 		content.WriteString(fmt.Sprintf(`<td class="px-4 py-2 border-b border-tokyo-night-border text-tokyo-night-fg-dim">%s</td>`, bye))
 
+		noteLink := fmt.Sprintf(`<a href="/draft/%d/players/%d/note?team=%d" class="ml-2 text-sm text-tokyo-night-fg-dim hover:text-tokyo-night-accent">Note</a>`,
+			id, player.ID, notesTeamID)
 		if draft.CanMakePicks() && !isDrafted {
 			content.WriteString(fmt.Sprintf(`<td class="px-4 py-2 border-b border-tokyo-night-border">
 				<form method="POST" action="/draft/%d/pick" class="inline">
@@ -1239,10 +1487,10 @@ func (h *Handler) GetAvailablePlayers(w http.ResponseWriter, r *http.Request) {
 					<button type="submit" class="px-3 py-1 bg-tokyo-night-accent hover:bg-tokyo-night-accent-hover text-white rounded text-sm font-semibold transition-colors">
 						Draft
 					</button>
-				</form>
-			</td>`, id, player.ID))
+				</form>%s
+			</td>`, id, player.ID, noteLink))
 		} else {
-			content.WriteString(`<td class="px-4 py-2 border-b border-tokyo-night-border text-tokyo-night-fg-dim">-</td>`)
+			content.WriteString(`<td class="px-4 py-2 border-b border-tokyo-night-border text-tokyo-night-fg-dim">-` + noteLink + `</td>`)
 		}
 		content.WriteString(`</tr>`)
 	}
@@ -1276,11 +1524,13 @@ func (h *Handler) SearchPlayersJSON(w http.ResponseWriter, r *http.Request) {
 		Search:        search,
 		DraftType:     draft.DraftType,
 		ScoringFormat: draft.ScoringFormat,
+		Sport:         draft.Sport,
+		RookieSeason:  draft.RookieSeason(),
 		IncludeDrafted: false,
 		Limit:         10,
 	}
 
-	players, err := h.playerRepo.GetAvailable(id, filters)
+	players, err := h.players(draft).GetAvailable(id, filters)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
@@ -1292,12 +1542,14 @@ func (h *Handler) SearchPlayersJSON(w http.ResponseWriter, r *http.Request) {
 		Team     string `json:"team"`
 		Position string `json:"position"`
 		Rank     string `json:"rank"`
+		Status   string `json:"status"`
 	}
 
+	adpRank := h.adpRanker(draft)
 	results := make([]PlayerResult, 0, len(players))
 	for _, player := range players {
 		rank := "-"
-		if r := player.GetADPRank(draft.DraftType, draft.ScoringFormat); r != nil {
+		if r := adpRank(player); r != nil {
 			rank = fmt.Sprintf("%d", *r)
 		}
 		results = append(results, PlayerResult{
@@ -1306,6 +1558,7 @@ func (h *Handler) SearchPlayersJSON(w http.ResponseWriter, r *http.Request) {
 			Team:     player.Team,
 			Position: player.Position,
 			Rank:     rank,
+			Status:   player.Status,
 		})
 	}
 
@@ -1355,7 +1608,8 @@ func (h *Handler) MakePick(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	currentTeam, err := snake.CalculateCurrentTeam(currentPickNumber, draft.NumTeams, snakeTeams)
+	owners, _ := h.pickRepo.Owners(draftID)
+	currentTeam, err := snake.CalculatePickOwner(currentPickNumber, draft.NumTeams, snakeTeams, draft.Snakes(), owners)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
@@ -1369,7 +1623,7 @@ func (h *Handler) MakePick(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	player, err := h.playerRepo.GetByID(playerID)
+	player, err := h.players(draft).GetByID(playerID)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusNotFound)
 		return
@@ -1381,7 +1635,7 @@ func (h *Handler) MakePick(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	adpRank := player.GetADPRank(draft.DraftType, draft.ScoringFormat)
+	adpRank := h.adpRanker(draft)(player)
 	round := snake.CalculateRound(currentPickNumber, draft.NumTeams)
 
 	pick := &models.Pick{
@@ -1394,7 +1648,7 @@ func (h *Handler) MakePick(w http.ResponseWriter, r *http.Request) {
 		IsTraded:    false,
 	}
 
-	if err := validation.ValidatePick(pick, draft, teams, pickCount); err != nil {
+	if err := validation.ValidatePick(pick, draft, teams, pickCount, owners); err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
@@ -1420,6 +1674,10 @@ func (h *Handler) MakePick(w http.ResponseWriter, r *http.Request) {
 		},
 	})
 
+	for _, alert := range h.pickAlerts(draft) {
+		h.broadcastEvent(draftID, SSEEvent{Type: "position-run", Data: alert})
+	}
+
 	// Check if draft is complete
 	pickCount++
 	if draft.CheckDraftCompletion(pickCount) {
@@ -1427,6 +1685,9 @@ func (h *Handler) MakePick(w http.ResponseWriter, r *http.Request) {
 		draft.Completed = true
 		h.draftRepo.Update(draft)
 		h.auditRepo.Log(draftID, "complete", nil, "Draft auto-completed")
+		if err := h.storeReport(draft); err != nil {
+			log.Printf("failed to store report for draft %d: %v", draftID, err)
+		}
 		h.broadcastEvent(draftID, SSEEvent{
 			Type: "draft-completed",
 			Data: map[string]interface{}{
@@ -1527,7 +1788,8 @@ func (h *Handler) GetCurrentPick(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	currentTeam, err := snake.CalculateCurrentTeam(currentPick, draft.NumTeams, snakeTeams)
+	owners, _ := h.pickRepo.Owners(id)
+	currentTeam, err := snake.CalculatePickOwner(currentPick, draft.NumTeams, snakeTeams, draft.Snakes(), owners)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
@@ -1650,9 +1912,23 @@ func (h *Handler) GetQueue(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
+	notes, err := h.noteRepo.GetByDraft(draftID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	type queueEntry struct {
+		models.QueueItem
+		Notes []models.PlayerNote
+	}
+	entries := make([]queueEntry, len(items))
+	for i, item := range items {
+		entries[i] = queueEntry{QueueItem: item, Notes: teamNotes(notes, teamID, item.PlayerID)}
+	}
 
 	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(items)
+	json.NewEncoder(w).Encode(entries)
 }
 
 func (h *Handler) AddToQueue(w http.ResponseWriter, r *http.Request) {
@@ -1712,8 +1988,14 @@ func (h *Handler) CreateCustomPlayer(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	profile, ok := sport.Lookup(r.FormValue("sport"))
+	if !ok {
+		http.Error(w, validation.ErrInvalidSport.Error(), http.StatusBadRequest)
+		return
+	}
+
 	var byeWeek *int
-	if bw := r.FormValue("bye_week"); bw != "" {
+	if bw := r.FormValue("bye_week"); bw != "" && profile.ByeWeeks {
 		if b, err := strconv.Atoi(bw); err == nil {
 			byeWeek = &b
 		}
@@ -1721,16 +2003,36 @@ func (h *Handler) CreateCustomPlayer(w http.ResponseWriter, r *http.Request) {
 
 	player := &models.Player{
 		Name:     r.FormValue("name"),
-		Team:     r.FormValue("team"),
+		Team:     strings.ToUpper(strings.TrimSpace(r.FormValue("team"))),
 		Position: r.FormValue("position"),
 		ByeWeek:  byeWeek,
 		IsCustom: true,
+		Sport:    profile.Key,
+	}
+	// Football teams go by their canonical abbreviation and bye week.
+	if profile.Key == sport.Football {
+		teams := h.nflTeams()
+		player.Team = teams.Canonical(r.FormValue("team"))
+		if team := teams.Lookup(player.Team); team != nil && team.ByeWeek != nil {
+			player.ByeWeek = team.ByeWeek
+		}
 	}
 
-	if err := validation.ValidatePosition(player.Position); err != nil {
+	if err := validation.ValidatePosition(profile, player.Position); err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
+	eligible, err := eligiblePositions(profile, r.FormValue("eligible_positions"), player.Position)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	player.EligiblePositions = eligible
+	if rookie, _ := strconv.ParseBool(r.FormValue("rookie")); rookie || r.FormValue("rookie") == "on" {
+		if season, err := h.seasonRepo.Current(); err == nil {
+			player.RookieSeason = &season
+		}
+	}
 
 	if err := h.playerRepo.Create(player); err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -1769,6 +2071,8 @@ func (h *Handler) GetBigBoard(w http.ResponseWriter, r *http.Request) {
 
 	// Build pick map by round and team
 	pickMap := make(map[int]map[int]*models.Pick)
+	playerRepo := h.players(draft)
+	notes, _ := h.noteRepo.GetByDraft(id)
 	for i := range picks {
 		pick := &picks[i]
 		if pickMap[pick.Round] == nil {
@@ -1777,6 +2081,12 @@ func (h *Handler) GetBigBoard(w http.ResponseWriter, r *http.Request) {
 		pickMap[pick.Round][pick.TeamID] = pick
 	}
 
+	// The board can be downloaded as an image with one team highlighted.
+	teamOptions := ""
+	for _, team := range teams {
+		teamOptions += fmt.Sprintf(`<option value="%d">%s</option>`, team.ID, team.TeamName)
+	}
+
 	w.Header().Set("Content-Type", "text/html")
 	var content strings.Builder
 	content.WriteString(`
@@ -1786,9 +2096,19 @@ func (h *Handler) GetBigBoard(w http.ResponseWriter, r *http.Request) {
 					<a href="/draft/` + fmt.Sprintf("%d", id) + `" class="text-tokyo-night-fg-dim hover:text-tokyo-night-accent mb-4 inline-block">← Back to Draft</a>
 					<h1 class="text-4xl font-bold mb-2 text-tokyo-night-accent">Big Board - ` + draft.Name + `</h1>
 				</div>
-				<button onclick="window.print()" class="no-print px-4 py-2 bg-tokyo-night-accent hover:bg-tokyo-night-accent-hover text-white rounded-lg font-semibold transition-colors">
-					Print
-				</button>
+				<div class="no-print flex items-center gap-2">
+					<form method="GET" action="/draft/` + fmt.Sprintf("%d", id) + `/big-board.png" class="flex items-center gap-2">
+						<select name="team" class="px-3 py-2 bg-tokyo-night-bg border border-tokyo-night-border rounded-lg text-tokyo-night-fg focus:outline-none focus:border-tokyo-night-accent">
+							<option value="">No highlight</option>
+							` + teamOptions + `
+						</select>
+						<button type="submit" class="px-4 py-2 bg-tokyo-night-bg-light hover:bg-tokyo-night-bg border border-tokyo-night-border rounded-lg transition-colors">PNG</button>
+						<button type="submit" formaction="/draft/` + fmt.Sprintf("%d", id) + `/big-board.svg" class="px-4 py-2 bg-tokyo-night-bg-light hover:bg-tokyo-night-bg border border-tokyo-night-border rounded-lg transition-colors">SVG</button>
+					</form>
+					<button onclick="window.print()" class="px-4 py-2 bg-tokyo-night-accent hover:bg-tokyo-night-accent-hover text-white rounded-lg font-semibold transition-colors">
+						Print
+					</button>
+				</div>
 			</div>
 		</div>
 		<div class="overflow-x-auto">
@@ -1820,7 +2140,7 @@ func (h *Handler) GetBigBoard(w http.ResponseWriter, r *http.Request) {
 		content.WriteString(fmt.Sprintf(`<tr><td class="px-3 py-2 font-medium text-tokyo-night-fg border-b border-tokyo-night-border">Round %d</td>`, round))
 		for _, team := range teams {
 			if pick, ok := pickMap[round][team.ID]; ok {
-				player, _ := h.playerRepo.GetByID(pick.PlayerID)
+				player, _ := playerRepo.GetByID(pick.PlayerID)
 				if player != nil {
 					traded := ""
 					if pick.IsTraded {
@@ -1829,8 +2149,8 @@ func (h *Handler) GetBigBoard(w http.ResponseWriter, r *http.Request) {
 					content.WriteString(fmt.Sprintf(`<td class="px-3 py-2 border-b border-tokyo-night-border">
 						<div class="font-medium text-tokyo-night-fg">%s</div>
 						<div class="text-xs text-tokyo-night-fg-dim">%s - %s</div>
-						%s
-					</td>`, player.Name, player.Position, player.Team, traded))
+						%s%s
+					</td>`, player.Name, player.Position, player.Team, traded, noteChips(teamNotes(notes, pick.TeamID, player.ID))))
 				} else {
 					content.WriteString(`<td class="px-3 py-2 border-b border-tokyo-night-border text-tokyo-night-fg-dim">-</td>`)
 				}
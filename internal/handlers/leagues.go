@@ -0,0 +1,785 @@
+package handlers
+
+import (
+	"fmt"
+	"html/template"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/vibes/draft-board/internal/league"
+	"github.com/vibes/draft-board/internal/models"
+	"github.com/vibes/draft-board/internal/sport"
+)
+
+// leagueFromRequest reads the league named in the URL, writing the error
+// response when there is none.
+func (h *Handler) leagueFromRequest(w http.ResponseWriter, r *http.Request) (*models.League, bool) {
+	leagueID, err := strconv.Atoi(chi.URLParam(r, "leagueId"))
+	if err != nil {
+		http.Error(w, "Invalid league ID", http.StatusBadRequest)
+		return nil, false
+	}
+	l, err := h.leagueRepo.GetByID(leagueID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return nil, false
+	}
+	return l, true
+}
+
+// managerNames maps the league's manager IDs to their names.
+func (h *Handler) managerNames(leagueID int) (map[int]string, error) {
+	managers, err := h.leagueRepo.Managers(leagueID)
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[int]string, len(managers))
+	for _, m := range managers {
+		names[m.ID] = m.Name
+	}
+	return names, nil
+}
+
+// leagueHeader is the back link, title and page links shared by a league's
+// pages.
+func leagueHeader(l *models.League, subtitle string) string {
+	link := "px-4 py-2 bg-tokyo-night-bg-light hover:bg-tokyo-night-bg border border-tokyo-night-border rounded-lg transition-colors"
+	return fmt.Sprintf(`
+		<div class="mb-8">
+			<a href="/leagues" class="text-tokyo-night-fg-dim hover:text-tokyo-night-accent mb-4 inline-block">← Leagues</a>
+			<h1 class="text-4xl font-bold mb-2 text-tokyo-night-accent">%s</h1>
+			<p class="text-tokyo-night-fg-dim mb-4">%s · %s</p>
+			<div class="flex flex-wrap gap-2">
+				<a href="/leagues/%d" class="%s">History</a>
+				<a href="/leagues/%d/tendencies" class="%s">Owner Tendencies</a>
+				<a href="/leagues/%d/superlatives" class="%s">Superlatives</a>
+				<a href="/leagues/%d/rookie-draft" class="%s">Rookie Draft</a>
+			</div>
+		</div>
+	`, template.HTMLEscapeString(l.Name), sport.Get(l.Sport).Name, subtitle, l.ID, link, l.ID, link, l.ID, link, l.ID, link)
+}
+
+// GetLeagues lists the leagues with a form to start one
+func (h *Handler) GetLeagues(w http.ResponseWriter, r *http.Request) {
+	leagues, err := h.leagueRepo.List()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var content strings.Builder
+	content.WriteString(`
+		<div class="mb-8">
+			<a href="/" class="text-tokyo-night-fg-dim hover:text-tokyo-night-accent mb-4 inline-block">← Back</a>
+			<h1 class="text-4xl font-bold mb-2 text-tokyo-night-accent">Leagues</h1>
+			<p class="text-tokyo-night-fg-dim">A league groups its drafts by season and follows its franchises and managers from year to year</p>
+		</div>
+	`)
+
+	if len(leagues) == 0 {
+		content.WriteString(`<div class="bg-tokyo-night-bg-light rounded-lg border border-tokyo-night-border p-8 mb-8 text-center text-tokyo-night-fg-dim">No leagues yet</div>`)
+	} else {
+		content.WriteString(`<div class="grid gap-4 md:grid-cols-2 lg:grid-cols-3 mb-8">`)
+		for _, l := range leagues {
+			drafts, err := h.leagueRepo.Drafts(l.ID)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			seasons := "No drafts yet"
+			if len(drafts) > 0 {
+				seasons = fmt.Sprintf("%d drafts, %d–%d", len(drafts), drafts[0].Season, drafts[len(drafts)-1].Season)
+			}
+			content.WriteString(fmt.Sprintf(`
+				<a href="/leagues/%d" class="block bg-tokyo-night-bg-light rounded-lg p-6 border border-tokyo-night-border hover:border-tokyo-night-accent transition-colors">
+					<h3 class="text-xl font-semibold mb-2 text-tokyo-night-fg">%s</h3>
+					<div class="flex items-center gap-4 text-sm text-tokyo-night-fg-dim">
+						<span>%s</span>
+						<span>%s</span>
+					</div>
+				</a>
+			`, l.ID, template.HTMLEscapeString(l.Name), sport.Get(l.Sport).Name, seasons))
+		}
+		content.WriteString(`</div>`)
+	}
+
+	content.WriteString(`
+		<div class="bg-tokyo-night-bg-light rounded-lg border border-tokyo-night-border p-6 max-w-2xl">
+			<h2 class="text-2xl font-semibold mb-4 text-tokyo-night-fg">New League</h2>
+			<form method="POST" action="/leagues" class="flex flex-wrap items-end gap-4">
+				<div class="flex-1">
+					<label class="block text-sm font-medium mb-2 text-tokyo-night-fg">Name</label>
+					<input type="text" name="name" required maxlength="100"
+						class="w-full px-4 py-2 bg-tokyo-night-bg border border-tokyo-night-border rounded-lg text-tokyo-night-fg focus:outline-none focus:border-tokyo-night-accent">
+				</div>
+				<div>
+					<label class="block text-sm font-medium mb-2 text-tokyo-night-fg">Sport</label>
+					<select name="sport" class="px-4 py-2 bg-tokyo-night-bg border border-tokyo-night-border rounded-lg text-tokyo-night-fg focus:outline-none focus:border-tokyo-night-accent">
+						` + sportOptions() + `
+					</select>
+				</div>
+				<button type="submit"
+					class="px-4 py-2 bg-tokyo-night-accent hover:bg-tokyo-night-accent-hover text-white rounded-lg font-semibold transition-colors">
+					Create League
+				</button>
+			</form>
+		</div>
+	`)
+
+	renderTemplate(w, content.String(), "Leagues")
+}
+
+// CreateLeague starts a league
+func (h *Handler) CreateLeague(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	l := &models.League{
+		Name:  strings.TrimSpace(r.FormValue("name")),
+		Sport: strings.ToLower(r.FormValue("sport")),
+	}
+	if l.Name == "" {
+		http.Error(w, "League name is required", http.StatusBadRequest)
+		return
+	}
+	if _, ok := sport.Lookup(l.Sport); !ok {
+		http.Error(w, "Unknown sport: "+l.Sport, http.StatusBadRequest)
+		return
+	}
+
+	if err := h.leagueRepo.Create(l); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	http.Redirect(w, r, fmt.Sprintf("/leagues/%d", l.ID), http.StatusSeeOther)
+}
+
+// GetLeague shows the league's history: its drafts by season, and each
+// franchise's first pick every season with a form to rename it or change
+// its manager
+func (h *Handler) GetLeague(w http.ResponseWriter, r *http.Request) {
+	l, ok := h.leagueFromRequest(w, r)
+	if !ok {
+		return
+	}
+
+	drafts, err := h.leagueRepo.Drafts(l.ID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	franchises, err := h.leagueRepo.Franchises(l.ID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	managers, err := h.managerNames(l.ID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	picks, err := h.leagueRepo.Picks(l.ID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// The first overall pick of each draft, and each franchise's first
+	// pick in each draft.
+	firstOverall := make(map[int]models.LeaguePick)
+	firstPicks := make(map[string]models.LeaguePick)
+	for _, pick := range picks {
+		if _, ok := firstOverall[pick.DraftID]; !ok {
+			firstOverall[pick.DraftID] = pick
+		}
+		if pick.FranchiseID != nil {
+			key := fmt.Sprintf("%d:%d", *pick.FranchiseID, pick.DraftID)
+			if _, ok := firstPicks[key]; !ok {
+				firstPicks[key] = pick
+			}
+		}
+	}
+
+	var content strings.Builder
+	content.WriteString(leagueHeader(l, fmt.Sprintf("%d drafts, %d franchises", len(drafts), len(franchises))))
+
+	content.WriteString(`
+		<h2 class="text-2xl font-semibold mb-4 text-tokyo-night-fg">Drafts</h2>
+		<div class="overflow-x-auto mb-8">
+			<table class="w-full border-collapse bg-tokyo-night-bg-light rounded-lg overflow-hidden">
+				<thead>
+					<tr class="bg-tokyo-night-bg-dark">
+						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">Season</th>
+						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">Draft</th>
+						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">Status</th>
+						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">First Overall Pick</th>
+						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border"></th>
+					</tr>
+				</thead>
+				<tbody>
+	`)
+	if len(drafts) == 0 {
+		content.WriteString(`<tr><td colspan="5" class="px-4 py-6 text-center text-tokyo-night-fg-dim">No drafts in this league yet</td></tr>`)
+	}
+	for _, d := range drafts {
+		first := `<span class="text-tokyo-night-fg-dim">-</span>`
+		if pick, ok := firstOverall[d.ID]; ok {
+			first = fmt.Sprintf(`%s %s <span class="text-sm text-tokyo-night-fg-dim">by %s</span>`,
+				getPositionBadge(pick.Position), template.HTMLEscapeString(pick.PlayerName),
+				template.HTMLEscapeString(league.Owner(pick, managers)))
+		}
+		content.WriteString(fmt.Sprintf(`
+					<tr class="hover:bg-tokyo-night-bg">
+						<td class="px-4 py-2 border-b border-tokyo-night-border font-semibold">%d</td>
+						<td class="px-4 py-2 border-b border-tokyo-night-border"><a href="/draft/%d" class="text-tokyo-night-accent hover:underline">%s</a></td>
+						<td class="px-4 py-2 border-b border-tokyo-night-border text-tokyo-night-fg-dim">%s</td>
+						<td class="px-4 py-2 border-b border-tokyo-night-border">%s</td>
+						<td class="px-4 py-2 border-b border-tokyo-night-border text-right whitespace-nowrap">
+							<a href="/leagues/%d/drafts/%d" class="text-sm text-tokyo-night-accent hover:underline mr-3">Teams</a>
+							<form method="POST" action="/leagues/%d/drafts/%d/detach" class="inline" onsubmit="return confirm('Remove this draft from the league?')">
+								<button type="submit" class="text-sm text-tokyo-night-error hover:underline">Remove</button>
+							</form>
+						</td>
+					</tr>
+		`, d.Season, d.ID, template.HTMLEscapeString(d.Name), d.Status, first, l.ID, d.ID, l.ID, d.ID))
+	}
+	content.WriteString(`</tbody></table></div>`)
+
+	content.WriteString(h.attachDraftForm(l))
+
+	content.WriteString(`
+		<h2 class="text-2xl font-semibold mb-4 text-tokyo-night-fg">Franchises</h2>
+		<p class="text-sm text-tokyo-night-fg-dim mb-4">Each franchise's first pick by season</p>
+		<div class="overflow-x-auto mb-8">
+			<table class="w-full border-collapse bg-tokyo-night-bg-light rounded-lg overflow-hidden">
+				<thead>
+					<tr class="bg-tokyo-night-bg-dark">
+						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">Franchise</th>
+						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">Manager</th>
+	`)
+	for _, d := range drafts {
+		content.WriteString(fmt.Sprintf(`<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">%d</th>`, d.Season))
+	}
+	content.WriteString(`</tr></thead><tbody>`)
+
+	inputClass := "px-2 py-1 bg-tokyo-night-bg border border-tokyo-night-border rounded text-tokyo-night-fg focus:outline-none focus:border-tokyo-night-accent"
+	for _, f := range franchises {
+		manager := ""
+		if f.ManagerID != nil {
+			manager = managers[*f.ManagerID]
+		}
+		formID := fmt.Sprintf("franchise-%d", f.ID)
+		content.WriteString(fmt.Sprintf(`
+					<tr class="hover:bg-tokyo-night-bg">
+						<td class="px-4 py-2 border-b border-tokyo-night-border">
+							<form id="%s" method="POST" action="/leagues/%d/franchises">
+								<input type="hidden" name="id" value="%d">
+								<input type="text" name="name" value="%s" required maxlength="50" class="%s">
+							</form>
+						</td>
+						<td class="px-4 py-2 border-b border-tokyo-night-border whitespace-nowrap">
+							<input form="%s" type="text" name="manager" value="%s" maxlength="50" list="league-managers" class="%s w-32">
+							<button form="%s" type="submit" class="ml-1 text-sm text-tokyo-night-accent hover:underline">Save</button>
+						</td>
+		`, formID, l.ID, f.ID, template.HTMLEscapeString(f.Name), inputClass,
+			formID, template.HTMLEscapeString(manager), inputClass, formID))
+		for _, d := range drafts {
+			cell := `<span class="text-tokyo-night-fg-dim">-</span>`
+			if pick, ok := firstPicks[fmt.Sprintf("%d:%d", f.ID, d.ID)]; ok {
+				cell = fmt.Sprintf(`%s %s <span class="text-xs text-tokyo-night-fg-dim">#%d</span>`,
+					getPositionBadge(pick.Position), template.HTMLEscapeString(pick.PlayerName), pick.OverallPick)
+			}
+			content.WriteString(fmt.Sprintf(`<td class="px-4 py-2 border-b border-tokyo-night-border text-sm whitespace-nowrap">%s</td>`, cell))
+		}
+		content.WriteString(`</tr>`)
+	}
+	content.WriteString(`</tbody></table></div>`)
+
+	content.WriteString(`<datalist id="league-managers">`)
+	for _, name := range sortedNames(managers) {
+		content.WriteString(fmt.Sprintf(`<option value="%s">`, template.HTMLEscapeString(name)))
+	}
+	content.WriteString(`</datalist>`)
+
+	content.WriteString(fmt.Sprintf(`
+		<div class="bg-tokyo-night-bg-light rounded-lg border border-tokyo-night-border p-6 max-w-2xl">
+			<h2 class="text-2xl font-semibold mb-4 text-tokyo-night-fg">Add Franchise</h2>
+			<form method="POST" action="/leagues/%d/franchises" class="flex flex-wrap items-end gap-4">
+				<div class="flex-1">
+					<label class="block text-sm font-medium mb-2 text-tokyo-night-fg">Franchise</label>
+					<input type="text" name="name" required maxlength="50" class="w-full %s">
+				</div>
+				<div class="flex-1">
+					<label class="block text-sm font-medium mb-2 text-tokyo-night-fg">Manager</label>
+					<input type="text" name="manager" maxlength="50" list="league-managers" class="w-full %s">
+				</div>
+				<button type="submit"
+					class="px-4 py-2 bg-tokyo-night-accent hover:bg-tokyo-night-accent-hover text-white rounded-lg font-semibold transition-colors">
+					Add
+				</button>
+			</form>
+		</div>
+	`, l.ID, inputClass, inputClass))
+
+	renderTemplate(w, content.String(), l.Name)
+}
+
+// attachDraftForm offers the drafts of the league's sport that are not in
+// a league yet.
+func (h *Handler) attachDraftForm(l *models.League) string {
+	drafts, _ := h.draftRepo.List()
+	var options strings.Builder
+	for _, d := range drafts {
+		if d.LeagueID != nil || sport.Get(d.Sport).Key != sport.Get(l.Sport).Key {
+			continue
+		}
+		options.WriteString(fmt.Sprintf(`<option value="%d">%d · %s</option>`, d.ID, d.Season, template.HTMLEscapeString(d.Name)))
+	}
+	if options.Len() == 0 {
+		return ""
+	}
+	return fmt.Sprintf(`
+		<div class="bg-tokyo-night-bg-light rounded-lg border border-tokyo-night-border p-6 mb-8 max-w-2xl">
+			<h2 class="text-2xl font-semibold mb-2 text-tokyo-night-fg">Add a Draft</h2>
+			<p class="text-sm text-tokyo-night-fg-dim mb-4">The draft becomes the league's draft for its season. Its teams are matched to franchises by name and to managers by owner, adding any that are new.</p>
+			<form method="POST" action="/leagues/%d/drafts" class="flex flex-wrap items-end gap-4">
+				<select name="draft_id" class="flex-1 px-4 py-2 bg-tokyo-night-bg border border-tokyo-night-border rounded-lg text-tokyo-night-fg focus:outline-none focus:border-tokyo-night-accent">%s</select>
+				<button type="submit"
+					class="px-4 py-2 bg-tokyo-night-accent hover:bg-tokyo-night-accent-hover text-white rounded-lg font-semibold transition-colors">
+					Add Draft
+				</button>
+			</form>
+		</div>
+	`, l.ID, options.String())
+}
+
+func sortedNames(names map[int]string) []string {
+	sorted := make([]string, 0, len(names))
+	for _, name := range names {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+	return sorted
+}
+
+// AttachLeagueDraft adds a draft to the league and links its teams to
+// franchises and managers
+func (h *Handler) AttachLeagueDraft(w http.ResponseWriter, r *http.Request) {
+	l, ok := h.leagueFromRequest(w, r)
+	if !ok {
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	draftID, err := strconv.Atoi(r.FormValue("draft_id"))
+	if err != nil {
+		http.Error(w, "Invalid draft ID", http.StatusBadRequest)
+		return
+	}
+	draft, err := h.draftRepo.GetByID(draftID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	if sport.Get(draft.Sport).Key != sport.Get(l.Sport).Key {
+		http.Error(w, "Draft is for a different sport than the league", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.leagueRepo.AttachDraft(l.ID, draft.ID); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	http.Redirect(w, r, fmt.Sprintf("/leagues/%d", l.ID), http.StatusSeeOther)
+}
+
+// DetachLeagueDraft removes a draft from the league
+func (h *Handler) DetachLeagueDraft(w http.ResponseWriter, r *http.Request) {
+	l, ok := h.leagueFromRequest(w, r)
+	if !ok {
+		return
+	}
+	draftID, err := strconv.Atoi(chi.URLParam(r, "draftId"))
+	if err != nil {
+		http.Error(w, "Invalid draft ID", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.leagueRepo.DetachDraft(draftID); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	http.Redirect(w, r, fmt.Sprintf("/leagues/%d", l.ID), http.StatusSeeOther)
+}
+
+// SaveFranchise adds a franchise to the league, or renames one and changes
+// its manager
+func (h *Handler) SaveFranchise(w http.ResponseWriter, r *http.Request) {
+	l, ok := h.leagueFromRequest(w, r)
+	if !ok {
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	franchise := &models.Franchise{
+		LeagueID: l.ID,
+		Name:     strings.TrimSpace(r.FormValue("name")),
+	}
+	if franchise.Name == "" {
+		http.Error(w, "Franchise name is required", http.StatusBadRequest)
+		return
+	}
+	if id := r.FormValue("id"); id != "" {
+		franchise.ID, _ = strconv.Atoi(id)
+	}
+
+	if err := h.leagueRepo.SaveFranchise(franchise, r.FormValue("manager")); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	http.Redirect(w, r, fmt.Sprintf("/leagues/%d", l.ID), http.StatusSeeOther)
+}
+
+// leagueDraft reads the draft named in the URL, writing the error response
+// when it is not one of the league's drafts.
+func (h *Handler) leagueDraft(w http.ResponseWriter, r *http.Request, l *models.League) (*models.Draft, bool) {
+	draftID, err := strconv.Atoi(chi.URLParam(r, "draftId"))
+	if err != nil {
+		http.Error(w, "Invalid draft ID", http.StatusBadRequest)
+		return nil, false
+	}
+	draft, err := h.draftRepo.GetByID(draftID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return nil, false
+	}
+	if draft.LeagueID == nil || *draft.LeagueID != l.ID {
+		http.Error(w, "Draft is not in this league", http.StatusNotFound)
+		return nil, false
+	}
+	return draft, true
+}
+
+// GetLeagueDraftTeams shows which franchise each of a league draft's teams
+// was and who managed it, with a form to change them
+func (h *Handler) GetLeagueDraftTeams(w http.ResponseWriter, r *http.Request) {
+	l, ok := h.leagueFromRequest(w, r)
+	if !ok {
+		return
+	}
+	draft, ok := h.leagueDraft(w, r, l)
+	if !ok {
+		return
+	}
+
+	teams, err := h.teamRepo.GetByDraft(draft.ID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	franchises, err := h.leagueRepo.Franchises(l.ID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	managers, err := h.leagueRepo.Managers(l.ID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	selectClass := "px-3 py-2 bg-tokyo-night-bg border border-tokyo-night-border rounded-lg text-tokyo-night-fg focus:outline-none focus:border-tokyo-night-accent"
+	var content strings.Builder
+	content.WriteString(leagueHeader(l, fmt.Sprintf("%d · %s", draft.Season, template.HTMLEscapeString(draft.Name))))
+	content.WriteString(fmt.Sprintf(`
+		<form method="POST" action="/leagues/%d/drafts/%d" class="max-w-3xl">
+			<table class="w-full border-collapse bg-tokyo-night-bg-light rounded-lg overflow-hidden mb-4">
+				<thead>
+					<tr class="bg-tokyo-night-bg-dark">
+						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">Team</th>
+						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">Franchise</th>
+						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">Manager</th>
+					</tr>
+				</thead>
+				<tbody>
+	`, l.ID, draft.ID))
+	for _, team := range teams {
+		var franchiseOptions, managerOptions strings.Builder
+		franchiseOptions.WriteString(`<option value="">None</option>`)
+		for _, f := range franchises {
+			selected := ""
+			if team.FranchiseID != nil && *team.FranchiseID == f.ID {
+				selected = " selected"
+			}
+			franchiseOptions.WriteString(fmt.Sprintf(`<option value="%d"%s>%s</option>`, f.ID, selected, template.HTMLEscapeString(f.Name)))
+		}
+		managerOptions.WriteString(`<option value="">None</option>`)
+		for _, m := range managers {
+			selected := ""
+			if team.ManagerID != nil && *team.ManagerID == m.ID {
+				selected = " selected"
+			}
+			managerOptions.WriteString(fmt.Sprintf(`<option value="%d"%s>%s</option>`, m.ID, selected, template.HTMLEscapeString(m.Name)))
+		}
+		content.WriteString(fmt.Sprintf(`
+					<tr>
+						<td class="px-4 py-2 border-b border-tokyo-night-border">%d. %s <span class="text-sm text-tokyo-night-fg-dim">%s</span></td>
+						<td class="px-4 py-2 border-b border-tokyo-night-border"><select name="franchise_%d" class="%s">%s</select></td>
+						<td class="px-4 py-2 border-b border-tokyo-night-border"><select name="manager_%d" class="%s">%s</select></td>
+					</tr>
+		`, team.DraftPosition, template.HTMLEscapeString(team.TeamName), template.HTMLEscapeString(team.OwnerName),
+			team.ID, selectClass, franchiseOptions.String(), team.ID, selectClass, managerOptions.String()))
+	}
+	content.WriteString(`
+				</tbody>
+			</table>
+			<button type="submit"
+				class="px-4 py-2 bg-tokyo-night-accent hover:bg-tokyo-night-accent-hover text-white rounded-lg font-semibold transition-colors">
+				Save Teams
+			</button>
+		</form>
+	`)
+
+	renderTemplate(w, content.String(), l.Name+": "+draft.Name)
+}
+
+// UpdateLeagueDraftTeams links a league draft's teams to the franchises
+// and managers chosen for them
+func (h *Handler) UpdateLeagueDraftTeams(w http.ResponseWriter, r *http.Request) {
+	l, ok := h.leagueFromRequest(w, r)
+	if !ok {
+		return
+	}
+	draft, ok := h.leagueDraft(w, r, l)
+	if !ok {
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	teams, err := h.teamRepo.GetByDraft(draft.ID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	franchises, _ := h.leagueRepo.Franchises(l.ID)
+	managers, _ := h.leagueRepo.Managers(l.ID)
+	known := make(map[string]bool)
+	for _, f := range franchises {
+		known[fmt.Sprintf("franchise:%d", f.ID)] = true
+	}
+	for _, m := range managers {
+		known[fmt.Sprintf("manager:%d", m.ID)] = true
+	}
+
+	// formID reads an optional franchise or manager of the league.
+	formID := func(kind string, teamID int) (*int, error) {
+		raw := r.FormValue(fmt.Sprintf("%s_%d", kind, teamID))
+		if raw == "" {
+			return nil, nil
+		}
+		id, err := strconv.Atoi(raw)
+		if err != nil || !known[fmt.Sprintf("%s:%d", kind, id)] {
+			return nil, fmt.Errorf("unknown %s %q", kind, raw)
+		}
+		return &id, nil
+	}
+
+	for _, team := range teams {
+		franchiseID, err := formID("franchise", team.ID)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		managerID, err := formID("manager", team.ID)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if err := h.leagueRepo.LinkTeam(team.ID, franchiseID, managerID); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	http.Redirect(w, r, fmt.Sprintf("/leagues/%d", l.ID), http.StatusSeeOther)
+}
+
+// GetLeagueTendencies shows how each manager drafts: the positions they
+// take overall and early, the round they usually take their first player
+// at each position, whether they reach or wait for value, and their early
+// picks each season
+func (h *Handler) GetLeagueTendencies(w http.ResponseWriter, r *http.Request) {
+	l, ok := h.leagueFromRequest(w, r)
+	if !ok {
+		return
+	}
+	managers, err := h.managerNames(l.ID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	picks, err := h.leagueRepo.Picks(l.ID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	profile := sport.Get(l.Sport)
+	tendencies := league.Tendencies(picks, managers)
+
+	var content strings.Builder
+	content.WriteString(leagueHeader(l, fmt.Sprintf("Owner tendencies over %d picks", len(picks))))
+	if len(tendencies) == 0 {
+		content.WriteString(`<div class="bg-tokyo-night-bg-light rounded-lg border border-tokyo-night-border p-8 text-center text-tokyo-night-fg-dim">No picks in this league's drafts yet</div>`)
+	}
+
+	for _, t := range tendencies {
+		value := "No ADP data"
+		if t.ValuePicks > 0 {
+			switch {
+			case t.Value < 0:
+				value = fmt.Sprintf(`<span class="text-tokyo-night-error">Reaches %.1f spots</span> before ADP on average`, -t.Value)
+			case t.Value > 0:
+				value = fmt.Sprintf(`<span class="text-tokyo-night-success">Waits %.1f spots</span> past ADP on average`, t.Value)
+			default:
+				value = "Picks right at ADP on average"
+			}
+		}
+		content.WriteString(fmt.Sprintf(`
+			<div class="bg-tokyo-night-bg-light rounded-lg border border-tokyo-night-border p-6 mb-6">
+				<div class="flex flex-wrap items-baseline justify-between gap-4 mb-4">
+					<h2 class="text-2xl font-semibold text-tokyo-night-fg">%s</h2>
+					<span class="text-sm text-tokyo-night-fg-dim">%d drafts · %d picks · %s</span>
+				</div>
+				<table class="w-full text-sm mb-4">
+					<thead>
+						<tr class="text-tokyo-night-fg-dim">
+							<th class="py-1 text-left font-medium">Position</th>
+							<th class="py-1 text-right font-medium">Share of Picks</th>
+							<th class="py-1 text-right font-medium">Rounds 1-%d</th>
+							<th class="py-1 text-right font-medium">Avg First Round</th>
+						</tr>
+					</thead>
+					<tbody>
+		`, template.HTMLEscapeString(t.Manager), t.Drafts, t.Picks, value, league.EarlyRounds))
+		for _, pos := range tendencyPositions(profile, t) {
+			first := "-"
+			if round, ok := t.FirstRound[pos]; ok {
+				first = fmt.Sprintf("%.1f", round)
+			}
+			content.WriteString(fmt.Sprintf(`
+						<tr class="border-t border-tokyo-night-border">
+							<td class="py-1">%s</td>
+							<td class="py-1 text-right">%.0f%% <span class="text-tokyo-night-fg-dim">(%d)</span></td>
+							<td class="py-1 text-right">%d</td>
+							<td class="py-1 text-right">%s</td>
+						</tr>
+			`, getPositionBadge(pos), t.Share(pos)*100, t.Positions[pos], t.Early[pos], first))
+		}
+		content.WriteString(`</tbody></table><div class="space-y-1 text-sm">`)
+		for _, season := range t.Seasons {
+			var early []string
+			for _, pick := range season.Picks {
+				early = append(early, fmt.Sprintf(`%s %s`, getPositionBadge(pick.Position), template.HTMLEscapeString(pick.PlayerName)))
+			}
+			if len(early) == 0 {
+				early = append(early, `<span class="text-tokyo-night-fg-dim">No early picks</span>`)
+			}
+			content.WriteString(fmt.Sprintf(`<div><span class="font-semibold text-tokyo-night-fg-dim mr-2">%d</span>%s</div>`,
+				season.Season, strings.Join(early, ` <span class="text-tokyo-night-fg-dim">→</span> `)))
+		}
+		content.WriteString(`</div></div>`)
+	}
+
+	renderTemplate(w, content.String(), l.Name+": Owner Tendencies")
+}
+
+// tendencyPositions lists the profile's positions the manager has drafted,
+// then any other position they drafted.
+func tendencyPositions(profile *sport.Profile, t *league.Tendency) []string {
+	var positions []string
+	for _, pos := range profile.PositionAbbrs() {
+		if t.Positions[pos] > 0 {
+			positions = append(positions, pos)
+		}
+	}
+	var others []string
+	for pos := range t.Positions {
+		if !profile.HasPosition(pos) {
+			others = append(others, pos)
+		}
+	}
+	sort.Strings(others)
+	return append(positions, others...)
+}
+
+// GetLeagueSuperlatives lists the league's all-time records
+func (h *Handler) GetLeagueSuperlatives(w http.ResponseWriter, r *http.Request) {
+	l, ok := h.leagueFromRequest(w, r)
+	if !ok {
+		return
+	}
+	managers, err := h.managerNames(l.ID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	picks, err := h.leagueRepo.Picks(l.ID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var content strings.Builder
+	content.WriteString(leagueHeader(l, "All-time superlatives"))
+
+	sections := []struct {
+		title   string
+		records []league.Superlative
+	}{
+		{"Records", league.Superlatives(picks, managers)},
+		{"Earliest by Position", league.Earliest(picks, managers, sport.Get(l.Sport).PositionAbbrs())},
+	}
+	for _, section := range sections {
+		content.WriteString(fmt.Sprintf(`<h2 class="text-2xl font-semibold mb-4 text-tokyo-night-fg">%s</h2>`, section.title))
+		if len(section.records) == 0 {
+			content.WriteString(`<div class="bg-tokyo-night-bg-light rounded-lg border border-tokyo-night-border p-8 mb-8 text-center text-tokyo-night-fg-dim">Not enough drafts yet</div>`)
+			continue
+		}
+		content.WriteString(`<div class="grid gap-4 md:grid-cols-2 lg:grid-cols-3 mb-8">`)
+		for _, record := range section.records {
+			season := ""
+			if record.Season > 0 {
+				season = fmt.Sprintf(`<span class="text-sm text-tokyo-night-fg-dim ml-2">%d</span>`, record.Season)
+			}
+			content.WriteString(fmt.Sprintf(`
+				<div class="bg-tokyo-night-bg-light rounded-lg border border-tokyo-night-border p-4">
+					<div class="text-sm uppercase tracking-wide text-tokyo-night-fg-dim mb-1">%s</div>
+					<div class="text-xl font-semibold text-tokyo-night-accent">%s%s</div>
+					<div class="text-sm text-tokyo-night-fg">%s</div>
+				</div>
+			`, record.Title, template.HTMLEscapeString(record.Holder), season, template.HTMLEscapeString(record.Detail)))
+		}
+		content.WriteString(`</div>`)
+	}
+
+	renderTemplate(w, content.String(), l.Name+": Superlatives")
+}
@@ -0,0 +1,123 @@
+package handlers
+
+import (
+	"fmt"
+	"html/template"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/vibes/draft-board/internal/models"
+	"github.com/vibes/draft-board/internal/nfl"
+)
+
+// nflTeams indexes the NFL teams table, falling back to the built-in teams
+// if it can't be read.
+func (h *Handler) nflTeams() *nfl.Index {
+	teams, err := h.nflTeamRepo.List()
+	if err != nil || len(teams) == 0 {
+		return nfl.NewIndex(nfl.Teams)
+	}
+	return nfl.NewIndex(teams)
+}
+
+// GetNFLTeams lists the NFL teams by division with forms to set each
+// team's bye week and aliases
+func (h *Handler) GetNFLTeams(w http.ResponseWriter, r *http.Request) {
+	teams, err := h.nflTeamRepo.List()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var content strings.Builder
+	content.WriteString(`
+		<div class="mb-8">
+			<a href="/" class="text-tokyo-night-fg-dim hover:text-tokyo-night-accent mb-4 inline-block">← Back</a>
+			<h1 class="text-4xl font-bold mb-2 text-tokyo-night-accent">NFL Teams</h1>
+			<p class="text-tokyo-night-fg-dim">Imports map aliases to the team's abbreviation, and players take their team's bye week.</p>
+		</div>
+		<div class="grid grid-cols-1 lg:grid-cols-2 gap-6">
+	`)
+
+	division := ""
+	for _, team := range teams {
+		if team.DivisionName() != division {
+			if division != "" {
+				content.WriteString(`</tbody></table></div>`)
+			}
+			division = team.DivisionName()
+			content.WriteString(fmt.Sprintf(`
+			<div class="bg-tokyo-night-bg-light rounded-lg border border-tokyo-night-border overflow-hidden">
+				<h2 class="px-4 py-3 bg-tokyo-night-bg-dark text-lg font-semibold text-tokyo-night-fg">%s</h2>
+				<table class="w-full text-sm"><tbody>
+			`, division))
+		}
+		bye := ""
+		if team.ByeWeek != nil {
+			bye = strconv.Itoa(*team.ByeWeek)
+		}
+		content.WriteString(fmt.Sprintf(`
+				<tr class="border-t border-tokyo-night-border">
+					<td class="px-4 py-2"><span class="font-semibold text-tokyo-night-fg">%s</span> <span class="text-tokyo-night-fg-dim">%s</span></td>
+					<td class="px-4 py-2">
+						<form method="POST" action="/nfl-teams/%s" class="flex items-center gap-2">
+							<input type="number" name="bye_week" value="%s" min="1" max="18" placeholder="Bye" title="Bye week"
+								class="w-16 px-2 py-1 bg-tokyo-night-bg border border-tokyo-night-border rounded text-tokyo-night-fg">
+							<input type="text" name="aliases" value="%s" placeholder="Aliases" title="Other abbreviations, comma-separated"
+								class="w-28 px-2 py-1 bg-tokyo-night-bg border border-tokyo-night-border rounded text-tokyo-night-fg">
+							<button type="submit" class="px-3 py-1 bg-tokyo-night-accent hover:bg-tokyo-night-accent-hover text-white rounded font-semibold transition-colors">Save</button>
+						</form>
+					</td>
+				</tr>
+		`, team.Abbr, template.HTMLEscapeString(team.Name), team.Abbr, bye, template.HTMLEscapeString(team.Aliases)))
+	}
+	if division != "" {
+		content.WriteString(`</tbody></table></div>`)
+	}
+	content.WriteString(`</div>`)
+
+	renderTemplate(w, content.String(), "NFL Teams")
+}
+
+// UpdateNFLTeam sets a team's bye week and aliases
+func (h *Handler) UpdateNFLTeam(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	team := &models.NFLTeam{Abbr: strings.ToUpper(chi.URLParam(r, "abbr"))}
+	if raw := strings.TrimSpace(r.FormValue("bye_week")); raw != "" {
+		week, err := strconv.Atoi(raw)
+		if err != nil || week < 1 || week > 18 {
+			http.Error(w, "Bye week must be between 1 and 18", http.StatusBadRequest)
+			return
+		}
+		team.ByeWeek = &week
+	}
+
+	// Aliases can't name another team, or imports couldn't tell them apart.
+	teams := h.nflTeams()
+	var aliases []string
+	for _, alias := range strings.Split(r.FormValue("aliases"), ",") {
+		alias = strings.ToUpper(strings.TrimSpace(alias))
+		if alias == "" || alias == team.Abbr {
+			continue
+		}
+		if other := teams.Lookup(alias); other != nil && other.Abbr != team.Abbr {
+			http.Error(w, fmt.Sprintf("%s already refers to %s", alias, other.Abbr), http.StatusBadRequest)
+			return
+		}
+		aliases = append(aliases, alias)
+	}
+	team.Aliases = strings.Join(aliases, ",")
+
+	if err := h.nflTeamRepo.Update(team); err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	http.Redirect(w, r, "/nfl-teams", http.StatusSeeOther)
+}
@@ -0,0 +1,356 @@
+package handlers
+
+import (
+	"fmt"
+	"hash/fnv"
+	"html/template"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/vibes/draft-board/internal/models"
+)
+
+// tagColors are the chip colors tags cycle through, picked by tag name so a
+// tag keeps its color everywhere it appears.
+var tagColors = []string{
+	"bg-pink-500/20 text-pink-300 border-pink-500/50",
+	"bg-cyan-500/20 text-cyan-300 border-cyan-500/50",
+	"bg-lime-500/20 text-lime-300 border-lime-500/50",
+	"bg-amber-500/20 text-amber-300 border-amber-500/50",
+	"bg-violet-500/20 text-violet-300 border-violet-500/50",
+	"bg-red-500/20 text-red-300 border-red-500/50",
+	"bg-teal-500/20 text-teal-300 border-teal-500/50",
+	"bg-sky-500/20 text-sky-300 border-sky-500/50",
+}
+
+func tagBadge(tag string) string {
+	hash := fnv.New32a()
+	hash.Write([]byte(tag))
+	colorClass := tagColors[hash.Sum32()%uint32(len(tagColors))]
+	return fmt.Sprintf(`<span class="px-1.5 py-0.5 rounded text-xs border %s">%s</span>`, colorClass, template.HTMLEscapeString(tag))
+}
+
+// noteChips renders a player's tags, with the note text behind a marker
+// that shows it on hover.
+func noteChips(notes []models.PlayerNote) string {
+	var chips []string
+	var texts []string
+	for _, note := range notes {
+		for _, tag := range note.TagList() {
+			chips = append(chips, tagBadge(tag))
+		}
+		if note.Note != "" {
+			texts = append(texts, note.Note)
+		}
+	}
+	if len(texts) > 0 {
+		chips = append(chips, fmt.Sprintf(`<span class="text-xs text-tokyo-night-accent cursor-help" title="%s">✎</span>`,
+			template.HTMLEscapeString(strings.Join(texts, "\n"))))
+	}
+	if len(chips) == 0 {
+		return ""
+	}
+	return ` <span class="inline-flex flex-wrap items-center gap-1 align-middle">` + strings.Join(chips, "") + `</span>`
+}
+
+// notesByPlayer groups a draft's notes by player, keeping the shared notes
+// and those of one team. A teamID of 0 keeps only shared notes.
+func notesByPlayer(notes []models.PlayerNote, teamID int) map[int][]models.PlayerNote {
+	byPlayer := make(map[int][]models.PlayerNote)
+	for _, note := range notes {
+		if note.TeamID == 0 || note.TeamID == teamID {
+			byPlayer[note.PlayerID] = append(byPlayer[note.PlayerID], note)
+		}
+	}
+	return byPlayer
+}
+
+// teamNotes returns the notes on one player that a team sees.
+func teamNotes(notes []models.PlayerNote, teamID, playerID int) []models.PlayerNote {
+	var visible []models.PlayerNote
+	for _, note := range notes {
+		if note.PlayerID == playerID && (note.TeamID == 0 || note.TeamID == teamID) {
+			visible = append(visible, note)
+		}
+	}
+	return visible
+}
+
+// draftTags lists the tags used in a draft's notes.
+func draftTags(notes []models.PlayerNote) []string {
+	seen := make(map[string]bool)
+	var tags []string
+	for _, note := range notes {
+		for _, tag := range note.TagList() {
+			if !seen[tag] {
+				seen[tag] = true
+				tags = append(tags, tag)
+			}
+		}
+	}
+	sort.Strings(tags)
+	return tags
+}
+
+// noteFilters renders the available players filters on notes: whose notes
+// to show, a tag, and text to find in the notes.
+func noteFilters(draftID int, teams []models.Team, notes []models.PlayerNote, tag, noteSearch string, teamID int) string {
+	hx := fmt.Sprintf(`hx-get="/draft/%d/players" hx-target="#players-page-content" hx-select="#players-page-content" hx-push-url="true"
+		hx-include="[name='search'], [name='position'], [name='show_drafted'], [name='tag'], [name='notes'], [name='team'], [name='status']"`, draftID)
+	selectClass := "px-3 py-2 bg-tokyo-night-bg-light border border-tokyo-night-border rounded-lg text-tokyo-night-fg focus:outline-none focus:border-tokyo-night-accent"
+	selected := func(match bool) string {
+		if match {
+			return "selected"
+		}
+		return ""
+	}
+
+	var teamOptions strings.Builder
+	teamOptions.WriteString(fmt.Sprintf(`<option value="0" %s>Shared notes</option>`, selected(teamID == 0)))
+	for _, team := range teams {
+		teamOptions.WriteString(fmt.Sprintf(`<option value="%d" %s>Shared + %s</option>`,
+			team.ID, selected(team.ID == teamID), template.HTMLEscapeString(team.TeamName)))
+	}
+	var tagOptions strings.Builder
+	tagOptions.WriteString(`<option value="">Any tag</option>`)
+	for _, t := range draftTags(notes) {
+		tagOptions.WriteString(fmt.Sprintf(`<option value="%s" %s>%s</option>`,
+			template.HTMLEscapeString(t), selected(t == tag), template.HTMLEscapeString(t)))
+	}
+
+	return fmt.Sprintf(`
+		<div>
+			<label class="block text-sm font-medium mb-2 text-tokyo-night-fg">Notes</label>
+			<select name="team" %s hx-trigger="change" class="%s">%s</select>
+		</div>
+		<div>
+			<label class="block text-sm font-medium mb-2 text-tokyo-night-fg">Tag</label>
+			<select name="tag" %s hx-trigger="change" class="%s">%s</select>
+		</div>
+		<div>
+			<label class="block text-sm font-medium mb-2 text-tokyo-night-fg">Note text</label>
+			<input type="text" name="notes" value="%s" placeholder="Search notes..." %s hx-trigger="keyup changed delay:300ms" class="%s">
+		</div>
+		<a href="/draft/%d/notes" class="px-3 py-2 text-sm text-tokyo-night-fg-dim hover:text-tokyo-night-accent">All notes</a>
+	`, hx, selectClass, teamOptions.String(), hx, selectClass, tagOptions.String(),
+		template.HTMLEscapeString(noteSearch), hx, selectClass, draftID)
+}
+
+// GetDraftNotes lists every note in a draft
+func (h *Handler) GetDraftNotes(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	draftID, err := strconv.Atoi(idStr)
+	if err != nil {
+		http.Error(w, "Invalid draft ID", http.StatusBadRequest)
+		return
+	}
+
+	draft, err := h.draftRepo.GetByID(draftID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	notes, err := h.noteRepo.GetByDraft(draftID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	teams, err := h.teamRepo.GetByDraft(draftID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	teamNames := make(map[int]string)
+	for _, team := range teams {
+		teamNames[team.ID] = team.TeamName
+	}
+
+	var content strings.Builder
+	content.WriteString(fmt.Sprintf(`
+		<div class="mb-8">
+			<a href="/draft/%d" class="text-tokyo-night-fg-dim hover:text-tokyo-night-accent mb-4 inline-block">← Back to Draft</a>
+			<h1 class="text-4xl font-bold mb-2 text-tokyo-night-accent">Player Notes</h1>
+			<p class="text-tokyo-night-fg-dim">Add notes from the Note link on the available players list. Shared notes show for every team.</p>
+		</div>
+	`, draftID))
+
+	if tags := draftTags(notes); len(tags) > 0 {
+		content.WriteString(`<div class="mb-6 flex flex-wrap gap-2">`)
+		for _, tag := range tags {
+			content.WriteString(fmt.Sprintf(`<a href="/draft/%d/players?tag=%s">%s</a>`, draftID, url.QueryEscape(tag), tagBadge(tag)))
+		}
+		content.WriteString(`</div>`)
+	}
+
+	content.WriteString(`
+		<div class="overflow-x-auto">
+			<table class="w-full border-collapse bg-tokyo-night-bg-light rounded-lg overflow-hidden">
+				<thead>
+					<tr class="bg-tokyo-night-bg-dark">
+						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">Player</th>
+						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">For</th>
+						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">Tags</th>
+						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">Note</th>
+						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border"></th>
+					</tr>
+				</thead>
+				<tbody>
+	`)
+	playerRepo := h.players(draft)
+	for _, note := range notes {
+		player, err := playerRepo.GetByID(note.PlayerID)
+		if err != nil {
+			continue
+		}
+		scope := "Everyone"
+		if note.TeamID != 0 {
+			scope = template.HTMLEscapeString(teamNames[note.TeamID])
+		}
+		var tags strings.Builder
+		for _, tag := range note.TagList() {
+			tags.WriteString(tagBadge(tag) + " ")
+		}
+		content.WriteString(fmt.Sprintf(`
+			<tr class="hover:bg-tokyo-night-bg-dark transition-colors">
+				<td class="px-4 py-2 border-b border-tokyo-night-border">%s <span class="font-medium text-tokyo-night-fg">%s</span> <span class="text-sm text-tokyo-night-fg-dim">%s</span></td>
+				<td class="px-4 py-2 border-b border-tokyo-night-border text-tokyo-night-fg-dim">%s</td>
+				<td class="px-4 py-2 border-b border-tokyo-night-border">%s</td>
+				<td class="px-4 py-2 border-b border-tokyo-night-border text-tokyo-night-fg">%s</td>
+				<td class="px-4 py-2 border-b border-tokyo-night-border">
+					<a href="/draft/%d/players/%d/note?team=%d" class="text-sm text-tokyo-night-accent hover:underline">Edit</a>
+				</td>
+			</tr>
+		`, getPlayerPositionBadges(player), template.HTMLEscapeString(player.Name), player.Team, scope,
+			tags.String(), template.HTMLEscapeString(note.Note), draftID, note.PlayerID, note.TeamID))
+	}
+	if len(notes) == 0 {
+		content.WriteString(`<tr><td colspan="5" class="px-4 py-8 text-center text-tokyo-night-fg-dim">No notes yet</td></tr>`)
+	}
+	content.WriteString(`</tbody></table></div>`)
+
+	renderTemplate(w, content.String(), "Player Notes")
+}
+
+// GetPlayerNote shows the form for one team's note on a player
+func (h *Handler) GetPlayerNote(w http.ResponseWriter, r *http.Request) {
+	draftID, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		http.Error(w, "Invalid draft ID", http.StatusBadRequest)
+		return
+	}
+	playerID, err := strconv.Atoi(chi.URLParam(r, "playerId"))
+	if err != nil {
+		http.Error(w, "Invalid player ID", http.StatusBadRequest)
+		return
+	}
+	teamID, _ := strconv.Atoi(r.URL.Query().Get("team"))
+
+	draft, err := h.draftRepo.GetByID(draftID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	player, err := h.players(draft).GetByID(playerID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	note, err := h.noteRepo.Get(draftID, teamID, playerID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if note == nil {
+		note = &models.PlayerNote{}
+	}
+	teams, _ := h.teamRepo.GetByDraft(draftID)
+
+	selected := func(match bool) string {
+		if match {
+			return "selected"
+		}
+		return ""
+	}
+	var teamOptions strings.Builder
+	teamOptions.WriteString(fmt.Sprintf(`<option value="0" %s>Everyone in the draft</option>`, selected(teamID == 0)))
+	for _, team := range teams {
+		teamOptions.WriteString(fmt.Sprintf(`<option value="%d" %s>Only %s</option>`,
+			team.ID, selected(team.ID == teamID), template.HTMLEscapeString(team.TeamName)))
+	}
+
+	inputClass := "w-full px-4 py-2 bg-tokyo-night-bg border border-tokyo-night-border rounded-lg text-tokyo-night-fg focus:outline-none focus:border-tokyo-night-accent"
+	content := fmt.Sprintf(`
+		<div class="max-w-2xl mx-auto">
+			<div class="mb-8">
+				<a href="/draft/%d/players" class="text-tokyo-night-fg-dim hover:text-tokyo-night-accent mb-4 inline-block">← Back to Players</a>
+				<h1 class="text-4xl font-bold mb-2 text-tokyo-night-accent">%s</h1>
+				<p class="text-tokyo-night-fg-dim">%s %s</p>
+			</div>
+			<form method="POST" action="/draft/%d/players/%d/note" class="space-y-4 bg-tokyo-night-bg-light rounded-lg border border-tokyo-night-border p-6">
+				<div>
+					<label class="block text-sm font-medium mb-2 text-tokyo-night-fg">Visible to</label>
+					<select name="team" class="%s"
+						onchange="window.location='/draft/%d/players/%d/note?team=' + this.value">%s</select>
+				</div>
+				<div>
+					<label class="block text-sm font-medium mb-2 text-tokyo-night-fg">Tags</label>
+					<input type="text" name="tags" value="%s" placeholder="sleeper, avoid - injury, handcuff" class="%s">
+				</div>
+				<div>
+					<label class="block text-sm font-medium mb-2 text-tokyo-night-fg">Note</label>
+					<textarea name="note" rows="4" class="%s">%s</textarea>
+				</div>
+				<p class="text-sm text-tokyo-night-fg-dim">Clear both fields to remove the note.</p>
+				<button type="submit" class="w-full px-6 py-3 bg-tokyo-night-accent hover:bg-tokyo-night-accent-hover text-white rounded-lg font-semibold transition-colors">
+					Save Note
+				</button>
+			</form>
+		</div>
+	`, draftID, template.HTMLEscapeString(player.Name), getPlayerPositionBadges(player), player.Team,
+		draftID, playerID, inputClass, draftID, playerID, teamOptions.String(),
+		template.HTMLEscapeString(strings.ReplaceAll(note.Tags, ",", ", ")), inputClass,
+		inputClass, template.HTMLEscapeString(note.Note))
+
+	renderTemplate(w, content, "Note - "+player.Name)
+}
+
+// SavePlayerNote stores one team's note on a player
+func (h *Handler) SavePlayerNote(w http.ResponseWriter, r *http.Request) {
+	draftID, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		http.Error(w, "Invalid draft ID", http.StatusBadRequest)
+		return
+	}
+	playerID, err := strconv.Atoi(chi.URLParam(r, "playerId"))
+	if err != nil {
+		http.Error(w, "Invalid player ID", http.StatusBadRequest)
+		return
+	}
+	teamID, _ := strconv.Atoi(r.FormValue("team"))
+
+	if teamID != 0 {
+		team, err := h.teamRepo.GetByID(teamID)
+		if err != nil || team.DraftID != draftID {
+			http.Error(w, "Team not found in this draft", http.StatusBadRequest)
+			return
+		}
+	}
+
+	note := &models.PlayerNote{
+		DraftID:  draftID,
+		TeamID:   teamID,
+		PlayerID: playerID,
+		Note:     strings.TrimSpace(r.FormValue("note")),
+		Tags:     r.FormValue("tags"),
+	}
+	if err := h.noteRepo.Save(note); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	http.Redirect(w, r, fmt.Sprintf("/draft/%d/notes", draftID), http.StatusSeeOther)
+}
@@ -0,0 +1,296 @@
+package handlers
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/vibes/draft-board/internal/importer"
+	"github.com/vibes/draft-board/internal/models"
+	"github.com/vibes/draft-board/internal/platform"
+)
+
+// platformDraft lays out the draft for a platform exporter, with each
+// player given by their ID in ids. Picks whose player has no ID are left
+// out and returned as unmapped, in pick order.
+func (e *draftExport) platformDraft(ids map[int]string) (*platform.Draft, []models.Pick) {
+	teams := max(len(e.teams), 1)
+	out := &platform.Draft{Name: e.draft.Name, Teams: len(e.teams)}
+	var unmapped []models.Pick
+	for _, pick := range e.picks {
+		id, ok := ids[pick.PlayerID]
+		if !ok {
+			unmapped = append(unmapped, pick)
+			continue
+		}
+		p := platform.Pick{
+			Round:       pick.Round,
+			PickInRound: (pick.OverallPick-1)%teams + 1,
+			OverallPick: pick.OverallPick,
+			PlayerID:    id,
+		}
+		if team := e.team(pick.TeamID); team != nil {
+			p.Slot = team.DraftPosition
+			p.TeamName = team.TeamName
+		}
+		if player := e.players[pick.PlayerID]; player != nil {
+			p.PlayerName = player.Name
+			p.Position = player.Position
+		}
+		out.Picks = append(out.Picks, p)
+	}
+	return out, unmapped
+}
+
+// GetPlatformExports lists the platforms a draft can be exported to, with
+// the drafted players each one has no ID for, and a form to import IDs.
+func (h *Handler) GetPlatformExports(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	draftID, err := strconv.Atoi(idStr)
+	if err != nil {
+		http.Error(w, "Invalid draft ID", http.StatusBadRequest)
+		return
+	}
+
+	draft, err := h.draftRepo.GetByID(draftID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	export, err := h.collectExport(draft)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	counts, err := h.platformRepo.Counts()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var content strings.Builder
+	content.WriteString(fmt.Sprintf(`
+		<div class="mb-8">
+			<a href="/draft/%d" class="text-tokyo-night-fg-dim hover:text-tokyo-night-accent mb-4 inline-block">← Back to Draft</a>
+			<h1 class="text-4xl font-bold mb-2 text-tokyo-night-accent">Platform Export</h1>
+			<p class="text-tokyo-night-fg-dim">Download %s's picks round by round for a hosting site's offline draft import. Every drafted player needs an ID on the site; import them below.</p>
+		</div>
+		<div class="grid grid-cols-1 lg:grid-cols-2 gap-6 mb-8">
+	`, draftID, template.HTMLEscapeString(draft.Name)))
+
+	exporters := platform.All()
+	for _, exporter := range exporters {
+		ids, err := h.platformRepo.IDs(exporter.Key())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		_, unmapped := export.platformDraft(ids)
+
+		content.WriteString(fmt.Sprintf(`
+			<div class="bg-tokyo-night-bg-light rounded-lg border border-tokyo-night-border p-6">
+				<div class="flex items-center justify-between mb-2">
+					<h2 class="text-2xl font-semibold text-tokyo-night-fg">%s</h2>
+		`, template.HTMLEscapeString(exporter.Name())))
+		if len(export.picks) > 0 && len(unmapped) == 0 {
+			content.WriteString(fmt.Sprintf(`
+					<a href="/draft/%d/platforms/%s/export" class="px-4 py-2 bg-tokyo-night-accent hover:bg-tokyo-night-accent-hover text-white rounded-lg font-semibold transition-colors">
+						Download .%s
+					</a>
+			`, draftID, exporter.Key(), exporter.Extension()))
+		}
+		content.WriteString(fmt.Sprintf(`
+				</div>
+				<p class="text-sm text-tokyo-night-fg-dim mb-4">%d of %d drafted players mapped. %d players have %s IDs.</p>
+		`, len(export.picks)-len(unmapped), len(export.picks), counts[exporter.Key()], template.HTMLEscapeString(exporter.Name())))
+
+		if len(export.picks) == 0 {
+			content.WriteString(`<p class="text-tokyo-night-fg-dim">No picks yet</p>`)
+		} else if len(unmapped) == 0 {
+			content.WriteString(`<p class="text-tokyo-night-success">Every drafted player is mapped</p>`)
+		} else {
+			content.WriteString(`
+				<h3 class="font-semibold mb-2 text-tokyo-night-warning">Unmapped players</h3>
+				<ul class="space-y-1 text-sm max-h-64 overflow-y-auto">
+			`)
+			for _, pick := range unmapped {
+				label := fmt.Sprintf("player #%d", pick.PlayerID)
+				if player := export.players[pick.PlayerID]; player != nil {
+					label = fmt.Sprintf("%s %s <span class=\"text-tokyo-night-fg-dim\">%s</span> <span class=\"text-tokyo-night-fg-dim\">#%d</span>",
+						getPlayerPositionBadges(player), template.HTMLEscapeString(player.Name), player.Team, player.ID)
+				}
+				content.WriteString(fmt.Sprintf(`<li><span class="text-tokyo-night-fg-dim">%d.%02d</span> %s</li>`,
+					pick.Round, (pick.OverallPick-1)%max(len(export.teams), 1)+1, label))
+			}
+			content.WriteString(`</ul>`)
+		}
+		content.WriteString(`</div>`)
+	}
+	content.WriteString(`</div>`)
+
+	var options strings.Builder
+	for _, exporter := range exporters {
+		options.WriteString(fmt.Sprintf(`<option value="%s">%s</option>`, exporter.Key(), template.HTMLEscapeString(exporter.Name())))
+	}
+	content.WriteString(fmt.Sprintf(`
+		<div class="max-w-2xl bg-tokyo-night-bg-light rounded-lg border border-tokyo-night-border p-6">
+			<h2 class="text-2xl font-semibold mb-4 text-tokyo-night-fg">Import Player IDs</h2>
+			<form method="POST" action="/platforms/ids/import" enctype="multipart/form-data" class="space-y-4">
+				<input type="hidden" name="draft_id" value="%d">
+				<select name="platform" class="w-full px-4 py-2 bg-tokyo-night-bg border border-tokyo-night-border rounded-lg text-tokyo-night-fg">%s</select>
+				<input type="file" name="file" accept=".csv,.json" required
+					class="w-full px-4 py-2 bg-tokyo-night-bg border border-tokyo-night-border rounded-lg text-tokyo-night-fg">
+				<p class="text-sm text-tokyo-night-fg-dim">
+					CSV columns: <code>player_id</code> or <code>name</code>, optional <code>team</code> and <code>position</code>,
+					and <code>platform_id</code>, the player's ID on the site. JSON takes an array of objects with the same keys.
+					A player's new ID replaces their old one; players not in the file keep theirs.
+				</p>
+				<button type="submit" class="w-full px-6 py-3 bg-tokyo-night-accent hover:bg-tokyo-night-accent-hover text-white rounded-lg font-semibold transition-colors">
+					Import
+				</button>
+			</form>
+		</div>
+	`, draftID, options.String()))
+
+	renderTemplate(w, content.String(), "Platform Export")
+}
+
+// ImportPlatformIDs maps players to a platform's IDs from an uploaded CSV
+// or JSON file
+func (h *Handler) ImportPlatformIDs(w http.ResponseWriter, r *http.Request) {
+	exporter, ok := platform.Get(r.FormValue("platform"))
+	if !ok {
+		http.Error(w, "Unknown platform", http.StatusBadRequest)
+		return
+	}
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	defer file.Close()
+
+	var rows []importer.PlatformIDRow
+	if strings.EqualFold(filepath.Ext(header.Filename), ".json") {
+		rows, err = importer.ParsePlatformIDsJSON(file)
+	} else {
+		rows, err = importer.ParsePlatformIDsCSV(file)
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	players, err := h.playerRepo.List()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	matcher := importer.NewMatcher(players).WithTeams(h.nflTeams())
+
+	var ids []models.PlatformID
+	var unmatched []string
+	for _, row := range rows {
+		player := matcher.Match(row.PlayerID, row.Name, row.Position, row.Team)
+		if player == nil {
+			label := row.Name
+			if label == "" {
+				label = fmt.Sprintf("player #%d", row.PlayerID)
+			}
+			unmatched = append(unmatched, label+" ("+row.PlatformID+")")
+			continue
+		}
+		ids = append(ids, models.PlatformID{PlayerID: player.ID, Platform: exporter.Key(), PlatformID: row.PlatformID})
+	}
+
+	if err := h.platformRepo.Save(ids); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	back, backLabel := "/", "Back"
+	if draftID, err := strconv.Atoi(r.FormValue("draft_id")); err == nil {
+		back, backLabel = fmt.Sprintf("/draft/%d/platforms", draftID), "Back to Platform Export"
+	}
+
+	var content strings.Builder
+	content.WriteString(fmt.Sprintf(`
+		<div class="max-w-2xl mx-auto">
+			<div class="mb-8">
+				<a href="%s" class="text-tokyo-night-fg-dim hover:text-tokyo-night-accent mb-4 inline-block">← %s</a>
+				<h1 class="text-4xl font-bold mb-2 text-tokyo-night-accent">%s IDs Imported</h1>
+				<p class="text-tokyo-night-fg-dim">%d of %d rows matched a player.</p>
+			</div>
+	`, back, backLabel, template.HTMLEscapeString(exporter.Name()), len(ids), len(rows)))
+	if len(unmatched) > 0 {
+		content.WriteString(`
+			<div class="bg-tokyo-night-bg-light rounded-lg border border-tokyo-night-border p-6">
+				<h2 class="text-xl font-semibold mb-4 text-tokyo-night-warning">Unmatched rows</h2>
+				<ul class="space-y-1 text-sm text-tokyo-night-fg-dim">
+		`)
+		for _, name := range unmatched {
+			content.WriteString(`<li>` + template.HTMLEscapeString(name) + `</li>`)
+		}
+		content.WriteString(`</ul></div>`)
+	}
+	content.WriteString(`</div>`)
+
+	renderTemplate(w, content.String(), "Player IDs Imported")
+}
+
+// ExportPlatform exports the draft in a platform's import format. Every
+// drafted player needs an ID on the platform, as the site can't place a
+// pick without one.
+func (h *Handler) ExportPlatform(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	draftID, err := strconv.Atoi(idStr)
+	if err != nil {
+		http.Error(w, "Invalid draft ID", http.StatusBadRequest)
+		return
+	}
+
+	exporter, ok := platform.Get(chi.URLParam(r, "platform"))
+	if !ok {
+		http.Error(w, "Unknown platform", http.StatusNotFound)
+		return
+	}
+
+	draft, err := h.draftRepo.GetByID(draftID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	export, err := h.collectExport(draft)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	ids, err := h.platformRepo.IDs(exporter.Key())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	out, unmapped := export.platformDraft(ids)
+	if len(unmapped) > 0 {
+		http.Error(w, fmt.Sprintf("%d drafted players have no %s ID; see /draft/%d/platforms", len(unmapped), exporter.Name(), draftID), http.StatusConflict)
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := exporter.Export(&buf, out); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", exporter.ContentType())
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=draft-%d-%s.%s", draftID, exporter.Key(), exporter.Extension()))
+	w.Write(buf.Bytes())
+}
@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/vibes/draft-board/internal/models"
+	"github.com/vibes/draft-board/internal/sport"
+	"github.com/vibes/draft-board/internal/validation"
+)
+
+// eligiblePositions validates the extra positions a player may start at
+// against their sport, dropping their primary position, and returns them in
+// stored form.
+func eligiblePositions(profile *sport.Profile, raw, primary string) (string, error) {
+	var positions []string
+	for _, pos := range models.ParsePositions(raw) {
+		if err := validation.ValidatePosition(profile, pos); err != nil {
+			return "", err
+		}
+		if pos != primary {
+			positions = append(positions, pos)
+		}
+	}
+	return strings.Join(positions, ","), nil
+}
+
+// UpdatePlayerPositions sets the positions besides their primary one a
+// player may start at
+func (h *Handler) UpdatePlayerPositions(w http.ResponseWriter, r *http.Request) {
+	playerID, err := strconv.Atoi(chi.URLParam(r, "playerId"))
+	if err != nil {
+		http.Error(w, "Invalid player ID", http.StatusBadRequest)
+		return
+	}
+	player, err := h.playerRepo.GetByID(playerID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	eligible, err := eligiblePositions(sport.Get(player.Sport), r.FormValue("eligible_positions"), player.Position)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := h.playerRepo.UpdateEligiblePositions(playerID, eligible); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	player.EligiblePositions = eligible
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(player)
+}
@@ -0,0 +1,357 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/vibes/draft-board/internal/importer"
+	"github.com/vibes/draft-board/internal/models"
+	"github.com/vibes/draft-board/internal/projections"
+	"github.com/vibes/draft-board/internal/scoring"
+	"github.com/vibes/draft-board/internal/sport"
+)
+
+// rosterSlots returns the draft's configured lineup, or its sport's default
+// lineup for its QB setting when none has been saved.
+func (h *Handler) rosterSlots(draft *models.Draft) []models.RosterSlot {
+	slots, err := h.rosterRepo.GetByDraft(draft.ID)
+	if err != nil || len(slots) == 0 {
+		return sport.Get(draft.Sport).DefaultSlots(draft.QBSetting)
+	}
+	return slots
+}
+
+// projectedStandings ranks the draft's teams by projected starting-lineup
+// points under the draft's scoring rules.
+func (h *Handler) projectedStandings(draft *models.Draft) ([]projections.TeamProjection, map[int]*models.Player, error) {
+	teams, err := h.teamRepo.GetByDraft(draft.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	picks, err := h.pickRepo.GetByDraft(draft.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	projs, err := h.projectionRepo.GetAll()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	players := make(map[int]*models.Player)
+	playerRepo := h.players(draft)
+	for _, pick := range picks {
+		if player, err := playerRepo.GetByID(pick.PlayerID); err == nil {
+			players[player.ID] = player
+		}
+	}
+
+	standings := projections.Standings(teams, picks, players, projs,
+		h.scoringRules(draft), h.rosterSlots(draft))
+	return standings, players, nil
+}
+
+// GetProjectedStandings ranks teams by projected starting-lineup points
+func (h *Handler) GetProjectedStandings(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	draftID, err := strconv.Atoi(idStr)
+	if err != nil {
+		http.Error(w, "Invalid draft ID", http.StatusBadRequest)
+		return
+	}
+
+	draft, err := h.draftRepo.GetByID(draftID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	standings, players, err := h.projectedStandings(draft)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var content strings.Builder
+	content.WriteString(`
+		<div class="mb-8">
+			<a href="/draft/` + fmt.Sprintf("%d", draftID) + `" class="text-tokyo-night-fg-dim hover:text-tokyo-night-accent mb-4 inline-block">← Back to Draft</a>
+			<h1 class="text-4xl font-bold mb-2 text-tokyo-night-accent">Projected Standings</h1>
+			<p class="text-tokyo-night-fg-dim">Season points from each team's best starting lineup (` + h.scoringLabel(draft) + ` scoring)</p>
+		</div>
+	`)
+
+	content.WriteString(draftReloadScript(draft))
+
+	content.WriteString(`
+		<div class="overflow-x-auto mb-8">
+			<table class="w-full border-collapse bg-tokyo-night-bg-light rounded-lg overflow-hidden">
+				<thead>
+					<tr class="bg-tokyo-night-bg-dark">
+						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">Rank</th>
+						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">Team</th>
+						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">Starters</th>
+						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">Bench</th>
+						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">Lineup</th>
+					</tr>
+				</thead>
+				<tbody>
+	`)
+
+	for _, team := range standings {
+		var starters []string
+		for _, a := range team.Lineup.Starters {
+			name := "—"
+			if player, ok := players[a.Entry.PlayerID]; ok {
+				name = fmt.Sprintf("%s %.1f", player.Name, a.Entry.Points)
+			}
+			starters = append(starters, fmt.Sprintf(`<span class="text-tokyo-night-fg-dim">%s:</span> %s`, a.Slot, name))
+		}
+		note := ""
+		if team.Unprojected > 0 {
+			note = fmt.Sprintf(`<div class="text-xs text-tokyo-night-warning">%d drafted without projections</div>`, team.Unprojected)
+		}
+		content.WriteString(fmt.Sprintf(`
+			<tr class="hover:bg-tokyo-night-bg-dark transition-colors">
+				<td class="px-4 py-2 border-b border-tokyo-night-border font-medium text-tokyo-night-fg">%d</td>
+				<td class="px-4 py-2 border-b border-tokyo-night-border font-medium text-tokyo-night-fg">%s%s</td>
+				<td class="px-4 py-2 border-b border-tokyo-night-border text-tokyo-night-success font-semibold">%.1f</td>
+				<td class="px-4 py-2 border-b border-tokyo-night-border text-tokyo-night-fg-dim">%.1f</td>
+				<td class="px-4 py-2 border-b border-tokyo-night-border text-sm text-tokyo-night-fg">%s</td>
+			</tr>
+		`, team.Rank, team.TeamName, note, team.StarterPoints, team.BenchPoints, strings.Join(starters, "<br>")))
+	}
+	if len(standings) == 0 {
+		content.WriteString(`<tr><td colspan="5" class="px-4 py-8 text-center text-tokyo-night-fg-dim">No teams in this draft</td></tr>`)
+	}
+	content.WriteString(`</tbody></table></div>`)
+
+	content.WriteString(`
+		<a href="/players/projections/import" class="px-4 py-2 bg-tokyo-night-bg-light hover:bg-tokyo-night-bg border border-tokyo-night-border rounded-lg transition-colors">
+			Import Projections
+		</a>
+	`)
+
+	renderTemplate(w, content.String(), "Projected Standings")
+}
+
+// GetProjectedStandingsJSON returns projected standings as JSON
+func (h *Handler) GetProjectedStandingsJSON(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	draftID, err := strconv.Atoi(idStr)
+	if err != nil {
+		http.Error(w, "Invalid draft ID", http.StatusBadRequest)
+		return
+	}
+
+	draft, err := h.draftRepo.GetByID(draftID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	standings, _, err := h.projectedStandings(draft)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(standings)
+}
+
+// ImportProjectionsForm shows the projections upload form
+func (h *Handler) ImportProjectionsForm(w http.ResponseWriter, r *http.Request) {
+	content := `
+		<div class="max-w-2xl mx-auto">
+			<div class="mb-8">
+				<a href="/" class="text-tokyo-night-fg-dim hover:text-tokyo-night-accent mb-4 inline-block">← Back</a>
+				<h1 class="text-4xl font-bold mb-2 text-tokyo-night-accent">Import Projections</h1>
+				<p class="text-tokyo-night-fg-dim">Upload season stat projections as CSV or JSON</p>
+			</div>
+			<div class="bg-tokyo-night-bg-light rounded-lg border border-tokyo-night-border p-6">
+				<form method="POST" action="/players/projections/import" enctype="multipart/form-data" class="space-y-6">
+					<input type="file" name="file" accept=".csv,.json" required
+						class="w-full px-4 py-2 bg-tokyo-night-bg border border-tokyo-night-border rounded-lg text-tokyo-night-fg">
+					<p class="text-sm text-tokyo-night-fg-dim">
+						CSV columns: <code>player_id</code> or <code>name</code>, optional <code>team</code> and <code>position</code>,
+						then any of: <code>` + strings.Join(scoring.Stats, ", ") + `</code>
+					</p>
+					<button type="submit"
+						class="w-full px-6 py-3 bg-tokyo-night-accent hover:bg-tokyo-night-accent-hover text-white rounded-lg font-semibold transition-colors">
+						Import
+					</button>
+				</form>
+			</div>
+		</div>
+	`
+	renderTemplate(w, content, "Import Projections")
+}
+
+// ImportProjections loads projections from an uploaded CSV or JSON file
+func (h *Handler) ImportProjections(w http.ResponseWriter, r *http.Request) {
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	defer file.Close()
+
+	var rows []importer.ProjectionRow
+	if strings.EqualFold(filepath.Ext(header.Filename), ".json") {
+		rows, err = importer.ParseProjectionsJSON(file)
+	} else {
+		rows, err = importer.ParseProjectionsCSV(file)
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	players, err := h.playerRepo.List()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	matcher := importer.NewMatcher(players).WithTeams(h.nflTeams())
+
+	var matched []models.Projection
+	var unmatched []string
+	for _, row := range rows {
+		player := matcher.Match(row.PlayerID, row.Name, row.Position, row.Team)
+		if player == nil {
+			label := row.Name
+			if label == "" {
+				label = fmt.Sprintf("player #%d", row.PlayerID)
+			}
+			unmatched = append(unmatched, label)
+			continue
+		}
+		matched = append(matched, models.Projection{PlayerID: player.ID, Stats: row.Stats})
+	}
+
+	if err := h.projectionRepo.Replace(matched); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var content strings.Builder
+	content.WriteString(fmt.Sprintf(`
+		<div class="max-w-2xl mx-auto">
+			<div class="mb-8">
+				<a href="/" class="text-tokyo-night-fg-dim hover:text-tokyo-night-accent mb-4 inline-block">← Back</a>
+				<h1 class="text-4xl font-bold mb-2 text-tokyo-night-accent">Projections Imported</h1>
+				<p class="text-tokyo-night-fg-dim">%d of %d rows matched a player.</p>
+			</div>
+	`, len(matched), len(rows)))
+	if len(unmatched) > 0 {
+		content.WriteString(`
+			<div class="bg-tokyo-night-bg-light rounded-lg border border-tokyo-night-border p-6">
+				<h2 class="text-xl font-semibold mb-4 text-tokyo-night-warning">Unmatched rows</h2>
+				<ul class="space-y-1 text-sm text-tokyo-night-fg-dim">
+		`)
+		for _, name := range unmatched {
+			content.WriteString(`<li>` + template.HTMLEscapeString(name) + `</li>`)
+		}
+		content.WriteString(`</ul></div>`)
+	}
+	content.WriteString(`</div>`)
+
+	renderTemplate(w, content.String(), "Projections Imported")
+}
+
+// UpdateRosterSlots saves the starting lineup used for projected standings
+func (h *Handler) UpdateRosterSlots(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	draftID, err := strconv.Atoi(idStr)
+	if err != nil {
+		http.Error(w, "Invalid draft ID", http.StatusBadRequest)
+		return
+	}
+
+	draft, err := h.draftRepo.GetByID(draftID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var slots []models.RosterSlot
+	for _, slot := range h.rosterSlots(draft) {
+		count, err := strconv.Atoi(r.FormValue("slot_" + slot.Slot))
+		if err != nil || count < 0 {
+			http.Error(w, fmt.Sprintf("invalid count for %s", slot.Slot), http.StatusBadRequest)
+			return
+		}
+		slots = append(slots, models.RosterSlot{Slot: slot.Slot, Count: count})
+	}
+
+	if err := h.rosterRepo.ReplaceForDraft(draftID, slots); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	http.Redirect(w, r, fmt.Sprintf("/draft/%d/setup", draftID), http.StatusSeeOther)
+}
+
+// rosterSlotsForm renders the lineup editor shown on the setup page.
+func (h *Handler) rosterSlotsForm(draft *models.Draft) string {
+	var form strings.Builder
+	form.WriteString(fmt.Sprintf(`
+		<div class="mt-8 bg-tokyo-night-bg-light rounded-lg border border-tokyo-night-border p-6">
+			<h2 class="text-2xl font-semibold mb-4 text-tokyo-night-fg">Roster Slots</h2>
+			<form method="POST" action="/draft/%d/roster-slots" class="flex flex-wrap items-end gap-4">
+	`, draft.ID))
+	for _, slot := range h.rosterSlots(draft) {
+		form.WriteString(fmt.Sprintf(`
+				<div>
+					<label class="block text-sm font-medium mb-2 text-tokyo-night-fg">%s</label>
+					<input type="number" name="slot_%s" min="0" max="20" value="%d"
+						class="w-20 px-3 py-2 bg-tokyo-night-bg border border-tokyo-night-border rounded-lg text-tokyo-night-fg focus:outline-none focus:border-tokyo-night-accent">
+				</div>
+		`, slot.Slot, slot.Slot, slot.Count))
+	}
+	form.WriteString(`
+				<button type="submit"
+					class="px-4 py-2 bg-tokyo-night-accent hover:bg-tokyo-night-accent-hover text-white rounded-lg font-semibold transition-colors">
+					Save Slots
+				</button>
+			</form>
+		</div>
+	`)
+	return form.String()
+}
+
+// draftReloadScript reloads a page drawn from a live draft's picks when a
+// pick is made or undone, or the draft completes. Drafts that aren't live
+// need no script.
+func draftReloadScript(draft *models.Draft) string {
+	if !draft.IsActive() && !draft.IsPaused() {
+		return ""
+	}
+	return fmt.Sprintf(`
+		<script>
+			(function() {
+				const eventSource = new EventSource('/draft/%d/stream');
+				['pick-made', 'pick-undone', 'draft-completed'].forEach(function(type) {
+					eventSource.addEventListener(type, function() {
+						eventSource.close();
+						location.reload();
+					});
+				});
+				window.addEventListener('beforeunload', function() {
+					eventSource.close();
+				});
+			})();
+		</script>
+	`, draft.ID)
+}
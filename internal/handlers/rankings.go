@@ -0,0 +1,477 @@
+package handlers
+
+import (
+	"fmt"
+	"html/template"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/vibes/draft-board/internal/consensus"
+	"github.com/vibes/draft-board/internal/importer"
+	"github.com/vibes/draft-board/internal/models"
+	"github.com/vibes/draft-board/internal/recommend"
+	"github.com/vibes/draft-board/internal/repository"
+	"github.com/vibes/draft-board/internal/sport"
+)
+
+// rankingEditorSize is how many players the drag-and-drop editor starts
+// with when a draft has no rankings of its own.
+const rankingEditorSize = 300
+
+// draftRanks returns the ranks a draft uses for ADP and the source key they
+// go by: the draft's own rankings, then its chosen ranking source or the
+// consensus of the season's sources. A nil map means the draft uses the
+// player rank columns, keyed by rank format and, for past seasons, year.
+func (h *Handler) draftRanks(draft *models.Draft) (string, map[int]int) {
+	if rankings, err := h.rankingRepo.GetByDraft(draft.ID); err == nil && len(rankings) > 0 {
+		ranks := make(map[int]int, len(rankings))
+		for _, ranking := range rankings {
+			ranks[ranking.PlayerID] = ranking.Rank
+		}
+		return draftTierSource(draft.ID), ranks
+	}
+
+	format := models.RankFormat(draft.DraftType, draft.ScoringFormat)
+	builtin := h.builtinTierSource(draft)
+	selection, err := h.rankSourceRepo.GetDraftSource(draft.ID)
+	if err != nil || selection == nil {
+		return builtin, nil
+	}
+
+	ranks := make(map[int]int)
+	var source string
+	if selection.Consensus {
+		all, err := h.rankSourceRepo.GetRanksByFormat(format, draft.Season)
+		if err != nil {
+			return builtin, nil
+		}
+		for _, stat := range consensus.Build(all) {
+			ranks[stat.PlayerID] = stat.Rank
+		}
+		source = h.seasonKey(draft, consensusTierSource(format))
+	} else if selection.SourceID != nil {
+		list, err := h.rankSourceRepo.GetRanks(*selection.SourceID, format)
+		if err != nil {
+			return builtin, nil
+		}
+		for _, rank := range list {
+			ranks[rank.PlayerID] = rank.Rank
+		}
+		source = sourceTierSource(*selection.SourceID, format)
+	}
+	if len(ranks) == 0 {
+		return builtin, nil
+	}
+	return source, ranks
+}
+
+// adpRanker returns the ADP rank lookup for a draft. Players its rankings
+// or ranking source leave out have no rank in that draft.
+func (h *Handler) adpRanker(draft *models.Draft) func(*models.Player) *int {
+	_, ranks := h.draftRanks(draft)
+	if ranks == nil {
+		return func(p *models.Player) *int {
+			return p.GetADPRank(draft.DraftType, draft.ScoringFormat)
+		}
+	}
+	return func(p *models.Player) *int {
+		if rank, ok := ranks[p.ID]; ok {
+			return &rank
+		}
+		return nil
+	}
+}
+
+// suggestedPicks recommends players for the team on the clock.
+func (h *Handler) suggestedPicks(draft *models.Draft, team *models.Team, picks []models.Pick) []recommend.Suggestion {
+	available, err := h.players(draft).GetAvailable(draft.ID, repository.PlayerFilters{
+		DraftType:     draft.DraftType,
+		ScoringFormat: draft.ScoringFormat,
+		Sport:         draft.Sport,
+		RookieSeason:  draft.RookieSeason(),
+		Limit:         50,
+	})
+	if err != nil {
+		return nil
+	}
+
+	var roster []*models.Player
+	playerRepo := h.players(draft)
+	for _, pick := range picks {
+		if pick.TeamID != team.ID {
+			continue
+		}
+		if player, err := playerRepo.GetByID(pick.PlayerID); err == nil {
+			roster = append(roster, player)
+		}
+	}
+	if draft.Rookie {
+		carried, _ := h.pickRepo.CarriedPlayers(draft.ID)
+		for _, c := range carried {
+			if c.TeamID != team.ID {
+				continue
+			}
+			if player, err := playerRepo.GetByID(c.PlayerID); err == nil {
+				roster = append(roster, player)
+			}
+		}
+	}
+
+	return recommend.Suggest(available, h.adpRanker(draft), roster, h.rosterSlots(draft), 5)
+}
+
+// suggestedPicksPanel renders the recommendations shown on the draft board.
+func suggestedPicksPanel(draftID int, team *models.Team, suggestions []recommend.Suggestion) string {
+	if len(suggestions) == 0 {
+		return ""
+	}
+	var panel strings.Builder
+	panel.WriteString(fmt.Sprintf(`
+		<div class="mb-6 bg-tokyo-night-bg-light rounded-lg border border-tokyo-night-border p-4">
+			<h2 class="text-lg font-semibold mb-3 text-tokyo-night-fg">Suggested for %s</h2>
+			<div class="flex flex-wrap gap-3">
+	`, template.HTMLEscapeString(team.TeamName)))
+	for _, s := range suggestions {
+		rank := "-"
+		if s.Rank != nil {
+			rank = strconv.Itoa(*s.Rank)
+		}
+		need := ""
+		if s.Need {
+			need = `<span class="text-xs text-tokyo-night-success">need</span>`
+		}
+		panel.WriteString(fmt.Sprintf(`
+				<form method="POST" action="/draft/%d/pick">
+					<input type="hidden" name="player_id" value="%d">
+					<button type="submit" class="flex items-center gap-2 px-3 py-2 bg-tokyo-night-bg hover:bg-tokyo-night-bg-dark border border-tokyo-night-border rounded-lg transition-colors">
+						%s <span class="font-medium text-tokyo-night-fg">%s</span>
+						<span class="text-xs text-tokyo-night-fg-dim">#%s</span> %s
+					</button>
+				</form>
+		`, draftID, s.Player.ID, getPlayerPositionBadges(s.Player)+getStatusBadge(s.Player), template.HTMLEscapeString(s.Player.Name), rank, need))
+	}
+	panel.WriteString(`</div></div>`)
+	return panel.String()
+}
+
+// GetDraftRankings shows the draft's cheat sheet with drag-and-drop ordering
+func (h *Handler) GetDraftRankings(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	draftID, err := strconv.Atoi(idStr)
+	if err != nil {
+		http.Error(w, "Invalid draft ID", http.StatusBadRequest)
+		return
+	}
+
+	draft, err := h.draftRepo.GetByID(draftID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	rankings, err := h.rankingRepo.GetByDraft(draftID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var players []*models.Player
+	if len(rankings) > 0 {
+		playerRepo := h.players(draft)
+		for _, ranking := range rankings {
+			if player, err := playerRepo.GetByID(ranking.PlayerID); err == nil {
+				players = append(players, player)
+			}
+		}
+	} else {
+		players, err = h.players(draft).GetAvailable(draftID, repository.PlayerFilters{
+			DraftType:      draft.DraftType,
+			ScoringFormat:  draft.ScoringFormat,
+			Sport:          draft.Sport,
+			RookieSeason:   draft.RookieSeason(),
+			IncludeDrafted: true,
+			Limit:          rankingEditorSize,
+		})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	source := fmt.Sprintf("No custom rankings yet. Showing global %s ranks; save to make them this draft's own.", sport.Get(draft.Sport).FormatLabel(draft.ScoringFormat))
+	if draft.DraftType == "Dynasty" {
+		source = "No custom rankings yet. Showing global dynasty ranks; save to make them this draft's own."
+	}
+	if len(rankings) > 0 {
+		source = fmt.Sprintf("%d players ranked. Players left off the list have no rank in this draft.", len(rankings))
+	}
+
+	var content strings.Builder
+	content.WriteString(fmt.Sprintf(`
+		<div class="mb-8">
+			<a href="/draft/%d/setup" class="text-tokyo-night-fg-dim hover:text-tokyo-night-accent mb-4 inline-block">← Back to Setup</a>
+			<h1 class="text-4xl font-bold mb-2 text-tokyo-night-accent">Custom Rankings</h1>
+			<p class="text-tokyo-night-fg-dim">%s</p>
+		</div>
+		<div class="grid md:grid-cols-3 gap-8">
+			<div class="md:col-span-2">
+				<form method="POST" action="/draft/%d/rankings" id="rankings-form">
+					<input type="hidden" name="order" id="rankings-order">
+					<ol id="rankings-list" class="space-y-1 mb-6">
+	`, draftID, source, draftID))
+	for i, player := range players {
+		content.WriteString(fmt.Sprintf(`
+						<li draggable="true" data-id="%d" class="flex items-center gap-3 px-3 py-2 bg-tokyo-night-bg-light border border-tokyo-night-border rounded cursor-move">
+							<span class="rank-number w-10 text-tokyo-night-fg-dim">%d</span>
+							%s <span class="font-medium text-tokyo-night-fg">%s</span>
+							<span class="text-sm text-tokyo-night-fg-dim">%s</span>
+							<button type="button" class="remove-ranking ml-auto text-tokyo-night-fg-dim hover:text-tokyo-night-error">✕</button>
+						</li>
+		`, player.ID, i+1, getPlayerPositionBadges(player), template.HTMLEscapeString(player.Name), player.Team))
+	}
+	content.WriteString(`
+					</ol>
+					<button type="submit" class="px-6 py-2 bg-tokyo-night-accent hover:bg-tokyo-night-accent-hover text-white rounded-lg font-semibold transition-colors">
+						Save Order
+					</button>
+				</form>
+			</div>
+	`)
+
+	content.WriteString(fmt.Sprintf(`
+			<div class="space-y-6">
+				<div class="bg-tokyo-night-bg-light rounded-lg border border-tokyo-night-border p-6">
+					<h2 class="text-xl font-semibold mb-4 text-tokyo-night-fg">Import CSV</h2>
+					<form method="POST" action="/draft/%d/rankings/import" enctype="multipart/form-data" class="space-y-4">
+						<input type="file" name="file" accept=".csv" required
+							class="w-full px-4 py-2 bg-tokyo-night-bg border border-tokyo-night-border rounded-lg text-tokyo-night-fg">
+						<p class="text-sm text-tokyo-night-fg-dim">
+							Columns: <code>player_id</code> or <code>name</code>, optional <code>rank</code>, <code>tier</code>, <code>team</code>, <code>position</code>. Without a rank column, row order is the rank.
+						</p>
+						<button type="submit" class="w-full px-4 py-2 bg-tokyo-night-accent hover:bg-tokyo-night-accent-hover text-white rounded-lg font-semibold transition-colors">
+							Import
+						</button>
+					</form>
+				</div>
+				<form method="POST" action="/draft/%d/rankings/reset">
+					<button type="submit" class="w-full px-4 py-2 bg-tokyo-night-bg-light hover:bg-tokyo-night-bg border border-tokyo-night-border rounded-lg transition-colors">
+						Use Global Rankings
+					</button>
+				</form>
+			</div>
+		</div>
+	`, draftID, draftID))
+
+	content.WriteString(`
+		<script>
+			(function() {
+				const list = document.getElementById('rankings-list');
+				let dragged = null;
+
+				function renumber() {
+					list.querySelectorAll('.rank-number').forEach(function(el, i) {
+						el.textContent = i + 1;
+					});
+				}
+
+				list.addEventListener('dragstart', function(e) {
+					dragged = e.target.closest('li');
+					e.dataTransfer.effectAllowed = 'move';
+				});
+				list.addEventListener('dragover', function(e) {
+					e.preventDefault();
+					const target = e.target.closest('li');
+					if (!target || target === dragged) return;
+					const rect = target.getBoundingClientRect();
+					const after = e.clientY > rect.top + rect.height / 2;
+					list.insertBefore(dragged, after ? target.nextSibling : target);
+				});
+				list.addEventListener('drop', function(e) {
+					e.preventDefault();
+					renumber();
+				});
+				list.addEventListener('click', function(e) {
+					if (e.target.classList.contains('remove-ranking')) {
+						e.target.closest('li').remove();
+						renumber();
+					}
+				});
+
+				document.getElementById('rankings-form').addEventListener('submit', function() {
+					const ids = Array.from(list.querySelectorAll('li')).map(function(li) {
+						return li.dataset.id;
+					});
+					document.getElementById('rankings-order').value = ids.join(',');
+				});
+			})();
+		</script>
+	`)
+
+	renderTemplate(w, content.String(), "Custom Rankings")
+}
+
+// UpdateDraftRankings saves the drag-and-drop order as the draft's rankings
+func (h *Handler) UpdateDraftRankings(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	draftID, err := strconv.Atoi(idStr)
+	if err != nil {
+		http.Error(w, "Invalid draft ID", http.StatusBadRequest)
+		return
+	}
+
+	if _, err := h.draftRepo.GetByID(draftID); err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var rankings []models.DraftRanking
+	seen := make(map[int]bool)
+	for _, field := range strings.Split(r.FormValue("order"), ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		playerID, err := strconv.Atoi(field)
+		if err != nil {
+			http.Error(w, "Invalid player ID", http.StatusBadRequest)
+			return
+		}
+		if seen[playerID] {
+			continue
+		}
+		seen[playerID] = true
+		rankings = append(rankings, models.DraftRanking{PlayerID: playerID, Rank: len(rankings) + 1})
+	}
+
+	if err := h.rankingRepo.ReplaceForDraft(draftID, rankings); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	http.Redirect(w, r, fmt.Sprintf("/draft/%d/rankings", draftID), http.StatusSeeOther)
+}
+
+// ImportDraftRankings loads a draft's rankings from an uploaded CSV
+func (h *Handler) ImportDraftRankings(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	draftID, err := strconv.Atoi(idStr)
+	if err != nil {
+		http.Error(w, "Invalid draft ID", http.StatusBadRequest)
+		return
+	}
+
+	if _, err := h.draftRepo.GetByID(draftID); err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	file, _, err := r.FormFile("file")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	defer file.Close()
+
+	rows, err := importer.ParseRankingsCSV(file)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	players, err := h.playerRepo.List()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	matcher := importer.NewMatcher(players).WithTeams(h.nflTeams())
+
+	var rankings []models.DraftRanking
+	var unmatched []string
+	seen := make(map[int]bool)
+	for _, row := range rows {
+		player := matcher.Match(row.PlayerID, row.Name, row.Position, row.Team)
+		if player == nil {
+			label := row.Name
+			if label == "" {
+				label = fmt.Sprintf("player #%d", row.PlayerID)
+			}
+			unmatched = append(unmatched, label)
+			continue
+		}
+		if seen[player.ID] {
+			continue
+		}
+		seen[player.ID] = true
+		rankings = append(rankings, models.DraftRanking{PlayerID: player.ID, Rank: row.Rank})
+	}
+
+	if err := h.rankingRepo.ReplaceForDraft(draftID, rankings); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// A sheet with a tier column brings its own tiers
+	sheetTiers, err := h.matchTiers(draftTierSource(draftID), rows)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if len(sheetTiers) > 0 {
+		if err := h.tierRepo.ReplaceForSource(draftTierSource(draftID), sheetTiers); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	var content strings.Builder
+	content.WriteString(fmt.Sprintf(`
+		<div class="max-w-2xl mx-auto">
+			<div class="mb-8">
+				<a href="/draft/%d/rankings" class="text-tokyo-night-fg-dim hover:text-tokyo-night-accent mb-4 inline-block">← Back to Rankings</a>
+				<h1 class="text-4xl font-bold mb-2 text-tokyo-night-accent">Rankings Imported</h1>
+				<p class="text-tokyo-night-fg-dim">%d of %d rows matched a player.</p>
+			</div>
+	`, draftID, len(rankings), len(rows)))
+	if len(unmatched) > 0 {
+		content.WriteString(`
+			<div class="bg-tokyo-night-bg-light rounded-lg border border-tokyo-night-border p-6">
+				<h2 class="text-xl font-semibold mb-4 text-tokyo-night-warning">Unmatched rows</h2>
+				<ul class="space-y-1 text-sm text-tokyo-night-fg-dim">
+		`)
+		for _, name := range unmatched {
+			content.WriteString(`<li>` + template.HTMLEscapeString(name) + `</li>`)
+		}
+		content.WriteString(`</ul></div>`)
+	}
+	content.WriteString(`</div>`)
+
+	renderTemplate(w, content.String(), "Rankings Imported")
+}
+
+// ResetDraftRankings drops the draft's rankings so it uses global ranks
+func (h *Handler) ResetDraftRankings(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	draftID, err := strconv.Atoi(idStr)
+	if err != nil {
+		http.Error(w, "Invalid draft ID", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.rankingRepo.DeleteForDraft(draftID); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if err := h.tierRepo.DeleteForSource(draftTierSource(draftID)); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	http.Redirect(w, r, fmt.Sprintf("/draft/%d/rankings", draftID), http.StatusSeeOther)
+}
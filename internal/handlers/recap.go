@@ -0,0 +1,481 @@
+package handlers
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/vibes/draft-board/internal/byes"
+	"github.com/vibes/draft-board/internal/models"
+	"github.com/vibes/draft-board/internal/pdf"
+	"github.com/vibes/draft-board/internal/sport"
+)
+
+// The recap is printed, so it is dark text on white rather than the
+// site's tokyo-night colors.
+const (
+	recapMargin = 40.0
+	recapInk    = "1F2937"
+	recapDim    = "6B7280"
+	recapRule   = "D1D5DB"
+	recapBand   = "1F2335"
+	recapSteal  = "15803D"
+	recapReach  = "B91C1C"
+)
+
+// recapWriter lays out the recap top to bottom, starting a new page when
+// the current one is full.
+type recapWriter struct {
+	doc   *pdf.Document
+	page  *pdf.Page
+	y     float64
+	title string
+}
+
+// newPage starts a page headed by a title band.
+func (rw *recapWriter) newPage(title, subtitle string) {
+	rw.page = rw.doc.AddPage()
+	rw.title = title
+	rw.page.Rect(0, 0, pdf.PageWidth, 64, recapBand)
+	rw.page.Text(recapMargin, 34, pdf.Bold, 20, "FFFFFF", pdf.Fit(title, pdf.Bold, 20, pdf.PageWidth-2*recapMargin))
+	rw.page.Text(recapMargin, 52, pdf.Regular, 10, "C0CAF5", subtitle)
+	rw.y = 90
+}
+
+// need makes room for h points, continuing on a new page if the current
+// one cannot fit them.
+func (rw *recapWriter) need(h float64) {
+	if rw.y+h > pdf.PageHeight-recapMargin {
+		rw.newPage(rw.title, "continued")
+	}
+}
+
+// heading writes a section heading.
+func (rw *recapWriter) heading(s string) {
+	rw.need(40)
+	rw.y += 8
+	rw.page.Text(recapMargin, rw.y, pdf.Bold, 13, recapInk, s)
+	rw.y += 6
+	rw.page.Line(recapMargin, rw.y, pdf.PageWidth-recapMargin, rw.y, 0.75, recapRule)
+	rw.y += 14
+}
+
+// recapColumn is a table column: its title, where it starts, how wide it
+// is, and whether it is right-aligned.
+type recapColumn struct {
+	title string
+	x     float64
+	width float64
+	right bool
+}
+
+// recapCell is a table cell, drawn in the table's ink unless it has its
+// own color.
+type recapCell struct {
+	text  string
+	color string
+	bold  bool
+}
+
+func (rw *recapWriter) tableHeader(columns []recapColumn) {
+	rw.need(30)
+	for _, col := range columns {
+		rw.cell(col, recapCell{text: col.title, color: recapDim, bold: true}, 8)
+	}
+	rw.y += 14
+}
+
+func (rw *recapWriter) tableRow(columns []recapColumn, cells []recapCell) {
+	rw.need(16)
+	for i, col := range columns {
+		if i < len(cells) {
+			rw.cell(col, cells[i], 9.5)
+		}
+	}
+	rw.page.Line(recapMargin, rw.y+5, pdf.PageWidth-recapMargin, rw.y+5, 0.25, recapRule)
+	rw.y += 16
+}
+
+func (rw *recapWriter) cell(col recapColumn, c recapCell, size float64) {
+	font := pdf.Regular
+	if c.bold {
+		font = pdf.Bold
+	}
+	color := c.color
+	if color == "" {
+		color = recapInk
+	}
+	text := pdf.Fit(c.text, font, size, col.width)
+	if col.right {
+		rw.page.TextRight(col.x+col.width, rw.y, font, size, color, text)
+	} else {
+		rw.page.Text(col.x, rw.y, font, size, color, text)
+	}
+}
+
+// recapColumns lays out table columns of the given widths across the page,
+// left to right; titles ending in "#" are right-aligned numbers.
+func recapColumns(titles []string, widths []float64) []recapColumn {
+	columns := make([]recapColumn, len(titles))
+	x := recapMargin
+	for i, title := range titles {
+		// Numbers keep a wider gap to the column after them, which
+		// starts flush left.
+		right := strings.HasSuffix(title, "#")
+		gap := 6.0
+		if right {
+			gap = 12
+		}
+		columns[i] = recapColumn{title: strings.TrimSuffix(title, "#"), x: x, width: widths[i] - gap, right: right}
+		x += widths[i]
+	}
+	return columns
+}
+
+// valueCell shows a pick's value against ADP, positive when the player
+// went later than their ADP, as on the value picks page.
+func valueCell(pick models.Pick) recapCell {
+	if pick.ADPRank == nil {
+		return recapCell{text: "-", color: recapDim}
+	}
+	diff := *pick.ADPRank - pick.OverallPick
+	switch {
+	case diff > 0:
+		return recapCell{text: fmt.Sprintf("+%d", diff), color: recapSteal}
+	case diff < 0:
+		return recapCell{text: strconv.Itoa(diff), color: recapReach}
+	default:
+		return recapCell{text: "0"}
+	}
+}
+
+// teamValue sums a team's value against ADP over the picks that have an
+// ADP rank, and counts its steals and reaches: picks 5 or more spots from
+// ADP, as on the value picks page.
+type teamValue struct {
+	valued, total, steals, reaches int
+	best, worst                    *models.Pick
+}
+
+func valueOf(picks []models.Pick) teamValue {
+	var v teamValue
+	for i := range picks {
+		pick := &picks[i]
+		if pick.ADPRank == nil {
+			continue
+		}
+		diff := *pick.ADPRank - pick.OverallPick
+		v.valued++
+		v.total += diff
+		if diff >= 5 {
+			v.steals++
+		}
+		if diff <= -5 {
+			v.reaches++
+		}
+		if v.best == nil || diff > *v.best.ADPRank-v.best.OverallPick {
+			v.best = pick
+		}
+		if v.worst == nil || diff < *v.worst.ADPRank-v.worst.OverallPick {
+			v.worst = pick
+		}
+	}
+	return v
+}
+
+func signed(n int) string {
+	if n > 0 {
+		return fmt.Sprintf("+%d", n)
+	}
+	return strconv.Itoa(n)
+}
+
+// ExportPDF exports a print-ready recap of the draft: a league summary
+// page, then a roster card for each team with its picks grouped by
+// position, their bye weeks, and their value against ADP.
+func (h *Handler) ExportPDF(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	draftID, err := strconv.Atoi(idStr)
+	if err != nil {
+		http.Error(w, "Invalid draft ID", http.StatusBadRequest)
+		return
+	}
+
+	draft, err := h.draftRepo.GetByID(draftID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	export, err := h.collectExport(draft)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	byeTeams, _, _, err := h.byeAnalysis(draft)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	byTeam := make(map[int]byes.Team)
+	for _, t := range byeTeams {
+		byTeam[t.TeamID] = t
+	}
+
+	profile := export.profile
+	proTeam := "Pro Team"
+	if profile.Key == sport.Football {
+		proTeam = "NFL Team"
+	}
+	playerName := func(pick *models.Pick) string {
+		if player := export.players[pick.PlayerID]; player != nil {
+			return player.Name
+		}
+		return fmt.Sprintf("Pick %d", pick.OverallPick)
+	}
+
+	details := []string{}
+	if draft.Season > 0 {
+		details = append(details, fmt.Sprintf("%d season", draft.Season))
+	}
+	details = append(details, profile.FormatLabel(draft.ScoringFormat), draft.DraftType,
+		fmt.Sprintf("%d teams", len(export.teams)), fmt.Sprintf("%d rounds", export.rounds))
+	subtitle := strings.Join(details, "  |  ")
+
+	doc := pdf.New(draft.Name + " Draft Recap")
+	rw := &recapWriter{doc: doc}
+
+	// League summary: every team's haul against ADP, the biggest steals
+	// and reaches, and the pro teams drafted from most.
+	rw.newPage(draft.Name+" Draft Recap", subtitle)
+	rw.heading("Teams")
+	columns := recapColumns([]string{"Slot#", "Team", "Owner", "Picks#", "Value#", "Best Value", "Biggest Reach"}, []float64{34, 110, 80, 40, 46, 111, 111})
+	rw.tableHeader(columns)
+	for _, team := range export.teams {
+		roster := export.roster(team.ID)
+		value := valueOf(roster)
+		best, worst := recapCell{text: "-", color: recapDim}, recapCell{text: "-", color: recapDim}
+		if value.best != nil && *value.best.ADPRank > value.best.OverallPick {
+			best = recapCell{text: fmt.Sprintf("%s (%s)", playerName(value.best), signed(*value.best.ADPRank-value.best.OverallPick)), color: recapSteal}
+		}
+		if value.worst != nil && *value.worst.ADPRank < value.worst.OverallPick {
+			worst = recapCell{text: fmt.Sprintf("%s (%s)", playerName(value.worst), signed(*value.worst.ADPRank-value.worst.OverallPick)), color: recapReach}
+		}
+		total := recapCell{text: signed(value.total)}
+		if value.valued == 0 {
+			total = recapCell{text: "-", color: recapDim}
+		} else if value.total > 0 {
+			total.color = recapSteal
+		} else if value.total < 0 {
+			total.color = recapReach
+		}
+		rw.tableRow(columns, []recapCell{
+			{text: strconv.Itoa(team.DraftPosition)},
+			{text: team.TeamName, bold: true},
+			{text: team.OwnerName},
+			{text: strconv.Itoa(len(roster))},
+			total,
+			best,
+			worst,
+		})
+	}
+
+	var valued []models.Pick
+	for _, pick := range export.picks {
+		if pick.ADPRank != nil {
+			valued = append(valued, pick)
+		}
+	}
+	sort.SliceStable(valued, func(i, j int) bool {
+		return *valued[i].ADPRank-valued[i].OverallPick > *valued[j].ADPRank-valued[j].OverallPick
+	})
+	valueTable := func(title string, picks []models.Pick) {
+		rw.heading(title)
+		if len(picks) == 0 {
+			rw.page.Text(recapMargin, rw.y, pdf.Regular, 9.5, recapDim, "None (5+ spots from ADP)")
+			rw.y += 16
+			return
+		}
+		columns := recapColumns([]string{"Pick#", "Player", "Position", "Team", "ADP#", "Value#"}, []float64{40, 160, 70, 130, 66, 66})
+		rw.tableHeader(columns)
+		for _, pick := range picks {
+			position := ""
+			if player := export.players[pick.PlayerID]; player != nil {
+				position = player.PositionLabel()
+			}
+			teamName := ""
+			if team := export.team(pick.TeamID); team != nil {
+				teamName = team.TeamName
+			}
+			rw.tableRow(columns, []recapCell{
+				{text: strconv.Itoa(pick.OverallPick)},
+				{text: playerName(&pick)},
+				{text: position},
+				{text: teamName},
+				{text: strconv.Itoa(*pick.ADPRank)},
+				valueCell(pick),
+			})
+		}
+	}
+	var steals, reaches []models.Pick
+	for i, pick := range valued {
+		if *pick.ADPRank-pick.OverallPick >= 5 && len(steals) < 5 {
+			steals = append(steals, pick)
+		}
+		if reach := valued[len(valued)-1-i]; *reach.ADPRank-reach.OverallPick <= -5 && len(reaches) < 5 {
+			reaches = append(reaches, reach)
+		}
+	}
+	valueTable("Biggest Steals", steals)
+	valueTable("Biggest Reaches", reaches)
+
+	stats, positions := h.franchiseStats(draft, export.picks)
+	if len(stats) > 10 {
+		stats = stats[:10]
+	}
+	if len(stats) > 0 {
+		rw.heading("Most Drafted " + strings.TrimSuffix(proTeam, " Team") + " Teams")
+		titles := []string{"Team", "Name", "Total#"}
+		widths := []float64{50, 140, 46}
+		for _, pos := range positions {
+			titles = append(titles, pos+"#")
+			widths = append(widths, min(40, 296/float64(len(positions)+1)))
+		}
+		columns := recapColumns(append(titles, "Other#"), append(widths, min(40, 296/float64(len(positions)+1))))
+		rw.tableHeader(columns)
+		for _, stat := range stats {
+			name := ""
+			if stat.Team != nil {
+				name = stat.Team.Name
+			}
+			cells := []recapCell{{text: stat.TeamAbbr, bold: true}, {text: name}, {text: strconv.Itoa(stat.TotalCount)}}
+			for _, pos := range positions {
+				cells = append(cells, recapCell{text: strconv.Itoa(stat.Counts[pos])})
+			}
+			rw.tableRow(columns, append(cells, recapCell{text: strconv.Itoa(stat.OtherCount)}))
+		}
+	}
+
+	// Roster cards: a page per team.
+	columns = recapColumns([]string{"Pick", "Overall#", "Player", proTeam, "Bye#", "ADP#", "Value#"}, []float64{44, 52, 190, 80, 50, 58, 58})
+	for _, team := range export.teams {
+		owner := "Draft slot " + strconv.Itoa(team.DraftPosition)
+		if team.OwnerName != "" {
+			owner = team.OwnerName + "  |  " + owner
+		}
+		rw.newPage(team.TeamName, owner+"  |  "+draft.Name)
+
+		roster := export.roster(team.ID)
+		if len(roster) == 0 {
+			rw.page.Text(recapMargin, rw.y, pdf.Regular, 10, recapDim, "No picks yet.")
+			continue
+		}
+
+		rw.tableHeader(columns)
+		for start := 0; start < len(roster); {
+			position := ""
+			if player := export.players[roster[start].PlayerID]; player != nil {
+				position = player.Position
+			}
+			end := start + 1
+			for end < len(roster) {
+				player := export.players[roster[end].PlayerID]
+				if (player == nil && position != "") || (player != nil && player.Position != position) {
+					break
+				}
+				end++
+			}
+
+			// Each position is headed by a band in its badge color.
+			rw.need(30 + 16)
+			label := position
+			if label == "" {
+				label = "Unknown"
+			}
+			rw.page.Rect(recapMargin, rw.y-6, pdf.PageWidth-2*recapMargin, 18, tint(sport.PositionHex(position), 0.2))
+			rw.page.Rect(recapMargin, rw.y-6, 4, 18, sport.PositionHex(position))
+			rw.page.Text(recapMargin+10, rw.y+7, pdf.Bold, 10, recapInk, fmt.Sprintf("%s (%d)", label, end-start))
+			rw.y += 30
+
+			for _, pick := range roster[start:end] {
+				name, pro, bye := playerName(&pick), "", "-"
+				if player := export.players[pick.PlayerID]; player != nil {
+					name += positionSuffix(player.PositionLabel(), player.Position)
+					pro = player.Team
+					if player.ByeWeek != nil {
+						bye = strconv.Itoa(*player.ByeWeek)
+					}
+				}
+				adp := "-"
+				if pick.ADPRank != nil {
+					adp = strconv.Itoa(*pick.ADPRank)
+				}
+				rw.tableRow(columns, []recapCell{
+					{text: fmt.Sprintf("%d.%02d", pick.Round, (pick.OverallPick-1)%max(len(export.teams), 1)+1)},
+					{text: strconv.Itoa(pick.OverallPick)},
+					{text: name, bold: true},
+					{text: pro},
+					{text: bye},
+					{text: adp},
+					valueCell(pick),
+				})
+			}
+			rw.y += 6
+			start = end
+		}
+
+		value := valueOf(roster)
+		rw.heading("Value vs. ADP")
+		summary := fmt.Sprintf("Total %s  |  %d steals  |  %d reaches (5+ spots from ADP)", signed(value.total), value.steals, value.reaches)
+		if value.valued == 0 {
+			summary = "None of this team's picks have an ADP rank."
+		}
+		rw.page.Text(recapMargin, rw.y, pdf.Regular, 10, recapInk, summary)
+		rw.y += 16
+
+		// Bye weeks: who is out each week, flagging weeks the roster can't
+		// field a legal lineup.
+		var weeks []byes.Week
+		for _, week := range byTeam[team.ID].Weeks {
+			if len(week.OnBye) > 0 {
+				weeks = append(weeks, week)
+			}
+		}
+		if len(weeks) == 0 {
+			continue
+		}
+		rw.heading("Bye Weeks")
+		for _, week := range weeks {
+			var names []string
+			for _, id := range week.OnBye {
+				if player := export.players[id]; player != nil {
+					names = append(names, player.Name)
+				}
+			}
+			rw.need(16)
+			color := recapInk
+			label := fmt.Sprintf("Week %d", week.Week)
+			if week.Illegal {
+				color = recapReach
+				label += " (short a starter)"
+			}
+			rw.page.Text(recapMargin, rw.y, pdf.Bold, 9.5, color, label)
+			rw.page.Text(recapMargin+140, rw.y, pdf.Regular, 9.5, recapInk,
+				pdf.Fit(strings.Join(names, ", "), pdf.Regular, 9.5, pdf.PageWidth-2*recapMargin-140))
+			rw.y += 16
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := doc.Write(&buf); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/pdf")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=draft-%d-recap.pdf", draftID))
+	w.Write(buf.Bytes())
+}
@@ -0,0 +1,279 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/vibes/draft-board/internal/models"
+	"github.com/vibes/draft-board/internal/report"
+)
+
+// buildReport grades the draft as it stands now.
+func (h *Handler) buildReport(draft *models.Draft) (*report.Report, error) {
+	teams, err := h.teamRepo.GetByDraft(draft.ID)
+	if err != nil {
+		return nil, err
+	}
+	picks, err := h.pickRepo.GetByDraft(draft.ID)
+	if err != nil {
+		return nil, err
+	}
+	standings, players, err := h.projectedStandings(draft)
+	if err != nil {
+		return nil, err
+	}
+
+	return report.Build(report.Input{
+		Draft:     draft,
+		Teams:     teams,
+		Picks:     picks,
+		Players:   players,
+		Standings: standings,
+		Slots:     h.rosterSlots(draft),
+	}), nil
+}
+
+// storeReport snapshots the report card for a completed draft. A draft that
+// already has a report keeps it.
+func (h *Handler) storeReport(draft *models.Draft) error {
+	existing, err := h.reportRepo.GetByDraft(draft.ID)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		return nil
+	}
+
+	rep, err := h.buildReport(draft)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(rep)
+	if err != nil {
+		return err
+	}
+	return h.reportRepo.Create(&models.DraftReport{DraftID: draft.ID, Report: string(data)})
+}
+
+// draftReport returns the stored report for a completed draft, storing one
+// first if the draft finished before reports existed. Drafts still in
+// progress get an unsaved preview, reported by the false second result.
+func (h *Handler) draftReport(draft *models.Draft) (*report.Report, bool, error) {
+	if !draft.IsCompleted() {
+		rep, err := h.buildReport(draft)
+		return rep, false, err
+	}
+
+	if err := h.storeReport(draft); err != nil {
+		return nil, false, err
+	}
+	stored, err := h.reportRepo.GetByDraft(draft.ID)
+	if err != nil {
+		return nil, false, err
+	}
+	var rep report.Report
+	if err := json.Unmarshal([]byte(stored.Report), &rep); err != nil {
+		return nil, false, fmt.Errorf("failed to decode draft report: %w", err)
+	}
+	return &rep, true, nil
+}
+
+// GetDraftReport shows the league report card with team grades
+func (h *Handler) GetDraftReport(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	draftID, err := strconv.Atoi(idStr)
+	if err != nil {
+		http.Error(w, "Invalid draft ID", http.StatusBadRequest)
+		return
+	}
+
+	draft, err := h.draftRepo.GetByID(draftID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	rep, stored, err := h.draftReport(draft)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var content strings.Builder
+	content.WriteString(fmt.Sprintf(`
+		<div class="mb-8">
+			<a href="/draft/%d" class="text-tokyo-night-fg-dim hover:text-tokyo-night-accent mb-4 inline-block">← Back to Draft</a>
+			<h1 class="text-4xl font-bold mb-2 text-tokyo-night-accent">Draft Report Card</h1>
+			<p class="text-tokyo-night-fg-dim">%s</p>
+		</div>
+	`, draftID, template.HTMLEscapeString(draft.Name)))
+
+	if stored {
+		content.WriteString(fmt.Sprintf(`<p class="mb-6 text-sm text-tokyo-night-fg-dim">Grades locked %s</p>`,
+			rep.GeneratedAt.Format("Jan 2, 2006 3:04 PM")))
+	} else {
+		content.WriteString(`
+			<div class="mb-6 p-4 bg-tokyo-night-bg-light border border-tokyo-night-warning rounded-lg text-tokyo-night-warning">
+				Preview — grades are locked in when the draft completes.
+			</div>
+		`)
+	}
+
+	if len(rep.Superlatives) > 0 {
+		content.WriteString(`<div class="grid grid-cols-1 md:grid-cols-3 gap-4 mb-8">`)
+		for _, s := range rep.Superlatives {
+			content.WriteString(fmt.Sprintf(`
+				<div class="bg-tokyo-night-bg-light rounded-lg p-4 border border-tokyo-night-border">
+					<div class="text-sm text-tokyo-night-fg-dim">%s</div>
+					<div class="text-xl font-bold text-tokyo-night-accent">%s</div>
+					<div class="text-sm text-tokyo-night-fg">%s</div>
+				</div>
+			`, s.Title, template.HTMLEscapeString(s.TeamName), template.HTMLEscapeString(s.Detail)))
+		}
+		content.WriteString(`</div>`)
+	}
+
+	content.WriteString(`
+		<div class="overflow-x-auto mb-8">
+			<table class="w-full border-collapse bg-tokyo-night-bg-light rounded-lg overflow-hidden">
+				<thead>
+					<tr class="bg-tokyo-night-bg-dark">
+						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">Team</th>
+						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">Grade</th>
+						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">Value</th>
+						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">Starters</th>
+						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">Balance</th>
+						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">Byes</th>
+						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">Best Pick</th>
+						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">Worst Pick</th>
+					</tr>
+				</thead>
+				<tbody>
+	`)
+	for _, g := range rep.Teams {
+		starters := "—"
+		if g.StarterScore != nil {
+			starters = fmt.Sprintf("%.1f pts (#%d)", g.ProjectedPoints, g.ProjectedRank)
+		}
+		content.WriteString(fmt.Sprintf(`
+			<tr class="hover:bg-tokyo-night-bg-dark transition-colors">
+				<td class="px-4 py-2 border-b border-tokyo-night-border font-medium text-tokyo-night-fg">%s<div class="text-xs text-tokyo-night-fg-dim">%s</div></td>
+				<td class="px-4 py-2 border-b border-tokyo-night-border"><span class="text-2xl font-bold %s">%s</span> <span class="text-xs text-tokyo-night-fg-dim">%.0f</span></td>
+				<td class="px-4 py-2 border-b border-tokyo-night-border %s">%+d</td>
+				<td class="px-4 py-2 border-b border-tokyo-night-border text-tokyo-night-fg">%s</td>
+				<td class="px-4 py-2 border-b border-tokyo-night-border text-tokyo-night-fg">%.0f</td>
+				<td class="px-4 py-2 border-b border-tokyo-night-border text-tokyo-night-fg">%d</td>
+				<td class="px-4 py-2 border-b border-tokyo-night-border text-sm">%s</td>
+				<td class="px-4 py-2 border-b border-tokyo-night-border text-sm">%s</td>
+			</tr>
+		`, template.HTMLEscapeString(g.TeamName), template.HTMLEscapeString(g.OwnerName),
+			gradeClass(g.Grade), g.Grade, g.Score,
+			valueClass(g.TotalValue), g.TotalValue,
+			starters, g.BalanceScore, g.ByeConflicts,
+			reportPickCell(g.BestPick), reportPickCell(g.WorstPick)))
+	}
+	if len(rep.Teams) == 0 {
+		content.WriteString(`<tr><td colspan="8" class="px-4 py-8 text-center text-tokyo-night-fg-dim">No teams in this draft</td></tr>`)
+	}
+	content.WriteString(`</tbody></table></div>`)
+
+	content.WriteString(`<div class="grid grid-cols-1 md:grid-cols-2 gap-8">`)
+	for _, section := range []struct {
+		title string
+		picks []report.PickValue
+	}{
+		{"Best Picks", rep.BestPicks},
+		{"Worst Picks", rep.WorstPicks},
+	} {
+		content.WriteString(fmt.Sprintf(`
+			<div class="bg-tokyo-night-bg-light rounded-lg p-6 border border-tokyo-night-border">
+				<h2 class="text-2xl font-semibold mb-4 text-tokyo-night-fg">%s</h2>
+		`, section.title))
+		if len(section.picks) == 0 {
+			content.WriteString(`<p class="text-tokyo-night-fg-dim">No picks with ADP data.</p>`)
+		}
+		for _, p := range section.picks {
+			content.WriteString(fmt.Sprintf(`
+				<div class="flex justify-between items-center py-2 border-b border-tokyo-night-border">
+					<div>%s <span class="font-medium text-tokyo-night-fg">%s</span> <span class="text-sm text-tokyo-night-fg-dim">%s</span></div>
+					<div class="text-sm"><span class="text-tokyo-night-fg-dim">#%d (ADP %d)</span> <span class="%s font-semibold">%+d</span></div>
+				</div>
+			`, getPositionBadge(p.Position), template.HTMLEscapeString(p.PlayerName), template.HTMLEscapeString(p.TeamName),
+				p.OverallPick, *p.ADPRank, valueClass(p.ValueDiff), p.ValueDiff))
+		}
+		content.WriteString(`</div>`)
+	}
+	content.WriteString(`</div>`)
+
+	content.WriteString(fmt.Sprintf(`
+		<div class="mt-8">
+			<a href="/draft/%d/report/json" class="px-4 py-2 bg-tokyo-night-bg-light hover:bg-tokyo-night-bg border border-tokyo-night-border rounded-lg transition-colors">
+				Download JSON
+			</a>
+		</div>
+	`, draftID))
+
+	renderTemplate(w, content.String(), "Draft Report Card")
+}
+
+// GetDraftReportJSON returns the league report card as JSON
+func (h *Handler) GetDraftReportJSON(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	draftID, err := strconv.Atoi(idStr)
+	if err != nil {
+		http.Error(w, "Invalid draft ID", http.StatusBadRequest)
+		return
+	}
+
+	draft, err := h.draftRepo.GetByID(draftID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	rep, _, err := h.draftReport(draft)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(rep)
+}
+
+func reportPickCell(p *report.PickValue) string {
+	if p == nil {
+		return `<span class="text-tokyo-night-fg-dim">—</span>`
+	}
+	return fmt.Sprintf(`%s <span class="text-tokyo-night-fg-dim">R%d</span> <span class="%s">%+d</span>`,
+		template.HTMLEscapeString(p.PlayerName), p.Round, valueClass(p.ValueDiff), p.ValueDiff)
+}
+
+func gradeClass(grade string) string {
+	switch grade[0] {
+	case 'A':
+		return "text-tokyo-night-success"
+	case 'B':
+		return "text-tokyo-night-accent"
+	case 'C':
+		return "text-tokyo-night-warning"
+	default:
+		return "text-tokyo-night-error"
+	}
+}
+
+func valueClass(diff int) string {
+	switch {
+	case diff > 0:
+		return "text-tokyo-night-success"
+	case diff < 0:
+		return "text-tokyo-night-error"
+	default:
+		return "text-tokyo-night-fg-dim"
+	}
+}
@@ -0,0 +1,548 @@
+package handlers
+
+import (
+	"fmt"
+	"html/template"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	draftorder "github.com/vibes/draft-board/internal/draft"
+	"github.com/vibes/draft-board/internal/importer"
+	"github.com/vibes/draft-board/internal/models"
+	"github.com/vibes/draft-board/internal/validation"
+)
+
+// rookieDraftRounds is the number of rounds a rookie draft offers by
+// default.
+const rookieDraftRounds = 4
+
+// dynastyDrafts returns the league's dynasty drafts, latest season first.
+func (h *Handler) dynastyDrafts(leagueID int) ([]*models.Draft, error) {
+	drafts, err := h.leagueRepo.Drafts(leagueID)
+	if err != nil {
+		return nil, err
+	}
+	var dynasty []*models.Draft
+	for i := len(drafts) - 1; i >= 0; i-- {
+		if drafts[i].DraftType == "Dynasty" {
+			dynasty = append(dynasty, drafts[i])
+		}
+	}
+	return dynasty, nil
+}
+
+// previousRosters returns the players on each of a draft's teams when it
+// ended: those they picked and, for a rookie draft, those they carried in.
+func (h *Handler) previousRosters(draftID int) (map[int][]int, error) {
+	picks, err := h.pickRepo.GetByDraft(draftID)
+	if err != nil {
+		return nil, err
+	}
+	carried, err := h.pickRepo.CarriedPlayers(draftID)
+	if err != nil {
+		return nil, err
+	}
+	rosters := make(map[int][]int)
+	for _, c := range carried {
+		rosters[c.TeamID] = append(rosters[c.TeamID], c.PlayerID)
+	}
+	for _, pick := range picks {
+		rosters[pick.TeamID] = append(rosters[pick.TeamID], pick.PlayerID)
+	}
+	return rosters, nil
+}
+
+// carriedRostersPanel lists the players each of a rookie draft's teams
+// carried over from the league's previous draft.
+func (h *Handler) carriedRostersPanel(draft *models.Draft, teams []models.Team) string {
+	carried, err := h.pickRepo.CarriedPlayers(draft.ID)
+	if err != nil || len(carried) == 0 {
+		return ""
+	}
+	playerRepo := h.players(draft)
+	rosters := make(map[int][]*models.Player)
+	for _, c := range carried {
+		if player, err := playerRepo.GetByID(c.PlayerID); err == nil {
+			rosters[c.TeamID] = append(rosters[c.TeamID], player)
+		}
+	}
+
+	var panel strings.Builder
+	panel.WriteString(`
+		<h2 class="text-2xl font-semibold mb-4 text-tokyo-night-fg">Carried Rosters</h2>
+		<div class="grid gap-4 md:grid-cols-2 lg:grid-cols-3 mb-8">
+	`)
+	for _, team := range teams {
+		roster := rosters[team.ID]
+		sort.SliceStable(roster, func(i, j int) bool {
+			if roster[i].Position != roster[j].Position {
+				return roster[i].Position < roster[j].Position
+			}
+			return roster[i].Name < roster[j].Name
+		})
+		panel.WriteString(fmt.Sprintf(`
+			<div class="bg-tokyo-night-bg-light rounded-lg border border-tokyo-night-border p-4">
+				<h3 class="font-semibold mb-2 text-tokyo-night-fg">%s <span class="text-sm text-tokyo-night-fg-dim">%d players</span></h3>
+				<ul class="space-y-1 text-sm">
+		`, template.HTMLEscapeString(team.TeamName), len(roster)))
+		for _, player := range roster {
+			panel.WriteString(fmt.Sprintf(`<li>%s %s <span class="text-tokyo-night-fg-dim">%s</span></li>`,
+				getPositionBadge(player.Position), template.HTMLEscapeString(player.Name), player.Team))
+		}
+		panel.WriteString(`</ul></div>`)
+	}
+	panel.WriteString(`</div>`)
+	return panel.String()
+}
+
+// GetRookieDraft shows the form to start the league's rookie draft from a
+// previous dynasty draft, the league's traded future picks and the form
+// to mark the season's rookie class
+func (h *Handler) GetRookieDraft(w http.ResponseWriter, r *http.Request) {
+	l, ok := h.leagueFromRequest(w, r)
+	if !ok {
+		return
+	}
+
+	drafts, err := h.dynastyDrafts(l.ID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	franchises, err := h.leagueRepo.Franchises(l.ID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	futurePicks, err := h.leagueRepo.FuturePicks(l.ID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	season, err := h.seasonRepo.Current()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var previous *models.Draft
+	if len(drafts) > 0 {
+		previous = drafts[0]
+	}
+	if id, err := strconv.Atoi(r.URL.Query().Get("previous")); err == nil {
+		for _, d := range drafts {
+			if d.ID == id {
+				previous = d
+			}
+		}
+	}
+
+	inputClass := "px-4 py-2 bg-tokyo-night-bg border border-tokyo-night-border rounded-lg text-tokyo-night-fg focus:outline-none focus:border-tokyo-night-accent"
+	buttonClass := "px-4 py-2 bg-tokyo-night-accent hover:bg-tokyo-night-accent-hover text-white rounded-lg font-semibold transition-colors"
+
+	var content strings.Builder
+	content.WriteString(leagueHeader(l, "Rookie draft"))
+
+	content.WriteString(`
+		<div class="bg-tokyo-night-bg-light rounded-lg border border-tokyo-night-border p-6 mb-8 max-w-3xl">
+			<h2 class="text-2xl font-semibold mb-2 text-tokyo-night-fg">New Rookie Draft</h2>
+			<p class="text-sm text-tokyo-night-fg-dim mb-4">Teams keep the rosters they ended the previous draft with and draft only the season's rookies. The last-place team picks first in every round, and traded picks go to the franchise that owns them.</p>
+	`)
+	if previous == nil {
+		content.WriteString(`<p class="text-tokyo-night-fg-dim">Add a dynasty draft to the league to start its rookie draft from.</p></div>`)
+	} else {
+		teams, err := h.teamRepo.GetByDraft(previous.ID)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		selected := func(match bool) string {
+			if match {
+				return "selected"
+			}
+			return ""
+		}
+		var options strings.Builder
+		for _, d := range drafts {
+			options.WriteString(fmt.Sprintf(`<option value="%d" %s>%d · %s</option>`,
+				d.ID, selected(d.ID == previous.ID), d.Season, template.HTMLEscapeString(d.Name)))
+		}
+		rookieSeason := previous.Season + 1
+		if season > rookieSeason {
+			rookieSeason = season
+		}
+
+		content.WriteString(fmt.Sprintf(`
+			<form method="GET" action="/leagues/%d/rookie-draft" class="mb-4">
+				<label class="block text-sm font-medium mb-2 text-tokyo-night-fg">Previous draft</label>
+				<select name="previous" onchange="this.form.submit()" class="w-full %s">%s</select>
+			</form>
+			<form method="POST" action="/leagues/%d/rookie-draft" class="space-y-4">
+				<input type="hidden" name="previous" value="%d">
+				<div class="flex flex-wrap gap-4">
+					<div class="flex-1">
+						<label class="block text-sm font-medium mb-2 text-tokyo-night-fg">Name</label>
+						<input type="text" name="name" value="%d Rookie Draft" required maxlength="100" class="w-full %s">
+					</div>
+					<div>
+						<label class="block text-sm font-medium mb-2 text-tokyo-night-fg">Season</label>
+						<input type="number" name="season" value="%d" min="1900" max="2999" required class="w-28 %s">
+					</div>
+					<div>
+						<label class="block text-sm font-medium mb-2 text-tokyo-night-fg">Rounds</label>
+						<input type="number" name="rounds" value="%d" min="1" max="10" required class="w-24 %s">
+					</div>
+				</div>
+				<div>
+					<label class="block text-sm font-medium mb-2 text-tokyo-night-fg">Last season's finish</label>
+					<p class="text-xs text-tokyo-night-fg-dim mb-2">1 for the champion, %d for last place</p>
+					<div class="grid gap-2 md:grid-cols-2">
+		`, l.ID, inputClass, options.String(), l.ID, previous.ID, rookieSeason, inputClass, rookieSeason, inputClass,
+			rookieDraftRounds, inputClass, len(teams)))
+		for _, team := range teams {
+			content.WriteString(fmt.Sprintf(`
+						<label class="flex items-center justify-between gap-2 text-tokyo-night-fg">
+							<span>%s</span>
+							<input type="number" name="finish_%d" min="1" max="%d" required class="w-20 %s">
+						</label>
+			`, template.HTMLEscapeString(team.TeamName), team.ID, len(teams), inputClass))
+		}
+		content.WriteString(fmt.Sprintf(`
+					</div>
+				</div>
+				<button type="submit" class="%s">Create Rookie Draft</button>
+			</form>
+		</div>
+		`, buttonClass))
+	}
+
+	content.WriteString(futurePicksSection(l, franchises, futurePicks, season, inputClass, buttonClass))
+
+	content.WriteString(fmt.Sprintf(`
+		<div class="bg-tokyo-night-bg-light rounded-lg border border-tokyo-night-border p-6 max-w-3xl">
+			<h2 class="text-2xl font-semibold mb-2 text-tokyo-night-fg">Rookie Class</h2>
+			<p class="text-sm text-tokyo-night-fg-dim mb-4">A rookie draft only offers the players marked as rookies in its season. One player per line: <code>name</code>, optionally followed by <code>position</code> and <code>team</code>, separated by commas.</p>
+			<form method="POST" action="/leagues/%d/rookies" class="space-y-4">
+				<div>
+					<label class="block text-sm font-medium mb-2 text-tokyo-night-fg">Season</label>
+					<input type="number" name="season" value="%d" min="1900" max="2999" required class="w-28 %s">
+				</div>
+				<textarea name="players" rows="6" required placeholder="Ashton Jeanty, RB, LV" class="w-full %s"></textarea>
+				<button type="submit" class="%s">Mark Rookies</button>
+			</form>
+		</div>
+	`, l.ID, season, inputClass, inputClass, buttonClass))
+
+	renderTemplate(w, content.String(), l.Name+" Rookie Draft")
+}
+
+// futurePicksSection lists the league's traded future picks with a form to
+// record another trade.
+func futurePicksSection(l *models.League, franchises []models.Franchise, picks []models.FuturePick, season int, inputClass, buttonClass string) string {
+	names := make(map[int]string, len(franchises))
+	var options strings.Builder
+	for _, f := range franchises {
+		names[f.ID] = f.Name
+		options.WriteString(fmt.Sprintf(`<option value="%d">%s</option>`, f.ID, template.HTMLEscapeString(f.Name)))
+	}
+
+	var content strings.Builder
+	content.WriteString(`
+		<h2 class="text-2xl font-semibold mb-4 text-tokyo-night-fg">Traded Picks</h2>
+		<div class="overflow-x-auto mb-4">
+			<table class="w-full border-collapse bg-tokyo-night-bg-light rounded-lg overflow-hidden">
+				<thead>
+					<tr class="bg-tokyo-night-bg-dark">
+						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">Season</th>
+						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">Round</th>
+						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">Pick of</th>
+						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">Owned by</th>
+						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">Note</th>
+						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border"></th>
+					</tr>
+				</thead>
+				<tbody>
+	`)
+	if len(picks) == 0 {
+		content.WriteString(`<tr><td colspan="6" class="px-4 py-6 text-center text-tokyo-night-fg-dim">No traded picks</td></tr>`)
+	}
+	for _, p := range picks {
+		content.WriteString(fmt.Sprintf(`
+					<tr class="hover:bg-tokyo-night-bg">
+						<td class="px-4 py-2 border-b border-tokyo-night-border">%d</td>
+						<td class="px-4 py-2 border-b border-tokyo-night-border">%d</td>
+						<td class="px-4 py-2 border-b border-tokyo-night-border">%s</td>
+						<td class="px-4 py-2 border-b border-tokyo-night-border font-semibold">%s</td>
+						<td class="px-4 py-2 border-b border-tokyo-night-border text-sm text-tokyo-night-fg-dim">%s</td>
+						<td class="px-4 py-2 border-b border-tokyo-night-border text-right">
+							<form method="POST" action="/leagues/%d/future-picks/%d/delete" class="inline">
+								<button type="submit" class="text-sm text-tokyo-night-error hover:underline">Remove</button>
+							</form>
+						</td>
+					</tr>
+		`, p.Season, p.Round, template.HTMLEscapeString(names[p.OriginalFranchiseID]),
+			template.HTMLEscapeString(names[p.OwnerFranchiseID]), template.HTMLEscapeString(p.Note), l.ID, p.ID))
+	}
+	content.WriteString(`</tbody></table></div>`)
+
+	if len(franchises) < 2 {
+		return content.String()
+	}
+	content.WriteString(fmt.Sprintf(`
+		<div class="bg-tokyo-night-bg-light rounded-lg border border-tokyo-night-border p-6 mb-8 max-w-3xl">
+			<h3 class="text-xl font-semibold mb-4 text-tokyo-night-fg">Record a Trade</h3>
+			<form method="POST" action="/leagues/%d/future-picks" class="flex flex-wrap items-end gap-4">
+				<div>
+					<label class="block text-sm font-medium mb-2 text-tokyo-night-fg">Season</label>
+					<input type="number" name="season" value="%d" min="1900" max="2999" required class="w-28 %s">
+				</div>
+				<div>
+					<label class="block text-sm font-medium mb-2 text-tokyo-night-fg">Round</label>
+					<input type="number" name="round" value="1" min="1" max="10" required class="w-20 %s">
+				</div>
+				<div>
+					<label class="block text-sm font-medium mb-2 text-tokyo-night-fg">Pick of</label>
+					<select name="original_franchise_id" class="%s">%s</select>
+				</div>
+				<div>
+					<label class="block text-sm font-medium mb-2 text-tokyo-night-fg">Owned by</label>
+					<select name="owner_franchise_id" class="%s">%s</select>
+				</div>
+				<div class="flex-1">
+					<label class="block text-sm font-medium mb-2 text-tokyo-night-fg">Note</label>
+					<input type="text" name="note" maxlength="100" class="w-full %s">
+				</div>
+				<button type="submit" class="%s">Save</button>
+			</form>
+		</div>
+	`, l.ID, season, inputClass, inputClass, inputClass, options.String(), inputClass, options.String(), inputClass, buttonClass))
+	return content.String()
+}
+
+// CreateRookieDraft creates the league's rookie draft from a previous
+// dynasty draft and last season's standings
+func (h *Handler) CreateRookieDraft(w http.ResponseWriter, r *http.Request) {
+	l, ok := h.leagueFromRequest(w, r)
+	if !ok {
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	previousID, err := strconv.Atoi(r.FormValue("previous"))
+	if err != nil {
+		http.Error(w, "Invalid draft ID", http.StatusBadRequest)
+		return
+	}
+	previous, err := h.draftRepo.GetByID(previousID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	if previous.LeagueID == nil || *previous.LeagueID != l.ID || previous.DraftType != "Dynasty" {
+		http.Error(w, "A rookie draft follows one of the league's dynasty drafts", http.StatusBadRequest)
+		return
+	}
+	season, err := strconv.Atoi(r.FormValue("season"))
+	if err != nil || season <= previous.Season {
+		http.Error(w, "The rookie draft's season must come after the previous draft's", http.StatusBadRequest)
+		return
+	}
+	rounds, err := strconv.Atoi(r.FormValue("rounds"))
+	if err != nil || rounds < 1 || rounds > 10 {
+		http.Error(w, "Rounds must be from 1 to 10", http.StatusBadRequest)
+		return
+	}
+
+	teams, err := h.teamRepo.GetByDraft(previous.ID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	finish := make(map[int]int, len(teams))
+	for _, team := range teams {
+		finish[team.ID], _ = strconv.Atoi(r.FormValue(fmt.Sprintf("finish_%d", team.ID)))
+	}
+	rosters, err := h.previousRosters(previous.ID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	trades, err := h.leagueRepo.FuturePicks(l.ID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	plan, err := draftorder.PlanRookieDraft(teams, finish, rosters, season, rounds, trades)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	cfg, err := h.draftConfig(previous)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	draft := cfg.Draft(strings.TrimSpace(r.FormValue("name")))
+	draft.CommissionerID = uuid.New().String()
+	draft.MaxRounds = rounds
+	draft.Season = season
+	draft.LeagueID = &l.ID
+	draft.PreviousDraftID = &previous.ID
+	if err := validation.ValidateDraft(draft); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := h.draftRepo.CreateRookieDraft(draft, plan, cfg); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	http.Redirect(w, r, fmt.Sprintf("/draft/%d/setup", draft.ID), http.StatusSeeOther)
+}
+
+// SaveFuturePick records a trade of one of the league's future picks
+func (h *Handler) SaveFuturePick(w http.ResponseWriter, r *http.Request) {
+	l, ok := h.leagueFromRequest(w, r)
+	if !ok {
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	pick := &models.FuturePick{LeagueID: l.ID, Note: strings.TrimSpace(r.FormValue("note"))}
+	var err error
+	if pick.Season, err = strconv.Atoi(r.FormValue("season")); err != nil {
+		http.Error(w, "Invalid season", http.StatusBadRequest)
+		return
+	}
+	if pick.Round, err = strconv.Atoi(r.FormValue("round")); err != nil || pick.Round < 1 {
+		http.Error(w, "Invalid round", http.StatusBadRequest)
+		return
+	}
+	franchises, err := h.leagueRepo.Franchises(l.ID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	inLeague := make(map[int]bool, len(franchises))
+	for _, f := range franchises {
+		inLeague[f.ID] = true
+	}
+	pick.OriginalFranchiseID, _ = strconv.Atoi(r.FormValue("original_franchise_id"))
+	pick.OwnerFranchiseID, _ = strconv.Atoi(r.FormValue("owner_franchise_id"))
+	if !inLeague[pick.OriginalFranchiseID] || !inLeague[pick.OwnerFranchiseID] {
+		http.Error(w, "Unknown franchise", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.leagueRepo.SaveFuturePick(pick); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	http.Redirect(w, r, fmt.Sprintf("/leagues/%d/rookie-draft", l.ID), http.StatusSeeOther)
+}
+
+// DeleteFuturePick removes a traded future pick from the league
+func (h *Handler) DeleteFuturePick(w http.ResponseWriter, r *http.Request) {
+	l, ok := h.leagueFromRequest(w, r)
+	if !ok {
+		return
+	}
+	pickID, err := strconv.Atoi(chi.URLParam(r, "pickId"))
+	if err != nil {
+		http.Error(w, "Invalid pick ID", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.leagueRepo.DeleteFuturePick(l.ID, pickID); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	http.Redirect(w, r, fmt.Sprintf("/leagues/%d/rookie-draft", l.ID), http.StatusSeeOther)
+}
+
+// MarkRookies marks the players listed one per line as rookies in a season
+func (h *Handler) MarkRookies(w http.ResponseWriter, r *http.Request) {
+	l, ok := h.leagueFromRequest(w, r)
+	if !ok {
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	season, err := strconv.Atoi(r.FormValue("season"))
+	if err != nil {
+		http.Error(w, "Invalid season", http.StatusBadRequest)
+		return
+	}
+
+	players, err := h.playerRepo.List()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	matcher := importer.NewMatcher(players).WithTeams(h.nflTeams())
+
+	var rookies []int
+	var unmatched []string
+	for _, line := range strings.Split(r.FormValue("players"), "\n") {
+		fields := strings.Split(line, ",")
+		for i := range fields {
+			fields[i] = strings.TrimSpace(fields[i])
+		}
+		if fields[0] == "" {
+			continue
+		}
+		fields = append(fields, "", "")
+		player := matcher.Match(0, fields[0], fields[1], fields[2])
+		if player == nil {
+			unmatched = append(unmatched, fields[0])
+			continue
+		}
+		rookies = append(rookies, player.ID)
+	}
+
+	if err := h.playerRepo.SetRookieSeason(rookies, season); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var content strings.Builder
+	content.WriteString(fmt.Sprintf(`
+		<div class="max-w-2xl mx-auto">
+			<div class="mb-8">
+				<a href="/leagues/%d/rookie-draft" class="text-tokyo-night-fg-dim hover:text-tokyo-night-accent mb-4 inline-block">← Back to Rookie Draft</a>
+				<h1 class="text-4xl font-bold mb-2 text-tokyo-night-accent">Rookies Marked</h1>
+				<p class="text-tokyo-night-fg-dim">%d players marked as %d rookies.</p>
+			</div>
+	`, l.ID, len(rookies), season))
+	if len(unmatched) > 0 {
+		content.WriteString(`
+			<div class="bg-tokyo-night-bg-light rounded-lg border border-tokyo-night-border p-6">
+				<h2 class="text-xl font-semibold mb-4 text-tokyo-night-warning">Unmatched players</h2>
+				<ul class="space-y-1 text-sm text-tokyo-night-fg-dim">
+		`)
+		for _, name := range unmatched {
+			content.WriteString(`<li>` + template.HTMLEscapeString(name) + `</li>`)
+		}
+		content.WriteString(`</ul></div>`)
+	}
+	content.WriteString(`</div>`)
+
+	renderTemplate(w, content.String(), "Rookies Marked")
+}
@@ -0,0 +1,166 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/vibes/draft-board/internal/models"
+	"github.com/vibes/draft-board/internal/repository"
+	"github.com/vibes/draft-board/internal/runs"
+	"github.com/vibes/draft-board/internal/validation"
+)
+
+// runSettings returns the draft's run alert settings or the defaults.
+func (h *Handler) runSettings(draftID int) models.RunSettings {
+	settings, err := h.runRepo.GetByDraft(draftID)
+	if err != nil || settings == nil {
+		return runs.DefaultSettings(draftID)
+	}
+	return *settings
+}
+
+// pickAlerts checks the draft's latest pick for a positional run or a tier
+// break at the drafted position.
+func (h *Handler) pickAlerts(draft *models.Draft) []runs.Alert {
+	picks, err := h.pickRepo.GetByDraft(draft.ID)
+	if err != nil || len(picks) == 0 {
+		return nil
+	}
+	settings := h.runSettings(draft.ID)
+
+	start := len(picks) - settings.Window
+	if start < 0 {
+		start = 0
+	}
+	var positions []string
+	playerRepo := h.players(draft)
+	for _, pick := range picks[start:] {
+		if player, err := playerRepo.GetByID(pick.PlayerID); err == nil {
+			positions = append(positions, player.Position)
+		}
+	}
+
+	var alerts []runs.Alert
+	if alert := runs.Detect(positions, settings); alert != nil {
+		alerts = append(alerts, *alert)
+	}
+
+	last := picks[len(picks)-1]
+	player, err := h.players(draft).GetByID(last.PlayerID)
+	if err != nil {
+		return alerts
+	}
+	next, err := h.players(draft).GetAvailable(draft.ID, repository.PlayerFilters{
+		Positions:     []string{player.Position},
+		DraftType:     draft.DraftType,
+		ScoringFormat: draft.ScoringFormat,
+		Sport:         draft.Sport,
+		RookieSeason:  draft.RookieSeason(),
+		Limit:         1,
+	})
+	if err != nil {
+		return alerts
+	}
+	var nextRank *int
+	if len(next) > 0 {
+		nextRank = h.adpRanker(draft)(next[0])
+	}
+	if alert := runs.DetectTierBreak(player.Position, last.ADPRank, nextRank, settings); alert != nil {
+		alerts = append(alerts, *alert)
+	}
+	return alerts
+}
+
+// runAlertsBanner renders the latest pick's alerts above the draft board.
+// New alerts arriving over SSE are added to the same container.
+func runAlertsBanner(alerts []runs.Alert) string {
+	var banner strings.Builder
+	banner.WriteString(`<div id="run-alerts" class="mb-6 space-y-2">`)
+	for _, alert := range alerts {
+		banner.WriteString(fmt.Sprintf(`
+			<div class="flex items-center gap-3 px-4 py-2 bg-tokyo-night-bg-light border border-tokyo-night-warning rounded-lg">
+				%s <span class="text-tokyo-night-warning font-semibold">%s</span>
+			</div>
+		`, getPositionBadge(alert.Position), alert.Message))
+	}
+	banner.WriteString(`</div>`)
+	return banner.String()
+}
+
+// UpdateRunSettings saves the window and thresholds for run alerts
+func (h *Handler) UpdateRunSettings(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	draftID, err := strconv.Atoi(idStr)
+	if err != nil {
+		http.Error(w, "Invalid draft ID", http.StatusBadRequest)
+		return
+	}
+
+	if _, err := h.draftRepo.GetByID(draftID); err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	window, _ := strconv.Atoi(r.FormValue("window"))
+	threshold, _ := strconv.Atoi(r.FormValue("threshold"))
+	tierGap, err := strconv.Atoi(r.FormValue("tier_gap"))
+	if err != nil {
+		tierGap = -1
+	}
+	settings := &models.RunSettings{
+		DraftID:   draftID,
+		Window:    window,
+		Threshold: threshold,
+		TierGap:   tierGap,
+	}
+
+	if err := validation.ValidateRunSettings(*settings); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := h.runRepo.Save(settings); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	http.Redirect(w, r, fmt.Sprintf("/draft/%d/setup", draftID), http.StatusSeeOther)
+}
+
+// runSettingsForm renders the run alert editor shown on the setup page.
+func (h *Handler) runSettingsForm(draft *models.Draft) string {
+	settings := h.runSettings(draft.ID)
+	inputClass := "w-20 px-3 py-2 bg-tokyo-night-bg border border-tokyo-night-border rounded-lg text-tokyo-night-fg focus:outline-none focus:border-tokyo-night-accent"
+	return fmt.Sprintf(`
+		<div class="mt-8 bg-tokyo-night-bg-light rounded-lg border border-tokyo-night-border p-6">
+			<h2 class="text-2xl font-semibold mb-4 text-tokyo-night-fg">Run Alerts</h2>
+			<p class="text-sm text-tokyo-night-fg-dim mb-4">Alert when one position takes the threshold of the last window picks, or when the next player at a position ranks the tier gap or more spots lower (0 turns tier alerts off).</p>
+			<form method="POST" action="/draft/%d/run-settings" class="flex flex-wrap items-end gap-4">
+				<div>
+					<label class="block text-sm font-medium mb-2 text-tokyo-night-fg">Window</label>
+					<input type="number" name="window" min="2" max="20" value="%d" class="%s">
+				</div>
+				<div>
+					<label class="block text-sm font-medium mb-2 text-tokyo-night-fg">Threshold</label>
+					<input type="number" name="threshold" min="2" max="20" value="%d" class="%s">
+				</div>
+				<div>
+					<label class="block text-sm font-medium mb-2 text-tokyo-night-fg">Tier Gap</label>
+					<input type="number" name="tier_gap" min="0" max="100" value="%d" class="%s">
+				</div>
+				<button type="submit"
+					class="px-4 py-2 bg-tokyo-night-accent hover:bg-tokyo-night-accent-hover text-white rounded-lg font-semibold transition-colors">
+					Save Alerts
+				</button>
+			</form>
+		</div>
+	`, draft.ID, settings.Window, inputClass, settings.Threshold, inputClass, settings.TierGap, inputClass)
+}
@@ -0,0 +1,234 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/vibes/draft-board/internal/models"
+	"github.com/vibes/draft-board/internal/scoring"
+	"github.com/vibes/draft-board/internal/sport"
+	"github.com/vibes/draft-board/internal/validation"
+)
+
+// scoringOverridePositions are the positions offered for per-position
+// overrides in the scoring editor: the draft's sport's positions other than
+// optional ones.
+func scoringOverridePositions(draft *models.Draft) []string {
+	return sport.Get(draft.Sport).CorePositions()
+}
+
+// scoringRules returns the draft's custom ruleset, or the preset for its
+// scoring format when no custom rules are stored. The presets score
+// football stats, so drafts of other sports score nothing until they are
+// given rules.
+func (h *Handler) scoringRules(draft *models.Draft) scoring.Ruleset {
+	rules, err := h.scoringRepo.GetByDraft(draft.ID)
+	if err != nil || len(rules) == 0 {
+		if sport.Get(draft.Sport).Key != sport.Football {
+			return scoring.Ruleset{Base: make(scoring.Rules)}
+		}
+		return scoring.Preset(draft.ScoringFormat)
+	}
+	return scoring.FromRules(rules)
+}
+
+// scoringLabel names the scoring a draft uses for display.
+func (h *Handler) scoringLabel(draft *models.Draft) string {
+	rules, err := h.scoringRepo.GetByDraft(draft.ID)
+	if err != nil || len(rules) == 0 {
+		return sport.Get(draft.Sport).FormatLabel(draft.ScoringFormat)
+	}
+	return "Custom"
+}
+
+// GetScoringRules shows the scoring rules editor
+func (h *Handler) GetScoringRules(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	draftID, err := strconv.Atoi(idStr)
+	if err != nil {
+		http.Error(w, "Invalid draft ID", http.StatusBadRequest)
+		return
+	}
+
+	draft, err := h.draftRepo.GetByID(draftID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	rules := h.scoringRules(draft)
+	positions := scoringOverridePositions(draft)
+
+	var content strings.Builder
+	content.WriteString(fmt.Sprintf(`
+		<div class="mb-8">
+			<a href="/draft/%d/setup" class="text-tokyo-night-fg-dim hover:text-tokyo-night-accent mb-4 inline-block">← Back to Setup</a>
+			<h1 class="text-4xl font-bold mb-2 text-tokyo-night-accent">Scoring Rules</h1>
+			<p class="text-tokyo-night-fg-dim">Currently using: %s. Points per unit of each stat; leave a position cell blank to use the base value.</p>
+		</div>
+		<form method="POST" action="/draft/%d/scoring">
+			<div class="overflow-x-auto mb-6">
+				<table class="w-full border-collapse bg-tokyo-night-bg-light rounded-lg overflow-hidden">
+					<thead>
+						<tr class="bg-tokyo-night-bg-dark">
+							<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">Stat</th>
+							<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">Base</th>
+	`, draftID, h.scoringLabel(draft), draftID))
+	for _, pos := range positions {
+		content.WriteString(fmt.Sprintf(`<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">%s</th>`, pos))
+	}
+	content.WriteString(`</tr></thead><tbody>`)
+
+	inputClass := "w-20 px-2 py-1 bg-tokyo-night-bg border border-tokyo-night-border rounded text-tokyo-night-fg focus:outline-none focus:border-tokyo-night-accent"
+	for _, stat := range scoring.Stats {
+		content.WriteString(fmt.Sprintf(`<tr>
+			<td class="px-4 py-2 border-b border-tokyo-night-border font-medium text-tokyo-night-fg">%s</td>
+			<td class="px-4 py-2 border-b border-tokyo-night-border"><input type="number" step="any" name="base_%s" value="%s" class="%s"></td>
+		`, stat, stat, formatPoints(rules.Base[stat]), inputClass))
+		for _, pos := range positions {
+			value := ""
+			if points, ok := rules.Positions[pos][stat]; ok {
+				value = formatPoints(points)
+			}
+			content.WriteString(fmt.Sprintf(`<td class="px-4 py-2 border-b border-tokyo-night-border"><input type="number" step="any" name="pos_%s_%s" value="%s" class="%s"></td>`,
+				pos, stat, value, inputClass))
+		}
+		content.WriteString(`</tr>`)
+	}
+	content.WriteString(`</tbody></table></div>
+			<button type="submit" class="px-6 py-2 bg-tokyo-night-accent hover:bg-tokyo-night-accent-hover text-white rounded-lg font-semibold transition-colors">
+				Save Scoring Rules
+			</button>
+		</form>
+	`)
+
+	profile := sport.Get(draft.Sport)
+	reset := "Clear Scoring Rules"
+	if profile.Key == sport.Football {
+		reset = fmt.Sprintf("Reset to %s Preset", profile.FormatLabel(draft.ScoringFormat))
+	}
+	content.WriteString(fmt.Sprintf(`
+		<form method="POST" action="/draft/%d/scoring/reset" class="mt-4">
+			<button type="submit" class="px-4 py-2 bg-tokyo-night-bg-light hover:bg-tokyo-night-bg border border-tokyo-night-border rounded-lg transition-colors">
+				%s
+			</button>
+		</form>
+	`, draftID, reset))
+
+	renderTemplate(w, content.String(), "Scoring Rules")
+}
+
+// GetScoringRulesJSON returns the draft's effective scoring rules as JSON
+func (h *Handler) GetScoringRulesJSON(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	draftID, err := strconv.Atoi(idStr)
+	if err != nil {
+		http.Error(w, "Invalid draft ID", http.StatusBadRequest)
+		return
+	}
+
+	draft, err := h.draftRepo.GetByID(draftID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(h.scoringRules(draft))
+}
+
+// UpdateScoringRules stores custom scoring rules for a draft. It accepts the
+// editor form or a JSON ruleset body.
+func (h *Handler) UpdateScoringRules(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	draftID, err := strconv.Atoi(idStr)
+	if err != nil {
+		http.Error(w, "Invalid draft ID", http.StatusBadRequest)
+		return
+	}
+
+	draft, err := h.draftRepo.GetByID(draftID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	var rules scoring.Ruleset
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
+		if err := json.NewDecoder(r.Body).Decode(&rules); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	} else {
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		rules = scoring.Ruleset{Base: make(scoring.Rules)}
+		for _, stat := range scoring.Stats {
+			if value := r.FormValue("base_" + stat); value != "" {
+				points, err := strconv.ParseFloat(value, 64)
+				if err != nil {
+					http.Error(w, fmt.Sprintf("invalid points for %s", stat), http.StatusBadRequest)
+					return
+				}
+				rules.Base[stat] = points
+			}
+			for _, pos := range scoringOverridePositions(draft) {
+				value := r.FormValue("pos_" + pos + "_" + stat)
+				if value == "" {
+					continue
+				}
+				points, err := strconv.ParseFloat(value, 64)
+				if err != nil {
+					http.Error(w, fmt.Sprintf("invalid %s points for %s", pos, stat), http.StatusBadRequest)
+					return
+				}
+				if rules.Positions == nil {
+					rules.Positions = make(map[string]scoring.Rules)
+				}
+				if rules.Positions[pos] == nil {
+					rules.Positions[pos] = make(scoring.Rules)
+				}
+				rules.Positions[pos][stat] = points
+			}
+		}
+	}
+
+	if err := validation.ValidateScoringRules(sport.Get(draft.Sport), rules); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := h.scoringRepo.ReplaceForDraft(draftID, rules.ToRules(draftID)); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	http.Redirect(w, r, fmt.Sprintf("/draft/%d/scoring", draftID), http.StatusSeeOther)
+}
+
+// ResetScoringRules drops custom rules so the draft uses its format's preset
+func (h *Handler) ResetScoringRules(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	draftID, err := strconv.Atoi(idStr)
+	if err != nil {
+		http.Error(w, "Invalid draft ID", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.scoringRepo.DeleteForDraft(draftID); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	http.Redirect(w, r, fmt.Sprintf("/draft/%d/scoring", draftID), http.StatusSeeOther)
+}
+
+func formatPoints(points float64) string {
+	return strconv.FormatFloat(points, 'f', -1, 64)
+}
@@ -0,0 +1,131 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/vibes/draft-board/internal/models"
+	"github.com/vibes/draft-board/internal/repository"
+)
+
+// players reads players as they were in the draft's season, so completed
+// drafts keep the teams, byes and ranks they were drafted with.
+func (h *Handler) players(draft *models.Draft) *repository.PlayerRepository {
+	if current, err := h.seasonRepo.Current(); err == nil && draft.Season == current {
+		return h.playerRepo
+	}
+	return h.playerRepo.ForSeason(draft.Season)
+}
+
+// seasonKey keys a shared tier source by season: keys for the current
+// season stand alone, past seasons add the year.
+func (h *Handler) seasonKey(draft *models.Draft, key string) string {
+	if current, err := h.seasonRepo.Current(); err == nil && draft.Season == current {
+		return key
+	}
+	return fmt.Sprintf("%s:%d", key, draft.Season)
+}
+
+// builtinTierSource is the tier source key for the player rank columns a
+// draft drafts from.
+func (h *Handler) builtinTierSource(draft *models.Draft) string {
+	return h.seasonKey(draft, models.RankFormat(draft.DraftType, draft.ScoringFormat))
+}
+
+// GetSeasons lists the seasons with a form to roll over to the next one
+func (h *Handler) GetSeasons(w http.ResponseWriter, r *http.Request) {
+	seasons, err := h.seasonRepo.List()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	drafts, err := h.draftRepo.List()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	current, err := h.seasonRepo.Current()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	draftCounts := make(map[int]int)
+	for _, d := range drafts {
+		draftCounts[d.Season]++
+	}
+
+	var content strings.Builder
+	content.WriteString(`
+		<div class="mb-8">
+			<a href="/" class="text-tokyo-night-fg-dim hover:text-tokyo-night-accent mb-4 inline-block">← Back</a>
+			<h1 class="text-4xl font-bold mb-2 text-tokyo-night-accent">Seasons</h1>
+			<p class="text-tokyo-night-fg-dim">Each draft belongs to a season and keeps the teams, byes and ranks players had in it</p>
+		</div>
+		<div class="overflow-x-auto mb-8 max-w-2xl">
+			<table class="w-full border-collapse bg-tokyo-night-bg-light rounded-lg overflow-hidden">
+				<thead>
+					<tr class="bg-tokyo-night-bg-dark">
+						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">Season</th>
+						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">Drafts</th>
+						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border"></th>
+					</tr>
+				</thead>
+				<tbody>
+	`)
+	for _, season := range seasons {
+		status := ""
+		if season.IsCurrent {
+			status = `<span class="px-2 py-1 rounded text-xs font-semibold bg-tokyo-night-accent text-white">Current</span>`
+		}
+		content.WriteString(fmt.Sprintf(`
+			<tr class="hover:bg-tokyo-night-bg-dark transition-colors">
+				<td class="px-4 py-2 border-b border-tokyo-night-border font-medium text-tokyo-night-fg">%d</td>
+				<td class="px-4 py-2 border-b border-tokyo-night-border text-tokyo-night-fg-dim">%d</td>
+				<td class="px-4 py-2 border-b border-tokyo-night-border">%s</td>
+			</tr>
+		`, season.Year, draftCounts[season.Year], status))
+	}
+	content.WriteString(`</tbody></table></div>`)
+
+	content.WriteString(fmt.Sprintf(`
+		<div class="max-w-2xl bg-tokyo-night-bg-light rounded-lg border border-tokyo-night-border p-6">
+			<h2 class="text-2xl font-semibold mb-2 text-tokyo-night-fg">Start a New Season</h2>
+			<p class="text-sm text-tokyo-night-fg-dim mb-4">
+				Archives %d player data so completed drafts keep it. Players keep their IDs, and drafts that
+				haven't completed move to the new season.
+			</p>
+			<form method="POST" action="/seasons/rollover" class="space-y-4" onsubmit="return confirm('Start the new season?')">
+				<input type="number" name="year" value="%d" min="%d" max="2100" required
+					class="w-full px-4 py-2 bg-tokyo-night-bg border border-tokyo-night-border rounded-lg text-tokyo-night-fg focus:outline-none focus:border-tokyo-night-accent">
+				<label class="flex items-center gap-2 text-tokyo-night-fg">
+					<input type="checkbox" name="reset_ranks" value="1" checked>
+					Clear redraft ranks and bye weeks for the new season's data (dynasty ranks carry over)
+				</label>
+				<button type="submit" class="w-full px-6 py-3 bg-tokyo-night-accent hover:bg-tokyo-night-accent-hover text-white rounded-lg font-semibold transition-colors">
+					Roll Over
+				</button>
+			</form>
+		</div>
+	`, current, current+1, current+1))
+
+	renderTemplate(w, content.String(), "Seasons")
+}
+
+// RolloverSeason archives the current season and starts the given one
+func (h *Handler) RolloverSeason(w http.ResponseWriter, r *http.Request) {
+	year, err := strconv.Atoi(r.FormValue("year"))
+	if err != nil {
+		http.Error(w, "Invalid season", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.seasonRepo.Rollover(year, r.FormValue("reset_ranks") == "1"); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	http.Redirect(w, r, "/seasons", http.StatusSeeOther)
+}
@@ -0,0 +1,553 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/vibes/draft-board/internal/consensus"
+	"github.com/vibes/draft-board/internal/importer"
+	"github.com/vibes/draft-board/internal/models"
+)
+
+// consensusPageSize is how many players the consensus page lists.
+const consensusPageSize = 300
+
+// rankFormatLabels names the rank formats for display.
+var rankFormatLabels = map[string]string{
+	models.FormatPPR:     "PPR",
+	models.FormatHalfPPR: "Half-PPR",
+	models.FormatStd:     "Standard",
+	models.FormatDynasty: "Dynasty",
+	models.FormatSF:      "Superflex",
+}
+
+// sourceLabel describes a ranking source key from draftRanks.
+func (h *Handler) sourceLabel(key string) string {
+	parts := strings.Split(key, ":")
+	switch {
+	case parts[0] == "draft":
+		return "this draft's custom rankings"
+	case parts[0] == "consensus" && len(parts) == 2:
+		return fmt.Sprintf("the %s consensus of all ranking sources", rankFormatLabels[parts[1]])
+	case parts[0] == "consensus" && len(parts) == 3:
+		return fmt.Sprintf("the %s consensus of the %s ranking sources", rankFormatLabels[parts[1]], parts[2])
+	case parts[0] == "source" && len(parts) == 3:
+		name := "a ranking source"
+		if id, err := strconv.Atoi(parts[1]); err == nil {
+			if source, err := h.rankSourceRepo.GetByID(id); err == nil {
+				name = template.HTMLEscapeString(source.Name)
+			}
+		}
+		return fmt.Sprintf("%s %s rankings", name, rankFormatLabels[parts[2]])
+	case len(parts) == 2:
+		return fmt.Sprintf("the built-in %s rankings for %s", rankFormatLabels[parts[0]], parts[1])
+	default:
+		return fmt.Sprintf("the built-in %s rankings", rankFormatLabels[key])
+	}
+}
+
+// consensusFormat reads the format query parameter, defaulting to PPR.
+func consensusFormat(r *http.Request) string {
+	format := r.URL.Query().Get("format")
+	if _, ok := rankFormatLabels[format]; !ok {
+		return models.FormatPPR
+	}
+	return format
+}
+
+// consensusSeason reads the season query parameter, defaulting to the
+// current season.
+func (h *Handler) consensusSeason(r *http.Request) (int, error) {
+	if season, err := strconv.Atoi(r.URL.Query().Get("season")); err == nil {
+		return season, nil
+	}
+	return h.seasonRepo.Current()
+}
+
+// GetRankingSources lists the named ranking sources with an upload form
+func (h *Handler) GetRankingSources(w http.ResponseWriter, r *http.Request) {
+	sources, err := h.rankSourceRepo.List()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	counts, err := h.rankSourceRepo.RankCounts()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	current, err := h.seasonRepo.Current()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	inputClass := "w-full px-4 py-2 bg-tokyo-night-bg border border-tokyo-night-border rounded-lg text-tokyo-night-fg focus:outline-none focus:border-tokyo-night-accent"
+	var content strings.Builder
+	content.WriteString(`
+		<div class="mb-8">
+			<a href="/" class="text-tokyo-night-fg-dim hover:text-tokyo-night-accent mb-4 inline-block">← Back</a>
+			<h1 class="text-4xl font-bold mb-2 text-tokyo-night-accent">Ranking Sources</h1>
+			<p class="text-tokyo-night-fg-dim">Named rankings from experts or your own sheets. Drafts can use one source or the consensus of their season's sources for ADP. Sources saved from historical ADP are left out of the consensus.</p>
+		</div>
+		<div class="mb-6 flex flex-wrap gap-2">
+	`)
+	for _, format := range models.RankFormats {
+		content.WriteString(fmt.Sprintf(`
+			<a href="/players/rankings/consensus?format=%s&season=%d" class="px-4 py-2 bg-tokyo-night-bg-light hover:bg-tokyo-night-bg border border-tokyo-night-border rounded-lg transition-colors">
+				%d %s Consensus
+			</a>
+		`, format, current, current, rankFormatLabels[format]))
+	}
+	content.WriteString(`
+			<a href="/players/adp" class="px-4 py-2 bg-tokyo-night-bg-light hover:bg-tokyo-night-bg border border-tokyo-night-border rounded-lg transition-colors">
+				Historical ADP
+			</a>
+		</div>
+	`)
+
+	content.WriteString(`
+		<div class="overflow-x-auto mb-8">
+			<table class="w-full border-collapse bg-tokyo-night-bg-light rounded-lg overflow-hidden">
+				<thead>
+					<tr class="bg-tokyo-night-bg-dark">
+						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">Source</th>
+						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">Season</th>
+	`)
+	for _, format := range models.RankFormats {
+		content.WriteString(fmt.Sprintf(`<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">%s</th>`, rankFormatLabels[format]))
+	}
+	content.WriteString(`
+						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">Actions</th>
+					</tr>
+				</thead>
+				<tbody>
+	`)
+	for _, source := range sources {
+		content.WriteString(fmt.Sprintf(`
+			<tr class="hover:bg-tokyo-night-bg-dark transition-colors">
+				<td class="px-4 py-2 border-b border-tokyo-night-border font-medium text-tokyo-night-fg">%s%s</td>
+				<td class="px-4 py-2 border-b border-tokyo-night-border text-tokyo-night-fg-dim">%d</td>
+		`, template.HTMLEscapeString(source.Name), sourceKindBadge(source), source.Season))
+		for _, format := range models.RankFormats {
+			count := "-"
+			if n := counts[source.ID][format]; n > 0 {
+				count = strconv.Itoa(n)
+			}
+			content.WriteString(fmt.Sprintf(`<td class="px-4 py-2 border-b border-tokyo-night-border text-tokyo-night-fg-dim">%s</td>`, count))
+		}
+		content.WriteString(fmt.Sprintf(`
+				<td class="px-4 py-2 border-b border-tokyo-night-border">
+					<form method="POST" action="/players/rankings/%d/import" enctype="multipart/form-data" class="inline-flex items-center gap-2">
+						<input type="file" name="file" accept=".csv" required class="text-sm text-tokyo-night-fg-dim w-48">
+						<select name="format" class="px-2 py-1 bg-tokyo-night-bg border border-tokyo-night-border rounded text-sm text-tokyo-night-fg">%s</select>
+						<button type="submit" class="px-3 py-1 bg-tokyo-night-accent hover:bg-tokyo-night-accent-hover text-white rounded text-sm font-semibold transition-colors">Upload</button>
+					</form>
+					<form method="POST" action="/players/rankings/%d/delete" class="inline" onsubmit="return confirm('Delete this ranking source?')">
+						<button type="submit" class="ml-2 px-3 py-1 text-sm text-tokyo-night-error hover:underline">Delete</button>
+					</form>
+				</td>
+			</tr>
+		`, source.ID, rankFormatOptions(), source.ID))
+	}
+	if len(sources) == 0 {
+		content.WriteString(fmt.Sprintf(`<tr><td colspan="%d" class="px-4 py-8 text-center text-tokyo-night-fg-dim">No ranking sources yet</td></tr>`, len(models.RankFormats)+3))
+	}
+	content.WriteString(`</tbody></table></div>`)
+
+	content.WriteString(fmt.Sprintf(`
+		<div class="max-w-2xl bg-tokyo-night-bg-light rounded-lg border border-tokyo-night-border p-6">
+			<h2 class="text-2xl font-semibold mb-4 text-tokyo-night-fg">Add Source</h2>
+			<form method="POST" action="/players/rankings" enctype="multipart/form-data" class="space-y-4">
+				<input type="text" name="name" placeholder="Source name" required class="%s">
+				<div>
+					<label class="block text-sm font-medium mb-2 text-tokyo-night-fg">Season</label>
+					<input type="number" name="season" value="%d" min="2000" max="2100" required class="%s">
+				</div>
+				<input type="file" name="file" accept=".csv" required class="%s">
+				<div>
+					<label class="block text-sm font-medium mb-2 text-tokyo-night-fg">Format of the rank column</label>
+					<select name="format" class="%s">%s</select>
+				</div>
+				<p class="text-sm text-tokyo-night-fg-dim">
+					Columns: <code>player_id</code> or <code>name</code>, optional <code>team</code> and <code>position</code>, then
+					<code>rank</code> (or row order) for the format above, or any of
+					<code>ppr_rank, half_ppr_rank, std_rank, dynasty_rank, sf_rank</code> to load several formats at once.
+				</p>
+				<button type="submit" class="w-full px-6 py-3 bg-tokyo-night-accent hover:bg-tokyo-night-accent-hover text-white rounded-lg font-semibold transition-colors">
+					Add Source
+				</button>
+			</form>
+		</div>
+	`, inputClass, current, inputClass, inputClass, inputClass, rankFormatOptions()))
+
+	renderTemplate(w, content.String(), "Ranking Sources")
+}
+
+func rankFormatOptions() string {
+	var options strings.Builder
+	for _, format := range models.RankFormats {
+		options.WriteString(fmt.Sprintf(`<option value="%s">%s</option>`, format, rankFormatLabels[format]))
+	}
+	return options.String()
+}
+
+// CreateRankingSource adds a named source and loads its ranks from a CSV
+func (h *Handler) CreateRankingSource(w http.ResponseWriter, r *http.Request) {
+	name := strings.TrimSpace(r.FormValue("name"))
+	if name == "" {
+		http.Error(w, "Source name is required", http.StatusBadRequest)
+		return
+	}
+
+	season, err := strconv.Atoi(r.FormValue("season"))
+	if err != nil {
+		http.Error(w, "Invalid season", http.StatusBadRequest)
+		return
+	}
+
+	rows, ok := parseSourceUpload(w, r)
+	if !ok {
+		return
+	}
+
+	source := &models.RankingSource{Name: name, Season: season}
+	if err := h.rankSourceRepo.Create(source); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	h.loadSourceRanks(w, r, source, rows)
+}
+
+// ImportRankingSource replaces a source's ranks in the uploaded formats
+func (h *Handler) ImportRankingSource(w http.ResponseWriter, r *http.Request) {
+	sourceID, err := strconv.Atoi(chi.URLParam(r, "sourceId"))
+	if err != nil {
+		http.Error(w, "Invalid source ID", http.StatusBadRequest)
+		return
+	}
+
+	source, err := h.rankSourceRepo.GetByID(sourceID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	rows, ok := parseSourceUpload(w, r)
+	if !ok {
+		return
+	}
+	h.loadSourceRanks(w, r, source, rows)
+}
+
+// parseSourceUpload reads the uploaded rankings CSV, writing the error
+// response itself when the upload is unusable.
+func parseSourceUpload(w http.ResponseWriter, r *http.Request) ([]importer.RankingRow, bool) {
+	file, _, err := r.FormFile("file")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return nil, false
+	}
+	defer file.Close()
+
+	rows, err := importer.ParseRankingsCSV(file)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return nil, false
+	}
+	return rows, true
+}
+
+// loadSourceRanks matches the uploaded rows to players, stores them as the
+// source's ranks and shows what didn't match.
+func (h *Handler) loadSourceRanks(w http.ResponseWriter, r *http.Request, source *models.RankingSource, rows []importer.RankingRow) {
+	format := r.FormValue("format")
+	if _, ok := rankFormatLabels[format]; !ok {
+		format = models.FormatPPR
+	}
+
+	players, err := h.playerRepo.List()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	matcher := importer.NewMatcher(players).WithTeams(h.nflTeams())
+
+	var ranks []models.SourceRank
+	var unmatched []string
+	formats := make(map[string]bool)
+	seen := make(map[string]bool)
+	for _, row := range rows {
+		player := matcher.Match(row.PlayerID, row.Name, row.Position, row.Team)
+		if player == nil {
+			label := row.Name
+			if label == "" {
+				label = fmt.Sprintf("player #%d", row.PlayerID)
+			}
+			unmatched = append(unmatched, label)
+			continue
+		}
+
+		rowRanks := row.Formats
+		if len(rowRanks) == 0 {
+			rowRanks = map[string]int{format: row.Rank}
+		}
+		for f, rank := range rowRanks {
+			key := fmt.Sprintf("%d:%s", player.ID, f)
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			formats[f] = true
+			ranks = append(ranks, models.SourceRank{SourceID: source.ID, PlayerID: player.ID, Format: f, Rank: rank})
+		}
+	}
+
+	if err := h.rankSourceRepo.ReplaceRanks(source.ID, ranks); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var loaded []string
+	for _, f := range models.RankFormats {
+		if formats[f] {
+			loaded = append(loaded, rankFormatLabels[f])
+		}
+	}
+
+	var content strings.Builder
+	content.WriteString(fmt.Sprintf(`
+		<div class="max-w-2xl mx-auto">
+			<div class="mb-8">
+				<a href="/players/rankings" class="text-tokyo-night-fg-dim hover:text-tokyo-night-accent mb-4 inline-block">← Back to Ranking Sources</a>
+				<h1 class="text-4xl font-bold mb-2 text-tokyo-night-accent">Rankings Loaded</h1>
+				<p class="text-tokyo-night-fg-dim">%s: %d of %d rows matched a player (%s).</p>
+			</div>
+	`, template.HTMLEscapeString(source.Name), len(rows)-len(unmatched), len(rows), strings.Join(loaded, ", ")))
+	if len(unmatched) > 0 {
+		content.WriteString(`
+			<div class="bg-tokyo-night-bg-light rounded-lg border border-tokyo-night-border p-6">
+				<h2 class="text-xl font-semibold mb-4 text-tokyo-night-warning">Unmatched rows</h2>
+				<ul class="space-y-1 text-sm text-tokyo-night-fg-dim">
+		`)
+		for _, name := range unmatched {
+			content.WriteString(`<li>` + template.HTMLEscapeString(name) + `</li>`)
+		}
+		content.WriteString(`</ul></div>`)
+	}
+	content.WriteString(`</div>`)
+
+	renderTemplate(w, content.String(), "Rankings Loaded")
+}
+
+// DeleteRankingSource removes a source, its ranks and its stored tiers
+func (h *Handler) DeleteRankingSource(w http.ResponseWriter, r *http.Request) {
+	sourceID, err := strconv.Atoi(chi.URLParam(r, "sourceId"))
+	if err != nil {
+		http.Error(w, "Invalid source ID", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.rankSourceRepo.Delete(sourceID); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	for _, format := range models.RankFormats {
+		h.tierRepo.DeleteForSource(sourceTierSource(sourceID, format))
+	}
+
+	http.Redirect(w, r, "/players/rankings", http.StatusSeeOther)
+}
+
+// GetConsensusRankings shows the consensus of a season's sources for one format
+func (h *Handler) GetConsensusRankings(w http.ResponseWriter, r *http.Request) {
+	format := consensusFormat(r)
+	season, err := h.consensusSeason(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	ranks, err := h.rankSourceRepo.GetRanksByFormat(format, season)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	stats := consensus.Build(ranks)
+	if len(stats) > consensusPageSize {
+		stats = stats[:consensusPageSize]
+	}
+
+	var content strings.Builder
+	content.WriteString(fmt.Sprintf(`
+		<div class="mb-8">
+			<a href="/players/rankings" class="text-tokyo-night-fg-dim hover:text-tokyo-night-accent mb-4 inline-block">← Back to Ranking Sources</a>
+			<h1 class="text-4xl font-bold mb-2 text-tokyo-night-accent">%d %s Consensus</h1>
+			<p class="text-tokyo-night-fg-dim">Players ordered by their mean rank across every source for the season that ranks them</p>
+		</div>
+		<div class="mb-6 flex flex-wrap gap-2">
+	`, season, rankFormatLabels[format]))
+	for _, f := range models.RankFormats {
+		class := "bg-tokyo-night-bg-light hover:bg-tokyo-night-bg border border-tokyo-night-border"
+		if f == format {
+			class = "bg-tokyo-night-accent text-white"
+		}
+		content.WriteString(fmt.Sprintf(`<a href="/players/rankings/consensus?format=%s&season=%d" class="px-4 py-2 rounded-lg transition-colors %s">%s</a>`,
+			f, season, class, rankFormatLabels[f]))
+	}
+	content.WriteString(fmt.Sprintf(`
+		<a href="/players/rankings/consensus/json?format=%s&season=%d" class="px-4 py-2 bg-tokyo-night-bg-light hover:bg-tokyo-night-bg border border-tokyo-night-border rounded-lg transition-colors">JSON</a>
+		</div>
+	`, format, season))
+
+	content.WriteString(`
+		<div class="overflow-x-auto">
+			<table class="w-full border-collapse bg-tokyo-night-bg-light rounded-lg overflow-hidden">
+				<thead>
+					<tr class="bg-tokyo-night-bg-dark">
+						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">Rank</th>
+						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">Player</th>
+						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">Avg</th>
+						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">Std Dev</th>
+						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">Best</th>
+						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">Worst</th>
+						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">Sources</th>
+					</tr>
+				</thead>
+				<tbody>
+	`)
+	playerRepo := h.players(&models.Draft{Season: season})
+	for _, stat := range stats {
+		player, err := playerRepo.GetByID(stat.PlayerID)
+		if err != nil {
+			continue
+		}
+		content.WriteString(fmt.Sprintf(`
+			<tr class="hover:bg-tokyo-night-bg-dark transition-colors">
+				<td class="px-4 py-2 border-b border-tokyo-night-border font-medium text-tokyo-night-fg">%d</td>
+				<td class="px-4 py-2 border-b border-tokyo-night-border">%s <span class="font-medium text-tokyo-night-fg">%s</span> <span class="text-sm text-tokyo-night-fg-dim">%s</span></td>
+				<td class="px-4 py-2 border-b border-tokyo-night-border text-tokyo-night-fg">%.1f</td>
+				<td class="px-4 py-2 border-b border-tokyo-night-border text-tokyo-night-fg-dim">%.1f</td>
+				<td class="px-4 py-2 border-b border-tokyo-night-border text-tokyo-night-success">%d</td>
+				<td class="px-4 py-2 border-b border-tokyo-night-border text-tokyo-night-error">%d</td>
+				<td class="px-4 py-2 border-b border-tokyo-night-border text-tokyo-night-fg-dim">%d</td>
+			</tr>
+		`, stat.Rank, getPlayerPositionBadges(player), template.HTMLEscapeString(player.Name), player.Team,
+			stat.Mean, stat.StdDev, stat.Best, stat.Worst, stat.Sources))
+	}
+	if len(stats) == 0 {
+		content.WriteString(`<tr><td colspan="7" class="px-4 py-8 text-center text-tokyo-night-fg-dim">No sources rank this format yet</td></tr>`)
+	}
+	content.WriteString(`</tbody></table></div>`)
+
+	renderTemplate(w, content.String(), rankFormatLabels[format]+" Consensus")
+}
+
+// GetConsensusRankingsJSON returns a season's consensus for one format as JSON
+func (h *Handler) GetConsensusRankingsJSON(w http.ResponseWriter, r *http.Request) {
+	season, err := h.consensusSeason(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	ranks, err := h.rankSourceRepo.GetRanksByFormat(consensusFormat(r), season)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(consensus.Build(ranks))
+}
+
+// UpdateDraftRankSource sets which ranking source a draft uses for ADP
+func (h *Handler) UpdateDraftRankSource(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	draftID, err := strconv.Atoi(idStr)
+	if err != nil {
+		http.Error(w, "Invalid draft ID", http.StatusBadRequest)
+		return
+	}
+
+	if _, err := h.draftRepo.GetByID(draftID); err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	switch value := r.FormValue("source"); value {
+	case "":
+		err = h.rankSourceRepo.ClearDraftSource(draftID)
+	case "consensus":
+		err = h.rankSourceRepo.SaveDraftSource(&models.DraftRankSource{DraftID: draftID, Consensus: true})
+	default:
+		sourceID, convErr := strconv.Atoi(value)
+		if convErr != nil {
+			http.Error(w, "Invalid source ID", http.StatusBadRequest)
+			return
+		}
+		if _, err := h.rankSourceRepo.GetByID(sourceID); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		err = h.rankSourceRepo.SaveDraftSource(&models.DraftRankSource{DraftID: draftID, SourceID: &sourceID})
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	http.Redirect(w, r, fmt.Sprintf("/draft/%d/setup", draftID), http.StatusSeeOther)
+}
+
+// rankSourceForm renders the ADP source picker shown on the setup page.
+func (h *Handler) rankSourceForm(draft *models.Draft) string {
+	sources, _ := h.rankSourceRepo.ListBySeason(draft.Season)
+	selection, _ := h.rankSourceRepo.GetDraftSource(draft.ID)
+
+	selected := func(match bool) string {
+		if match {
+			return "selected"
+		}
+		return ""
+	}
+	var options strings.Builder
+	options.WriteString(fmt.Sprintf(`<option value="" %s>Built-in rankings</option>`, selected(selection == nil)))
+	options.WriteString(fmt.Sprintf(`<option value="consensus" %s>Consensus of %d sources</option>`,
+		selected(selection != nil && selection.Consensus), draft.Season))
+	for _, source := range sources {
+		isSelected := selection != nil && selection.SourceID != nil && *selection.SourceID == source.ID
+		options.WriteString(fmt.Sprintf(`<option value="%d" %s>%s</option>`,
+			source.ID, selected(isSelected), template.HTMLEscapeString(source.Name)))
+	}
+
+	source, _ := h.draftRanks(draft)
+	return fmt.Sprintf(`
+		<div class="mt-8 bg-tokyo-night-bg-light rounded-lg border border-tokyo-night-border p-6">
+			<h2 class="text-2xl font-semibold mb-4 text-tokyo-night-fg">ADP Source</h2>
+			<p class="text-sm text-tokyo-night-fg-dim mb-4">Currently using %s. Custom rankings, when set, take precedence.</p>
+			<form method="POST" action="/draft/%d/rank-source" class="flex flex-wrap items-end gap-4">
+				<select name="source" class="px-4 py-2 bg-tokyo-night-bg border border-tokyo-night-border rounded-lg text-tokyo-night-fg focus:outline-none focus:border-tokyo-night-accent">
+					%s
+				</select>
+				<button type="submit"
+					class="px-4 py-2 bg-tokyo-night-accent hover:bg-tokyo-night-accent-hover text-white rounded-lg font-semibold transition-colors">
+					Save Source
+				</button>
+				<a href="/players/rankings" class="px-4 py-2 text-tokyo-night-fg-dim hover:text-tokyo-night-accent">Manage sources</a>
+			</form>
+		</div>
+	`, h.sourceLabel(source), draft.ID, options.String())
+}
+
+// sourceKindBadge marks sources saved from historical ADP, which the
+// consensus leaves out.
+func sourceKindBadge(source *models.RankingSource) string {
+	if source.Kind != models.SourceKindADP {
+		return ""
+	}
+	return ` <span class="px-1.5 py-0.5 rounded text-xs font-semibold border bg-gray-500/20 text-gray-300 border-gray-500/50" title="Not counted in the consensus">ADP</span>`
+}
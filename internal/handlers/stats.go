@@ -4,10 +4,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"sort"
 	"strconv"
 	"strings"
 
 	"github.com/go-chi/chi/v5"
+	"github.com/vibes/draft-board/internal/models"
+	"github.com/vibes/draft-board/internal/nfl"
+	"github.com/vibes/draft-board/internal/sport"
 )
 
 // StreamUpdates implements Server-Sent Events for real-time draft updates
@@ -64,7 +68,7 @@ func (h *Handler) StreamUpdates(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetFranchiseStats shows players drafted by NFL team
+// GetFranchiseStats shows players drafted by pro team
 func (h *Handler) GetFranchiseStats(w http.ResponseWriter, r *http.Request) {
 	idStr := chi.URLParam(r, "id")
 	draftID, err := strconv.Atoi(idStr)
@@ -73,55 +77,24 @@ func (h *Handler) GetFranchiseStats(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	picks, err := h.pickRepo.GetByDraft(draftID)
+	draft, err := h.draftRepo.GetByID(draftID)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), http.StatusNotFound)
 		return
 	}
 
-	type FranchiseStat struct {
-		TeamAbbr    string
-		TotalCount  int
-		QBCount     int
-		RBCount     int
-		WRCount     int
-		TECount     int
-		KCount      int
-		DSTCount    int
-		OtherCount  int
+	picks, err := h.pickRepo.GetByDraft(draftID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
 	}
 
-	stats := make(map[string]*FranchiseStat)
-
-	for _, pick := range picks {
-		player, err := h.playerRepo.GetByID(pick.PlayerID)
-		if err != nil {
-			continue
-		}
-
-		if stats[player.Team] == nil {
-			stats[player.Team] = &FranchiseStat{TeamAbbr: player.Team}
-		}
-
-		stat := stats[player.Team]
-		stat.TotalCount++
+	stats, positions := h.franchiseStats(draft, picks)
+	profile := sport.Get(draft.Sport)
 
-		switch player.Position {
-		case "QB":
-			stat.QBCount++
-		case "RB":
-			stat.RBCount++
-		case "WR":
-			stat.WRCount++
-		case "TE":
-			stat.TECount++
-		case "K":
-			stat.KCount++
-		case "D/ST":
-			stat.DSTCount++
-		default:
-			stat.OtherCount++
-		}
+	title := "Stats by Team"
+	if profile.Key == sport.Football {
+		title = "Stats by NFL Franchise"
 	}
 
 	w.Header().Set("Content-Type", "text/html")
@@ -129,20 +102,20 @@ func (h *Handler) GetFranchiseStats(w http.ResponseWriter, r *http.Request) {
 	content.WriteString(`
 		<div class="mb-8">
 			<a href="/draft/` + fmt.Sprintf("%d", draftID) + `" class="text-tokyo-night-fg-dim hover:text-tokyo-night-accent mb-4 inline-block">← Back to Draft</a>
-			<h1 class="text-4xl font-bold mb-2 text-tokyo-night-accent">Stats by NFL Franchise</h1>
+			<h1 class="text-4xl font-bold mb-2 text-tokyo-night-accent">` + title + `</h1>
 		</div>
 		<div class="overflow-x-auto">
 			<table class="w-full border-collapse bg-tokyo-night-bg-light rounded-lg overflow-hidden">
 				<thead>
 					<tr class="bg-tokyo-night-bg-dark">
 						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">Team</th>
+						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">Division</th>
 						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">Total</th>
-						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">QB</th>
-						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">RB</th>
-						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">WR</th>
-						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">TE</th>
-						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">K</th>
-						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">D/ST</th>
+	`)
+	for _, pos := range positions {
+		content.WriteString(`<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">` + pos + `</th>`)
+	}
+	content.WriteString(`
 						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">Other</th>
 					</tr>
 				</thead>
@@ -150,25 +123,97 @@ func (h *Handler) GetFranchiseStats(w http.ResponseWriter, r *http.Request) {
 	`)
 
 	for _, stat := range stats {
+		name, division := "", ""
+		if stat.Team != nil {
+			name, division = stat.Team.Name, stat.Team.DivisionName()
+		}
 		content.WriteString(fmt.Sprintf(`
 			<tr class="hover:bg-tokyo-night-bg-dark transition-colors">
-				<td class="px-4 py-2 border-b border-tokyo-night-border font-medium text-tokyo-night-fg">%s</td>
+				<td class="px-4 py-2 border-b border-tokyo-night-border font-medium text-tokyo-night-fg">%s <span class="text-sm font-normal text-tokyo-night-fg-dim">%s</span></td>
+				<td class="px-4 py-2 border-b border-tokyo-night-border text-tokyo-night-fg-dim">%s</td>
 				<td class="px-4 py-2 border-b border-tokyo-night-border text-tokyo-night-fg">%d</td>
-				<td class="px-4 py-2 border-b border-tokyo-night-border text-tokyo-night-fg-dim">%d</td>
-				<td class="px-4 py-2 border-b border-tokyo-night-border text-tokyo-night-fg-dim">%d</td>
-				<td class="px-4 py-2 border-b border-tokyo-night-border text-tokyo-night-fg-dim">%d</td>
-				<td class="px-4 py-2 border-b border-tokyo-night-border text-tokyo-night-fg-dim">%d</td>
-				<td class="px-4 py-2 border-b border-tokyo-night-border text-tokyo-night-fg-dim">%d</td>
-				<td class="px-4 py-2 border-b border-tokyo-night-border text-tokyo-night-fg-dim">%d</td>
+		`, stat.TeamAbbr, name, division, stat.TotalCount))
+		for _, pos := range positions {
+			content.WriteString(fmt.Sprintf(`<td class="px-4 py-2 border-b border-tokyo-night-border text-tokyo-night-fg-dim">%d</td>`, stat.Counts[pos]))
+		}
+		content.WriteString(fmt.Sprintf(`
 				<td class="px-4 py-2 border-b border-tokyo-night-border text-tokyo-night-fg-dim">%d</td>
 			</tr>
-		`, stat.TeamAbbr, stat.TotalCount, stat.QBCount, stat.RBCount, stat.WRCount, stat.TECount, stat.KCount, stat.DSTCount, stat.OtherCount))
+		`, stat.OtherCount))
 	}
 
 	content.WriteString(`</tbody></table></div>`)
 	renderTemplate(w, content.String(), "Stats by Franchise")
 }
 
+// franchiseStat counts the players drafted from one pro team.
+type franchiseStat struct {
+	TeamAbbr   string
+	Team       *models.NFLTeam
+	TotalCount int
+	Counts     map[string]int
+	OtherCount int
+}
+
+// franchiseStats counts a draft's picks by the pro team of the player
+// picked, most drafted first, and returns the positions to break the
+// counts down by.
+func (h *Handler) franchiseStats(draft *models.Draft, picks []models.Pick) ([]*franchiseStat, []string) {
+	stats := make(map[string]*franchiseStat)
+	drafted := make(map[string]bool)
+
+	profile := sport.Get(draft.Sport)
+	playerRepo := h.players(draft)
+	// Only football teams are in the NFL teams table; other sports go by
+	// the abbreviation as entered.
+	var teams *nfl.Index
+	if profile.Key == sport.Football {
+		teams = h.nflTeams()
+	}
+	for _, pick := range picks {
+		player, err := playerRepo.GetByID(pick.PlayerID)
+		if err != nil {
+			continue
+		}
+
+		abbr := teams.Canonical(player.Team)
+		if stats[abbr] == nil {
+			stats[abbr] = &franchiseStat{TeamAbbr: abbr, Team: teams.Lookup(abbr), Counts: make(map[string]int)}
+		}
+
+		stat := stats[abbr]
+		stat.TotalCount++
+		drafted[player.Position] = true
+
+		if profile.HasPosition(player.Position) {
+			stat.Counts[player.Position]++
+		} else {
+			stat.OtherCount++
+		}
+	}
+
+	// Optional positions such as IDP only get a column once one is drafted.
+	var positions []string
+	for _, pos := range profile.PositionAbbrs() {
+		if profile.IsOptional(pos) && !drafted[pos] {
+			continue
+		}
+		positions = append(positions, pos)
+	}
+
+	sorted := make([]*franchiseStat, 0, len(stats))
+	for _, stat := range stats {
+		sorted = append(sorted, stat)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].TotalCount != sorted[j].TotalCount {
+			return sorted[i].TotalCount > sorted[j].TotalCount
+		}
+		return sorted[i].TeamAbbr < sorted[j].TeamAbbr
+	})
+	return sorted, positions
+}
+
 // GetDraftedByPosition shows players drafted organized by position
 func (h *Handler) GetDraftedByPosition(w http.ResponseWriter, r *http.Request) {
 	idStr := chi.URLParam(r, "id")
@@ -178,6 +223,12 @@ func (h *Handler) GetDraftedByPosition(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	draft, err := h.draftRepo.GetByID(draftID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
 	picks, err := h.pickRepo.GetByDraft(draftID)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -193,8 +244,9 @@ func (h *Handler) GetDraftedByPosition(w http.ResponseWriter, r *http.Request) {
 
 	byPosition := make(map[string][]DraftedPlayer)
 
+	playerRepo := h.players(draft)
 	for _, pick := range picks {
-		player, err := h.playerRepo.GetByID(pick.PlayerID)
+		player, err := playerRepo.GetByID(pick.PlayerID)
 		if err != nil {
 			continue
 		}
@@ -208,7 +260,7 @@ func (h *Handler) GetDraftedByPosition(w http.ResponseWriter, r *http.Request) {
 			PlayerName:  player.Name,
 			TeamName:    team.TeamName,
 			OverallPick: pick.OverallPick,
-			Position:    player.Position,
+			Position:    player.PositionLabel(),
 		})
 	}
 
@@ -222,9 +274,14 @@ func (h *Handler) GetDraftedByPosition(w http.ResponseWriter, r *http.Request) {
 		<div class="grid md:grid-cols-2 lg:grid-cols-3 gap-6">
 	`)
 
-	positions := []string{"QB", "RB", "WR", "TE", "K", "D/ST"}
-	for _, pos := range positions {
+	profile := sport.Get(draft.Sport)
+	for _, pos := range profile.PositionAbbrs() {
 		players := byPosition[pos]
+		// Cards for optional positions such as IDP only show in drafts
+		// that took one.
+		if profile.IsOptional(pos) && len(players) == 0 {
+			continue
+		}
 		content.WriteString(fmt.Sprintf(`
 			<div class="bg-tokyo-night-bg-light rounded-lg border border-tokyo-night-border p-6">
 				<h2 class="text-2xl font-semibold mb-4 text-tokyo-night-fg">%s (%d)</h2>
@@ -236,7 +293,7 @@ func (h *Handler) GetDraftedByPosition(w http.ResponseWriter, r *http.Request) {
 					<div class="font-medium text-tokyo-night-fg">%s</div>
 					<div class="text-sm text-tokyo-night-fg-dim">%s - Pick %d</div>
 				</div>
-			`, p.PlayerName, p.TeamName, p.OverallPick))
+			`, p.PlayerName+positionSuffix(p.Position, pos), p.TeamName, p.OverallPick))
 		}
 		if len(players) == 0 {
 			content.WriteString(`<p class="text-tokyo-night-fg-dim">No players drafted</p>`)
@@ -248,6 +305,15 @@ func (h *Handler) GetDraftedByPosition(w http.ResponseWriter, r *http.Request) {
 	renderTemplate(w, content.String(), "Drafted by Position")
 }
 
+// positionSuffix notes a multi-position player's eligibility next to their
+// name when listed under their primary position.
+func positionSuffix(label, position string) string {
+	if label == position {
+		return ""
+	}
+	return " (" + label + ")"
+}
+
 // GetValuePicks shows steals and reaches based on ADP
 func (h *Handler) GetValuePicks(w http.ResponseWriter, r *http.Request) {
 	idStr := chi.URLParam(r, "id")
@@ -257,7 +323,7 @@ func (h *Handler) GetValuePicks(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	_, err = h.draftRepo.GetByID(draftID)
+	draft, err := h.draftRepo.GetByID(draftID)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusNotFound)
 		return
@@ -281,8 +347,9 @@ func (h *Handler) GetValuePicks(w http.ResponseWriter, r *http.Request) {
 	var steals, reaches, allPicks []ValuePick
 	picksWithoutADP := 0
 
+	playerRepo := h.players(draft)
 	for _, pick := range picks {
-		player, err := h.playerRepo.GetByID(pick.PlayerID)
+		player, err := playerRepo.GetByID(pick.PlayerID)
 		if err != nil {
 			continue
 		}
@@ -512,6 +579,12 @@ func (h *Handler) ExportCSV(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	draft, err := h.draftRepo.GetByID(draftID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
 	picks, err := h.pickRepo.GetByDraft(draftID)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -523,8 +596,9 @@ func (h *Handler) ExportCSV(w http.ResponseWriter, r *http.Request) {
 
 	fmt.Fprintf(w, "Round,Overall Pick,Team,Player,Position,NFL Team,ADP Rank\n")
 
+	playerRepo := h.players(draft)
 	for _, pick := range picks {
-		player, _ := h.playerRepo.GetByID(pick.PlayerID)
+		player, _ := playerRepo.GetByID(pick.PlayerID)
 		team, _ := h.teamRepo.GetByID(pick.TeamID)
 
 		playerName := ""
@@ -532,7 +606,7 @@ func (h *Handler) ExportCSV(w http.ResponseWriter, r *http.Request) {
 		teamAbbr := ""
 		if player != nil {
 			playerName = player.Name
-			position = player.Position
+			position = player.PositionLabel()
 			teamAbbr = player.Team
 		}
 
@@ -574,14 +648,16 @@ func (h *Handler) ExportJSON(w http.ResponseWriter, r *http.Request) {
 		OverallPick int     `json:"overall_pick"`
 		TeamName    string  `json:"team_name"`
 		PlayerName  string  `json:"player_name"`
-		Position    string  `json:"position"`
+		Position    string   `json:"position"`
+		Positions   []string `json:"positions"`
 		NFLTeam     string  `json:"nfl_team"`
 		ADPRank     *int    `json:"adp_rank"`
 	}
 
 	exportPicks := make([]ExportPick, 0, len(picks))
+	playerRepo := h.players(draft)
 	for _, pick := range picks {
-		player, _ := h.playerRepo.GetByID(pick.PlayerID)
+		player, _ := playerRepo.GetByID(pick.PlayerID)
 		team, _ := h.teamRepo.GetByID(pick.TeamID)
 
 		ep := ExportPick{
@@ -597,6 +673,7 @@ func (h *Handler) ExportJSON(w http.ResponseWriter, r *http.Request) {
 		if player != nil {
 			ep.PlayerName = player.Name
 			ep.Position = player.Position
+			ep.Positions = player.Positions()
 			ep.NFLTeam = player.Team
 		}
 
@@ -611,6 +688,8 @@ func (h *Handler) ExportJSON(w http.ResponseWriter, r *http.Request) {
 			"scoring_format": draft.ScoringFormat,
 			"draft_type":     draft.DraftType,
 			"status":         draft.Status,
+			"sport":          draft.Sport,
+			"created_at":     draft.CreatedAt,
 		},
 		"teams": teams,
 		"picks": exportPicks,
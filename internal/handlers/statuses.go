@@ -0,0 +1,274 @@
+package handlers
+
+import (
+	"fmt"
+	"html/template"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/vibes/draft-board/internal/importer"
+	"github.com/vibes/draft-board/internal/models"
+	"github.com/vibes/draft-board/internal/repository"
+)
+
+// statusNames are the display names of the player statuses.
+var statusNames = map[string]string{
+	models.StatusActive:       "Active",
+	models.StatusQuestionable: "Questionable",
+	models.StatusOut:          "Out",
+	models.StatusIR:           "Injured Reserve",
+	models.StatusSuspended:    "Suspended",
+	models.StatusFreeAgent:    "Free Agent",
+	models.StatusRetired:      "Retired",
+}
+
+// statusLabels are the short labels of the status badges.
+var statusLabels = map[string]string{
+	models.StatusQuestionable: "Q",
+	models.StatusOut:          "O",
+	models.StatusIR:           "IR",
+	models.StatusSuspended:    "SUSP",
+	models.StatusFreeAgent:    "FA",
+	models.StatusRetired:      "RET",
+}
+
+// getStatusBadge returns a badge for a player who isn't active, with the
+// status note on hover, or nothing for active players.
+func getStatusBadge(player *models.Player) string {
+	label, ok := statusLabels[player.Status]
+	if !ok {
+		return ""
+	}
+
+	colorClass := "bg-red-500/20 text-red-300 border-red-500/50"
+	switch player.Status {
+	case models.StatusQuestionable:
+		colorClass = "bg-yellow-500/20 text-yellow-300 border-yellow-500/50"
+	case models.StatusFreeAgent, models.StatusRetired:
+		colorClass = "bg-gray-500/20 text-gray-300 border-gray-500/50"
+	}
+
+	title := player.Status
+	if player.StatusNote != "" {
+		title += ": " + player.StatusNote
+	}
+	return fmt.Sprintf(` <span class="px-1.5 py-0.5 rounded text-xs font-semibold border %s" title="%s">%s</span>`,
+		colorClass, template.HTMLEscapeString(title), label)
+}
+
+func statusOptions(current string) string {
+	var options strings.Builder
+	for _, status := range models.PlayerStatuses {
+		selected := ""
+		if status == current {
+			selected = "selected"
+		}
+		options.WriteString(fmt.Sprintf(`<option value="%s" %s>%s</option>`, status, selected, statusNames[status]))
+	}
+	return options.String()
+}
+
+// statusFilterSelect renders the available players filter on status.
+func statusFilterSelect(draftID int, current string) string {
+	options := []struct{ value, label string }{
+		{"", "Any status"},
+		{"healthy", "Active only"},
+		{"injured", "Injured or suspended"},
+	}
+	for _, status := range models.PlayerStatuses {
+		options = append(options, struct{ value, label string }{status, statusNames[status]})
+	}
+
+	var html strings.Builder
+	html.WriteString(fmt.Sprintf(`
+		<div>
+			<label class="block text-sm font-medium mb-2 text-tokyo-night-fg">Status</label>
+			<select name="status" hx-get="/draft/%d/players" hx-trigger="change" hx-target="#players-page-content" hx-select="#players-page-content" hx-push-url="true"
+				hx-include="[name='search'], [name='position'], [name='show_drafted'], [name='tag'], [name='notes'], [name='team'], [name='status']"
+				class="px-3 py-2 bg-tokyo-night-bg-light border border-tokyo-night-border rounded-lg text-tokyo-night-fg focus:outline-none focus:border-tokyo-night-accent">
+	`, draftID))
+	for _, o := range options {
+		selected := ""
+		if o.value == current {
+			selected = "selected"
+		}
+		html.WriteString(fmt.Sprintf(`<option value="%s" %s>%s</option>`, o.value, selected, o.label))
+	}
+	html.WriteString(`</select></div>`)
+	return html.String()
+}
+
+// GetPlayerStatuses lists players who aren't active with a status import form
+func (h *Handler) GetPlayerStatuses(w http.ResponseWriter, r *http.Request) {
+	players, err := h.playerRepo.List()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var content strings.Builder
+	content.WriteString(`
+		<div class="mb-8">
+			<a href="/" class="text-tokyo-night-fg-dim hover:text-tokyo-night-accent mb-4 inline-block">← Back</a>
+			<h1 class="text-4xl font-bold mb-2 text-tokyo-night-accent">Player Status</h1>
+			<p class="text-tokyo-night-fg-dim">Injuries, suspensions and unsigned players. Everyone not listed is active.</p>
+		</div>
+		<div class="overflow-x-auto mb-8">
+			<table class="w-full border-collapse bg-tokyo-night-bg-light rounded-lg overflow-hidden">
+				<thead>
+					<tr class="bg-tokyo-night-bg-dark">
+						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">Player</th>
+						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">Status</th>
+						<th class="px-4 py-3 text-left font-semibold text-tokyo-night-fg border-b border-tokyo-night-border">Updated</th>
+					</tr>
+				</thead>
+				<tbody>
+	`)
+	listed := 0
+	for _, player := range players {
+		if player.Status == models.StatusActive {
+			continue
+		}
+		listed++
+		updated := "-"
+		if player.StatusUpdatedAt != nil {
+			updated = player.StatusUpdatedAt.Format("Jan 2 15:04")
+		}
+		content.WriteString(fmt.Sprintf(`
+			<tr class="hover:bg-tokyo-night-bg-dark transition-colors">
+				<td class="px-4 py-2 border-b border-tokyo-night-border">%s%s <span class="font-medium text-tokyo-night-fg">%s</span> <span class="text-sm text-tokyo-night-fg-dim">%s</span></td>
+				<td class="px-4 py-2 border-b border-tokyo-night-border">
+					<form method="POST" action="/players/%d/status" class="flex flex-wrap items-center gap-2">
+						<select name="status" class="px-2 py-1 bg-tokyo-night-bg border border-tokyo-night-border rounded text-sm text-tokyo-night-fg">%s</select>
+						<input type="text" name="note" value="%s" placeholder="Note" class="px-2 py-1 bg-tokyo-night-bg border border-tokyo-night-border rounded text-sm text-tokyo-night-fg">
+						<button type="submit" class="px-3 py-1 bg-tokyo-night-accent hover:bg-tokyo-night-accent-hover text-white rounded text-sm font-semibold transition-colors">Save</button>
+					</form>
+				</td>
+				<td class="px-4 py-2 border-b border-tokyo-night-border text-sm text-tokyo-night-fg-dim">%s</td>
+			</tr>
+		`, getPlayerPositionBadges(player), getStatusBadge(player), template.HTMLEscapeString(player.Name), player.Team,
+			player.ID, statusOptions(player.Status), template.HTMLEscapeString(player.StatusNote), updated))
+	}
+	if listed == 0 {
+		content.WriteString(`<tr><td colspan="3" class="px-4 py-8 text-center text-tokyo-night-fg-dim">Every player is active</td></tr>`)
+	}
+	content.WriteString(`</tbody></table></div>`)
+
+	content.WriteString(`
+		<div class="max-w-2xl bg-tokyo-night-bg-light rounded-lg border border-tokyo-night-border p-6">
+			<h2 class="text-2xl font-semibold mb-4 text-tokyo-night-fg">Import Statuses</h2>
+			<form method="POST" action="/players/status/import" enctype="multipart/form-data" class="space-y-4">
+				<input type="file" name="file" accept=".csv,.json" required
+					class="w-full px-4 py-2 bg-tokyo-night-bg border border-tokyo-night-border rounded-lg text-tokyo-night-fg">
+				<p class="text-sm text-tokyo-night-fg-dim">
+					CSV columns: <code>player_id</code> or <code>name</code>, optional <code>team</code> and <code>position</code>,
+					<code>status</code> and optional <code>note</code>. JSON takes an array of objects with the same keys.
+					Statuses: active, questionable, out, ir, suspended, free_agent, retired, or report abbreviations like Q, O and IR.
+					Players not in the file keep their status.
+				</p>
+				<button type="submit" class="w-full px-6 py-3 bg-tokyo-night-accent hover:bg-tokyo-night-accent-hover text-white rounded-lg font-semibold transition-colors">
+					Import
+				</button>
+			</form>
+		</div>
+	`)
+
+	renderTemplate(w, content.String(), "Player Status")
+}
+
+// ImportPlayerStatuses sets player statuses from an uploaded CSV or JSON file
+func (h *Handler) ImportPlayerStatuses(w http.ResponseWriter, r *http.Request) {
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	defer file.Close()
+
+	var rows []importer.StatusRow
+	if strings.EqualFold(filepath.Ext(header.Filename), ".json") {
+		rows, err = importer.ParseStatusJSON(file)
+	} else {
+		rows, err = importer.ParseStatusCSV(file)
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	players, err := h.playerRepo.List()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	matcher := importer.NewMatcher(players).WithTeams(h.nflTeams())
+
+	var updates []repository.StatusUpdate
+	var unmatched []string
+	for _, row := range rows {
+		player := matcher.Match(row.PlayerID, row.Name, row.Position, row.Team)
+		if player == nil {
+			label := row.Name
+			if label == "" {
+				label = fmt.Sprintf("player #%d", row.PlayerID)
+			}
+			unmatched = append(unmatched, label)
+			continue
+		}
+		updates = append(updates, repository.StatusUpdate{PlayerID: player.ID, Status: row.Status, Note: row.Note})
+	}
+
+	if err := h.playerRepo.UpdateStatuses(updates); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var content strings.Builder
+	content.WriteString(fmt.Sprintf(`
+		<div class="max-w-2xl mx-auto">
+			<div class="mb-8">
+				<a href="/players/status" class="text-tokyo-night-fg-dim hover:text-tokyo-night-accent mb-4 inline-block">← Back to Player Status</a>
+				<h1 class="text-4xl font-bold mb-2 text-tokyo-night-accent">Statuses Imported</h1>
+				<p class="text-tokyo-night-fg-dim">%d of %d rows matched a player.</p>
+			</div>
+	`, len(updates), len(rows)))
+	if len(unmatched) > 0 {
+		content.WriteString(`
+			<div class="bg-tokyo-night-bg-light rounded-lg border border-tokyo-night-border p-6">
+				<h2 class="text-xl font-semibold mb-4 text-tokyo-night-warning">Unmatched rows</h2>
+				<ul class="space-y-1 text-sm text-tokyo-night-fg-dim">
+		`)
+		for _, name := range unmatched {
+			content.WriteString(`<li>` + template.HTMLEscapeString(name) + `</li>`)
+		}
+		content.WriteString(`</ul></div>`)
+	}
+	content.WriteString(`</div>`)
+
+	renderTemplate(w, content.String(), "Statuses Imported")
+}
+
+// UpdatePlayerStatus sets one player's status
+func (h *Handler) UpdatePlayerStatus(w http.ResponseWriter, r *http.Request) {
+	playerID, err := strconv.Atoi(chi.URLParam(r, "playerId"))
+	if err != nil {
+		http.Error(w, "Invalid player ID", http.StatusBadRequest)
+		return
+	}
+	status, ok := models.ParseStatus(r.FormValue("status"))
+	if !ok {
+		http.Error(w, "Invalid status", http.StatusBadRequest)
+		return
+	}
+
+	update := repository.StatusUpdate{PlayerID: playerID, Status: status, Note: strings.TrimSpace(r.FormValue("note"))}
+	if err := h.playerRepo.UpdateStatuses([]repository.StatusUpdate{update}); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	http.Redirect(w, r, "/players/status", http.StatusSeeOther)
+}
@@ -0,0 +1,373 @@
+package handlers
+
+import (
+	"fmt"
+	"html/template"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/vibes/draft-board/internal/importer"
+	"github.com/vibes/draft-board/internal/models"
+	"github.com/vibes/draft-board/internal/repository"
+	"github.com/vibes/draft-board/internal/sport"
+	"github.com/vibes/draft-board/internal/tiers"
+)
+
+// tierPositions are the positions tiered on the board and tiers page: the
+// draft's sport's positions other than optional ones such as IDP.
+func tierPositions(draft *models.Draft) []string {
+	return sport.Get(draft.Sport).CorePositions()
+}
+
+// tiersPageDepth is how many players per position the tiers page lists.
+const tiersPageDepth = 40
+
+// draftTierSource is the tier source key for a draft's own rankings.
+func draftTierSource(draftID int) string {
+	return fmt.Sprintf("draft:%d", draftID)
+}
+
+// sourceTierSource is the tier source key for one format of a ranking source.
+func sourceTierSource(sourceID int, format string) string {
+	return fmt.Sprintf("source:%d:%s", sourceID, format)
+}
+
+// consensusTierSource is the tier source key for one format of the consensus.
+func consensusTierSource(format string) string {
+	return "consensus:" + format
+}
+
+// rankingSource names the ranks a draft uses for ADP, which is also the key
+// its tiers are stored under.
+func (h *Handler) rankingSource(draft *models.Draft) string {
+	source, _ := h.draftRanks(draft)
+	return source
+}
+
+// computeTiers clusters every ranked player in the draft's ranking source.
+func (h *Handler) computeTiers(draft *models.Draft, source string) ([]models.PlayerTier, error) {
+	players, err := h.players(draft).List()
+	if err != nil {
+		return nil, err
+	}
+	adpRank := h.adpRanker(draft)
+	var ranked []tiers.Ranked
+	for _, player := range players {
+		if rank := adpRank(player); rank != nil {
+			ranked = append(ranked, tiers.Ranked{PlayerID: player.ID, Position: player.Position, Rank: *rank})
+		}
+	}
+	return tiers.Compute(source, ranked), nil
+}
+
+// playerTiers maps player IDs to tiers for the draft's ranking source. Stored
+// tiers win; without them tiers are computed from rank gaps on the fly. The
+// second result reports whether the tiers were stored.
+func (h *Handler) playerTiers(draft *models.Draft) (map[int]int, bool, error) {
+	source := h.rankingSource(draft)
+	list, err := h.tierRepo.GetBySource(source)
+	if err != nil {
+		return nil, false, err
+	}
+	stored := len(list) > 0
+	if !stored {
+		if list, err = h.computeTiers(draft, source); err != nil {
+			return nil, false, err
+		}
+	}
+
+	result := make(map[int]int, len(list))
+	for _, t := range list {
+		result[t.PlayerID] = t.Tier
+	}
+	return result, stored, nil
+}
+
+// tierSeparator renders the row dividing tiers in the available players list.
+func tierSeparator(position string, tier int) string {
+	return fmt.Sprintf(`<tr class="tier-break"><td colspan="6" class="px-4 py-1 bg-tokyo-night-bg-dark border-b border-tokyo-night-border text-xs font-semibold text-tokyo-night-fg-dim">%s Tier %d</td></tr>`,
+		getPositionBadge(position), tier)
+}
+
+// topTierPanel renders how many players are left in each position's best
+// remaining tier.
+func (h *Handler) topTierPanel(draft *models.Draft) string {
+	playerTiers, _, err := h.playerTiers(draft)
+	if err != nil || len(playerTiers) == 0 {
+		return ""
+	}
+	available, err := h.players(draft).GetAvailable(draft.ID, repository.PlayerFilters{
+		DraftType:     draft.DraftType,
+		ScoringFormat: draft.ScoringFormat,
+		Sport:         draft.Sport,
+		RookieSeason:  draft.RookieSeason(),
+	})
+	if err != nil {
+		return ""
+	}
+	remaining := tiers.TopRemaining(tierPositions(draft), available, playerTiers)
+	if len(remaining) == 0 {
+		return ""
+	}
+
+	var panel strings.Builder
+	panel.WriteString(fmt.Sprintf(`
+		<div id="top-tiers" class="mb-6 flex flex-wrap items-center gap-3">
+			<a href="/draft/%d/tiers" class="text-sm text-tokyo-night-fg-dim hover:text-tokyo-night-accent">Top tiers left:</a>
+	`, draft.ID))
+	for _, r := range remaining {
+		countClass := "text-tokyo-night-fg"
+		if r.Count == 1 {
+			countClass = "text-tokyo-night-error"
+		} else if r.Count <= 3 {
+			countClass = "text-tokyo-night-warning"
+		}
+		panel.WriteString(fmt.Sprintf(`
+			<span class="flex items-center gap-2 px-3 py-1 bg-tokyo-night-bg-light border border-tokyo-night-border rounded-lg text-sm">
+				%s <span class="text-tokyo-night-fg-dim">T%d</span> <span class="font-semibold %s">%d left</span>
+			</span>
+		`, getPositionBadge(r.Position), r.Tier, countClass, r.Count))
+	}
+	panel.WriteString(`</div>`)
+	return panel.String()
+}
+
+// GetTiers shows the draft's tiers by position with import and compute tools
+func (h *Handler) GetTiers(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	draftID, err := strconv.Atoi(idStr)
+	if err != nil {
+		http.Error(w, "Invalid draft ID", http.StatusBadRequest)
+		return
+	}
+
+	draft, err := h.draftRepo.GetByID(draftID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	playerTiers, stored, err := h.playerTiers(draft)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	players, err := h.players(draft).GetAvailable(draftID, repository.PlayerFilters{
+		DraftType:      draft.DraftType,
+		ScoringFormat:  draft.ScoringFormat,
+		Sport:          draft.Sport,
+		RookieSeason:   draft.RookieSeason(),
+		IncludeDrafted: true,
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	draftedPlayerIDs, _ := h.pickRepo.GetDraftedPlayerIDs(draftID)
+	drafted := make(map[int]bool, len(draftedPlayerIDs))
+	for _, id := range draftedPlayerIDs {
+		drafted[id] = true
+	}
+
+	source := h.rankingSource(draft)
+	status := "Computed from gaps in the rankings."
+	if stored {
+		status = "Saved tiers."
+	}
+	sourceLabel := h.sourceLabel(source)
+	if source != draftTierSource(draftID) {
+		sourceLabel += ", shared with every draft using them"
+	}
+
+	var content strings.Builder
+	content.WriteString(fmt.Sprintf(`
+		<div class="mb-8">
+			<a href="/draft/%d/setup" class="text-tokyo-night-fg-dim hover:text-tokyo-night-accent mb-4 inline-block">← Back to Setup</a>
+			<h1 class="text-4xl font-bold mb-2 text-tokyo-night-accent">Tiers</h1>
+			<p class="text-tokyo-night-fg-dim">%s Tiers for %s.</p>
+		</div>
+		<div class="grid md:grid-cols-3 gap-6 mb-8">
+			<form method="POST" action="/draft/%d/tiers/compute" class="bg-tokyo-night-bg-light rounded-lg border border-tokyo-night-border p-6">
+				<h2 class="text-xl font-semibold mb-2 text-tokyo-night-fg">Auto-compute</h2>
+				<p class="text-sm text-tokyo-night-fg-dim mb-4">Split each position at its largest rank gaps and save the result.</p>
+				<button type="submit" class="w-full px-4 py-2 bg-tokyo-night-accent hover:bg-tokyo-night-accent-hover text-white rounded-lg font-semibold transition-colors">
+					Compute &amp; Save
+				</button>
+			</form>
+			<form method="POST" action="/draft/%d/tiers/import" enctype="multipart/form-data" class="bg-tokyo-night-bg-light rounded-lg border border-tokyo-night-border p-6">
+				<h2 class="text-xl font-semibold mb-2 text-tokyo-night-fg">Import CSV</h2>
+				<p class="text-sm text-tokyo-night-fg-dim mb-4">Columns: <code>player_id</code> or <code>name</code>, <code>tier</code>, optional <code>team</code>, <code>position</code>.</p>
+				<input type="file" name="file" accept=".csv" required
+					class="w-full mb-4 px-4 py-2 bg-tokyo-night-bg border border-tokyo-night-border rounded-lg text-tokyo-night-fg">
+				<button type="submit" class="w-full px-4 py-2 bg-tokyo-night-accent hover:bg-tokyo-night-accent-hover text-white rounded-lg font-semibold transition-colors">
+					Import
+				</button>
+			</form>
+			<form method="POST" action="/draft/%d/tiers/reset" class="bg-tokyo-night-bg-light rounded-lg border border-tokyo-night-border p-6">
+				<h2 class="text-xl font-semibold mb-2 text-tokyo-night-fg">Reset</h2>
+				<p class="text-sm text-tokyo-night-fg-dim mb-4">Drop saved tiers and go back to computing them live.</p>
+				<button type="submit" class="w-full px-4 py-2 bg-tokyo-night-bg hover:bg-tokyo-night-bg-dark border border-tokyo-night-border rounded-lg transition-colors">
+					Reset Tiers
+				</button>
+			</form>
+		</div>
+		<div class="grid grid-cols-1 md:grid-cols-3 gap-6">
+	`, draftID, status, sourceLabel, draftID, draftID, draftID))
+
+	for _, pos := range tierPositions(draft) {
+		var rows strings.Builder
+		count, lastTier := 0, 0
+		for _, player := range players {
+			tier, ok := playerTiers[player.ID]
+			if !ok || player.Position != pos || count >= tiersPageDepth {
+				continue
+			}
+			count++
+			if tier != lastTier {
+				rows.WriteString(fmt.Sprintf(`<div class="mt-3 mb-1 text-xs font-semibold text-tokyo-night-accent">Tier %d</div>`, tier))
+				lastTier = tier
+			}
+			class := "text-tokyo-night-fg"
+			if drafted[player.ID] {
+				class = "text-tokyo-night-fg-dim line-through"
+			}
+			rows.WriteString(fmt.Sprintf(`<div class="text-sm %s">%s <span class="text-tokyo-night-fg-dim">%s</span></div>`,
+				class, template.HTMLEscapeString(player.Name), player.Team))
+		}
+		if count == 0 {
+			continue
+		}
+		content.WriteString(fmt.Sprintf(`
+			<div class="bg-tokyo-night-bg-light rounded-lg border border-tokyo-night-border p-4">
+				<h2 class="mb-2">%s</h2>
+				%s
+			</div>
+		`, getPositionBadge(pos), rows.String()))
+	}
+	content.WriteString(`</div>`)
+
+	renderTemplate(w, content.String(), "Tiers")
+}
+
+// ComputeTiers clusters the draft's ranking source and saves the tiers
+func (h *Handler) ComputeTiers(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	draftID, err := strconv.Atoi(idStr)
+	if err != nil {
+		http.Error(w, "Invalid draft ID", http.StatusBadRequest)
+		return
+	}
+
+	draft, err := h.draftRepo.GetByID(draftID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	source := h.rankingSource(draft)
+	computed, err := h.computeTiers(draft, source)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if err := h.tierRepo.ReplaceForSource(source, computed); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	http.Redirect(w, r, fmt.Sprintf("/draft/%d/tiers", draftID), http.StatusSeeOther)
+}
+
+// ImportTiers loads tiers for the draft's ranking source from a CSV
+func (h *Handler) ImportTiers(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	draftID, err := strconv.Atoi(idStr)
+	if err != nil {
+		http.Error(w, "Invalid draft ID", http.StatusBadRequest)
+		return
+	}
+
+	draft, err := h.draftRepo.GetByID(draftID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	file, _, err := r.FormFile("file")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	defer file.Close()
+
+	rows, err := importer.ParseRankingsCSV(file)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	imported, err := h.matchTiers(h.rankingSource(draft), rows)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if len(imported) == 0 {
+		http.Error(w, "No rows with a tier matched a player", http.StatusBadRequest)
+		return
+	}
+	if err := h.tierRepo.ReplaceForSource(h.rankingSource(draft), imported); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	http.Redirect(w, r, fmt.Sprintf("/draft/%d/tiers", draftID), http.StatusSeeOther)
+}
+
+// matchTiers resolves the tiered rows of an imported sheet to players.
+func (h *Handler) matchTiers(source string, rows []importer.RankingRow) ([]models.PlayerTier, error) {
+	players, err := h.playerRepo.List()
+	if err != nil {
+		return nil, err
+	}
+	matcher := importer.NewMatcher(players).WithTeams(h.nflTeams())
+
+	var result []models.PlayerTier
+	seen := make(map[int]bool)
+	for _, row := range rows {
+		if row.Tier == 0 {
+			continue
+		}
+		player := matcher.Match(row.PlayerID, row.Name, row.Position, row.Team)
+		if player == nil || seen[player.ID] {
+			continue
+		}
+		seen[player.ID] = true
+		result = append(result, models.PlayerTier{Source: source, PlayerID: player.ID, Position: player.Position, Tier: row.Tier})
+	}
+	return result, nil
+}
+
+// ResetTiers drops the saved tiers for the draft's ranking source
+func (h *Handler) ResetTiers(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	draftID, err := strconv.Atoi(idStr)
+	if err != nil {
+		http.Error(w, "Invalid draft ID", http.StatusBadRequest)
+		return
+	}
+
+	draft, err := h.draftRepo.GetByID(draftID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	if err := h.tierRepo.DeleteForSource(h.rankingSource(draft)); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	http.Redirect(w, r, fmt.Sprintf("/draft/%d/tiers", draftID), http.StatusSeeOther)
+}
@@ -0,0 +1,281 @@
+package importer
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DraftFile is a finished draft read from a CSV or JSON export, or typed up
+// from a paper draft board. Settings the file does not carry are left
+// empty for the importer to choose. Date is the day the draft was held, as
+// YYYY-MM-DD.
+type DraftFile struct {
+	Name          string      `json:"name"`
+	ScoringFormat string      `json:"scoring_format"`
+	DraftType     string      `json:"draft_type"`
+	Sport         string      `json:"sport"`
+	Season        int         `json:"season"`
+	Date          string      `json:"date"`
+	Teams         []DraftTeam `json:"teams"`
+	Picks         []DraftRow  `json:"picks"`
+}
+
+// DraftTeam is one of an imported draft's teams.
+type DraftTeam struct {
+	Name          string `json:"name"`
+	Owner         string `json:"owner"`
+	DraftPosition int    `json:"draft_position"`
+}
+
+// DraftRow is one pick of an imported draft. Name, Position and Team
+// describe the player picked, to be matched to a player in the database.
+type DraftRow struct {
+	Round       int    `json:"round"`
+	OverallPick int    `json:"overall_pick"`
+	TeamName    string `json:"team_name"`
+	Name        string `json:"player_name"`
+	Position    string `json:"position"`
+	Team        string `json:"nfl_team"`
+	ADPRank     *int   `json:"adp_rank"`
+}
+
+// draftColumns maps the header names a draft CSV may use to its fields.
+var draftColumns = map[string]string{
+	"round":        "round",
+	"overall_pick": "overall_pick",
+	"overall":      "overall_pick",
+	"pick":         "overall_pick",
+	"team":         "team_name",
+	"team_name":    "team_name",
+	"player":       "player_name",
+	"player_name":  "player_name",
+	"name":         "player_name",
+	"position":     "position",
+	"nfl_team":     "nfl_team",
+	"adp_rank":     "adp_rank",
+	"adp":          "adp_rank",
+}
+
+// ParseDraftCSV reads a draft CSV as ExportCSV writes it. The header must
+// include the drafting team and the player; round, overall pick, position,
+// NFL team and ADP rank are optional and other columns are ignored. Header
+// names are matched without regard to case, spaces or underscores, so
+// "Overall Pick" and overall_pick both work. Without an overall pick
+// column, picks are numbered in row order. Teams are placed in the order
+// they first pick.
+func ParseDraftCSV(r io.Reader) (*DraftFile, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read header: %w", err)
+	}
+
+	columns := make(map[string]int)
+	for i, col := range header {
+		col = strings.ReplaceAll(strings.ToLower(strings.TrimSpace(col)), " ", "_")
+		if field, ok := draftColumns[col]; ok {
+			columns[field] = i
+		}
+	}
+	if _, ok := columns["team_name"]; !ok {
+		return nil, fmt.Errorf("header must include team")
+	}
+	if _, ok := columns["player_name"]; !ok {
+		return nil, fmt.Errorf("header must include player")
+	}
+
+	file := &DraftFile{}
+	line := 1
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		line++
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		row := DraftRow{OverallPick: len(file.Picks) + 1}
+		for field, i := range columns {
+			if i >= len(record) {
+				continue
+			}
+			value := strings.TrimSpace(record[i])
+			switch field {
+			case "round", "overall_pick", "adp_rank":
+				if value == "" {
+					continue
+				}
+				n, err := strconv.Atoi(value)
+				if err != nil || n < 1 {
+					return nil, fmt.Errorf("line %d: invalid %s %q", line, strings.ReplaceAll(field, "_", " "), value)
+				}
+				switch field {
+				case "round":
+					row.Round = n
+				case "overall_pick":
+					row.OverallPick = n
+				default:
+					row.ADPRank = &n
+				}
+			case "team_name":
+				row.TeamName = value
+			case "player_name":
+				row.Name = value
+			case "position":
+				row.Position = value
+			case "nfl_team":
+				row.Team = value
+			}
+		}
+		if row.Name == "" && row.TeamName == "" {
+			continue
+		}
+		file.Picks = append(file.Picks, row)
+	}
+
+	if err := file.resolve(); err != nil {
+		return nil, err
+	}
+	return file, nil
+}
+
+// exportJSON is the shape ExportJSON writes.
+type exportJSON struct {
+	Draft struct {
+		Name          string    `json:"name"`
+		ScoringFormat string    `json:"scoring_format"`
+		DraftType     string    `json:"draft_type"`
+		Sport         string    `json:"sport"`
+		CreatedAt     time.Time `json:"created_at"`
+	} `json:"draft"`
+	Teams []struct {
+		TeamName      string
+		OwnerName     string
+		DraftPosition int
+	} `json:"teams"`
+	Picks []DraftRow `json:"picks"`
+}
+
+// ParseDraftJSON reads a draft as ExportJSON writes it: the draft's
+// settings and date, its teams with their draft positions, and its picks.
+func ParseDraftJSON(r io.Reader) (*DraftFile, error) {
+	var export exportJSON
+	if err := json.NewDecoder(r).Decode(&export); err != nil {
+		return nil, fmt.Errorf("failed to decode draft: %w", err)
+	}
+
+	file := &DraftFile{
+		Name:          export.Draft.Name,
+		ScoringFormat: export.Draft.ScoringFormat,
+		DraftType:     export.Draft.DraftType,
+		Sport:         export.Draft.Sport,
+		Picks:         export.Picks,
+	}
+	if !export.Draft.CreatedAt.IsZero() {
+		file.Date = export.Draft.CreatedAt.UTC().Format("2006-01-02")
+	}
+	for _, t := range export.Teams {
+		file.Teams = append(file.Teams, DraftTeam{Name: t.TeamName, Owner: t.OwnerName, DraftPosition: t.DraftPosition})
+	}
+	if err := file.resolve(); err != nil {
+		return nil, err
+	}
+	return file, nil
+}
+
+// resolve checks the picks, puts them in order and fills in the teams,
+// draft positions and rounds the file leaves out.
+func (f *DraftFile) resolve() error {
+	if len(f.Picks) == 0 {
+		return fmt.Errorf("the file has no picks")
+	}
+	sort.SliceStable(f.Picks, func(i, j int) bool {
+		return f.Picks[i].OverallPick < f.Picks[j].OverallPick
+	})
+	for i, pick := range f.Picks {
+		if pick.OverallPick < 1 {
+			return fmt.Errorf("pick %d: invalid overall pick %d", i+1, pick.OverallPick)
+		}
+		if i > 0 && f.Picks[i-1].OverallPick == pick.OverallPick {
+			return fmt.Errorf("pick %d appears more than once", pick.OverallPick)
+		}
+		if pick.Name == "" {
+			return fmt.Errorf("pick %d: player is required", pick.OverallPick)
+		}
+		if pick.TeamName == "" {
+			return fmt.Errorf("pick %d: team is required", pick.OverallPick)
+		}
+	}
+
+	// Teams the file does not list, or lists without a draft position,
+	// take the next position in the order they first pick.
+	positions := make(map[string]bool)
+	taken := make(map[int]bool)
+	for _, t := range f.Teams {
+		positions[strings.ToLower(t.Name)] = true
+		if t.DraftPosition > 0 {
+			if taken[t.DraftPosition] {
+				return fmt.Errorf("more than one team has draft position %d", t.DraftPosition)
+			}
+			taken[t.DraftPosition] = true
+		}
+	}
+	for _, pick := range f.Picks {
+		if !positions[strings.ToLower(pick.TeamName)] {
+			positions[strings.ToLower(pick.TeamName)] = true
+			f.Teams = append(f.Teams, DraftTeam{Name: pick.TeamName})
+		}
+	}
+	next := 1
+	for i := range f.Teams {
+		if f.Teams[i].DraftPosition > 0 {
+			continue
+		}
+		for taken[next] {
+			next++
+		}
+		f.Teams[i].DraftPosition = next
+		taken[next] = true
+	}
+	sort.SliceStable(f.Teams, func(i, j int) bool {
+		return f.Teams[i].DraftPosition < f.Teams[j].DraftPosition
+	})
+
+	for i := range f.Picks {
+		if f.Picks[i].Round == 0 {
+			f.Picks[i].Round = (f.Picks[i].OverallPick-1)/len(f.Teams) + 1
+		}
+	}
+	return nil
+}
+
+// Rounds is the number of rounds the draft ran.
+func (f *DraftFile) Rounds() int {
+	rounds := 0
+	for _, pick := range f.Picks {
+		rounds = max(rounds, pick.Round)
+	}
+	return rounds
+}
+
+// DraftPosition returns the draft position of the team a pick names, or 0
+// when the file has no such team.
+func (f *DraftFile) DraftPosition(teamName string) int {
+	for _, t := range f.Teams {
+		if strings.EqualFold(t.Name, teamName) {
+			return t.DraftPosition
+		}
+	}
+	return 0
+}
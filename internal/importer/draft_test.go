@@ -0,0 +1,116 @@
+package importer
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/vibes/draft-board/internal/models"
+)
+
+func TestParseDraftCSV(t *testing.T) {
+	// As ExportCSV writes it, with the picks out of order.
+	input := `Round,Overall Pick,Team,Player,Position,NFL Team,ADP Rank
+1,2,Bears,Bijan Robinson,RB,ATL,3
+1,1,Aces,Ja'Marr Chase,WR,CIN,1
+2,3,Bears,Josh Allen,QB,BUF,
+2,4,Aces,Brock Bowers,TE,LVR,20
+`
+	file, err := ParseDraftCSV(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("ParseDraftCSV() error = %v", err)
+	}
+	if len(file.Picks) != 4 || file.Picks[0].Name != "Ja'Marr Chase" || file.Picks[3].Round != 2 {
+		t.Fatalf("picks = %+v", file.Picks)
+	}
+	if *file.Picks[0].ADPRank != 1 || file.Picks[2].ADPRank != nil {
+		t.Errorf("ADP ranks = %v, %v", file.Picks[0].ADPRank, file.Picks[2].ADPRank)
+	}
+	if len(file.Teams) != 2 || file.DraftPosition("aces") != 1 || file.DraftPosition("Bears") != 2 {
+		t.Errorf("teams = %+v, want Aces then Bears", file.Teams)
+	}
+	if file.Rounds() != 2 {
+		t.Errorf("Rounds() = %d, want 2", file.Rounds())
+	}
+}
+
+func TestParseDraftCSV_PaperDraft(t *testing.T) {
+	// A hand-typed board: just the team and player in pick order.
+	input := "team,player\nAces,Chase\nBears,Bijan\nCats,Jefferson\nCats,Allen\n"
+	file, err := ParseDraftCSV(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("ParseDraftCSV() error = %v", err)
+	}
+	if file.Picks[3].OverallPick != 4 || file.Picks[3].Round != 2 || file.DraftPosition("Cats") != 3 {
+		t.Errorf("picks = %+v, teams = %+v", file.Picks, file.Teams)
+	}
+}
+
+func TestParseDraftCSV_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{name: "no team column", input: "round,player\n1,Chase\n"},
+		{name: "no player column", input: "round,team\n1,Aces\n"},
+		{name: "invalid round", input: "round,team,player\nfirst,Aces,Chase\n"},
+		{name: "duplicate pick", input: "overall pick,team,player\n1,Aces,Chase\n1,Bears,Bijan\n"},
+		{name: "missing player", input: "team,player\nAces,\n"},
+		{name: "no picks", input: "team,player\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseDraftCSV(strings.NewReader(tt.input)); err == nil {
+				t.Error("ParseDraftCSV() error = nil, want error")
+			}
+		})
+	}
+}
+
+func TestParseDraftJSON(t *testing.T) {
+	// As ExportJSON writes it.
+	input := `{
+		"draft": {"id": 7, "name": "Home League", "num_teams": 2, "scoring_format": "PPR", "draft_type": "Redraft", "status": "completed", "sport": "football", "created_at": "2025-08-30T19:04:11Z"},
+		"teams": [
+			{"ID": 1, "DraftID": 7, "TeamName": "Bears", "OwnerName": "Bob", "DraftPosition": 2},
+			{"ID": 2, "DraftID": 7, "TeamName": "Aces", "OwnerName": "Ann", "DraftPosition": 1}
+		],
+		"picks": [
+			{"round": 1, "overall_pick": 1, "team_name": "Aces", "player_name": "Ja'Marr Chase", "position": "WR", "nfl_team": "CIN", "adp_rank": 1},
+			{"round": 1, "overall_pick": 2, "team_name": "Bears", "player_name": "Bijan Robinson", "position": "RB", "nfl_team": "ATL", "adp_rank": null}
+		]
+	}`
+	file, err := ParseDraftJSON(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("ParseDraftJSON() error = %v", err)
+	}
+	if file.Name != "Home League" || file.ScoringFormat != "PPR" || file.Sport != "football" || file.Date != "2025-08-30" {
+		t.Errorf("settings = %+v", file)
+	}
+	if file.Teams[0].Name != "Aces" || file.Teams[0].Owner != "Ann" || file.DraftPosition("Bears") != 2 {
+		t.Errorf("teams = %+v", file.Teams)
+	}
+	if len(file.Picks) != 2 || file.Picks[1].Team != "ATL" || file.Picks[1].ADPRank != nil {
+		t.Errorf("picks = %+v", file.Picks)
+	}
+}
+
+func TestMatcher_Closest(t *testing.T) {
+	players := []*models.Player{
+		{ID: 1, Name: "Ja'Marr Chase", Team: "CIN", Position: "WR"},
+		{ID: 2, Name: "Jayden Reed", Team: "GB", Position: "WR"},
+		{ID: 3, Name: "Jaylen Warren", Team: "PIT", Position: "RB"},
+		{ID: 4, Name: "Jaylen Waddle", Team: "MIA", Position: "WR"},
+	}
+	m := NewMatcher(players)
+
+	if got := m.Closest("Jamar Chase", "WR", 3); len(got) != 1 || got[0].ID != 1 {
+		t.Errorf("Closest(Jamar Chase) = %v, want Chase", got)
+	}
+	got := m.Closest("Jaylen Wadle", "WR", 3)
+	if len(got) == 0 || got[0].ID != 4 {
+		t.Errorf("Closest(Jaylen Wadle) = %v, want Waddle first", got)
+	}
+	if got := m.Closest("Travis Kelce", "", 3); len(got) != 0 {
+		t.Errorf("Closest(Travis Kelce) = %v, want none", got)
+	}
+}
@@ -0,0 +1,131 @@
+package importer
+
+import (
+	"sort"
+
+	"github.com/vibes/draft-board/internal/models"
+	"github.com/vibes/draft-board/internal/names"
+	"github.com/vibes/draft-board/internal/nfl"
+)
+
+// Matcher resolves imported rows to players in the database.
+type Matcher struct {
+	byID   map[int]*models.Player
+	byName map[string][]*models.Player
+	teams  *nfl.Index
+}
+
+func NewMatcher(players []*models.Player) *Matcher {
+	m := &Matcher{
+		byID:   make(map[int]*models.Player),
+		byName: make(map[string][]*models.Player),
+	}
+	for _, p := range players {
+		m.byID[p.ID] = p
+		key := names.Normalize(p.Name)
+		m.byName[key] = append(m.byName[key], p)
+	}
+	return m
+}
+
+// WithTeams makes the matcher compare NFL teams by their canonical
+// abbreviation, so a row's "KC" matches a player on "KCC".
+func (m *Matcher) WithTeams(teams *nfl.Index) *Matcher {
+	m.teams = teams
+	return m
+}
+
+// Match returns the player a row refers to, or nil when the row matches no
+// player or is ambiguous. A player ID wins outright; otherwise the normalized
+// name is narrowed by any position the player is eligible at and then NFL
+// team until one player remains.
+func (m *Matcher) Match(playerID int, name, position, team string) *models.Player {
+	if playerID != 0 {
+		return m.byID[playerID]
+	}
+
+	candidates := m.byName[names.Normalize(name)]
+	if positions := models.ParsePositions(position); len(positions) > 0 {
+		candidates = filterPlayers(candidates, func(p *models.Player) bool {
+			for _, pos := range positions {
+				if p.EligibleAt(pos) {
+					return true
+				}
+			}
+			return false
+		})
+	}
+	if len(candidates) > 1 && team != "" {
+		team = m.teams.Canonical(team)
+		candidates = filterPlayers(candidates, func(p *models.Player) bool {
+			return m.teams.Canonical(p.Team) == team
+		})
+	}
+	if len(candidates) != 1 {
+		return nil
+	}
+	return candidates[0]
+}
+
+func filterPlayers(players []*models.Player, keep func(*models.Player) bool) []*models.Player {
+	var out []*models.Player
+	for _, p := range players {
+		if keep(p) {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+// Closest returns up to limit players whose names are near the given one,
+// closest first, for rows Match cannot resolve such as a misspelled name.
+// A name is near when it loosely matches the row's name or is a few edits
+// away from it. Players eligible at the row's position come before those
+// who are not.
+func (m *Matcher) Closest(name, position string, limit int) []*models.Player {
+	key := names.Normalize(name)
+	if key == "" {
+		return nil
+	}
+	allowed := max(2, len(key)/4)
+	positions := models.ParsePositions(position)
+
+	type candidate struct {
+		player   *models.Player
+		distance int
+		eligible bool
+	}
+	var candidates []candidate
+	for other, players := range m.byName {
+		distance := names.Distance(key, other)
+		if distance > allowed && !names.Similar(name, other) {
+			continue
+		}
+		for _, p := range players {
+			eligible := len(positions) == 0
+			for _, pos := range positions {
+				eligible = eligible || p.EligibleAt(pos)
+			}
+			candidates = append(candidates, candidate{p, distance, eligible})
+		}
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if a.eligible != b.eligible {
+			return a.eligible
+		}
+		if a.distance != b.distance {
+			return a.distance < b.distance
+		}
+		return a.player.ID < b.player.ID
+	})
+	closest := make([]*models.Player, 0, limit)
+	for _, c := range candidates {
+		if len(closest) == limit {
+			break
+		}
+		closest = append(closest, c.player)
+	}
+	return closest
+}
@@ -0,0 +1,123 @@
+package importer
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// PlatformIDRow is one player's ID on a fantasy hosting site. PlayerID is
+// our own player ID, if known; the player is otherwise matched by name.
+type PlatformIDRow struct {
+	PlayerID   int    `json:"player_id"`
+	Name       string `json:"name"`
+	Team       string `json:"team"`
+	Position   string `json:"position"`
+	PlatformID string `json:"platform_id"`
+}
+
+// ParsePlatformIDsCSV reads a platform ID CSV. The header must include
+// platform_id and player_id or name; team and position are optional and
+// other columns are ignored. Rows without a platform ID are skipped.
+func ParsePlatformIDsCSV(r io.Reader) ([]PlatformIDRow, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read header: %w", err)
+	}
+
+	columns := make(map[string]int)
+	for i, col := range header {
+		col = strings.ToLower(strings.TrimSpace(col))
+		switch col {
+		case "player_id", "name", "team", "position", "platform_id":
+			columns[col] = i
+		}
+	}
+	_, hasID := columns["player_id"]
+	_, hasName := columns["name"]
+	if !hasID && !hasName {
+		return nil, fmt.Errorf("header must include player_id or name")
+	}
+	if _, ok := columns["platform_id"]; !ok {
+		return nil, fmt.Errorf("header must include platform_id")
+	}
+
+	var rows []PlatformIDRow
+	line := 1
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		line++
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		var row PlatformIDRow
+		for col, i := range columns {
+			if i >= len(record) {
+				continue
+			}
+			value := strings.TrimSpace(record[i])
+			switch col {
+			case "player_id":
+				if value == "" {
+					continue
+				}
+				id, err := strconv.Atoi(value)
+				if err != nil {
+					return nil, fmt.Errorf("line %d: invalid player_id %q", line, value)
+				}
+				row.PlayerID = id
+			case "name":
+				row.Name = value
+			case "team":
+				row.Team = value
+			case "position":
+				row.Position = value
+			case "platform_id":
+				row.PlatformID = value
+			}
+		}
+		if (row.PlayerID == 0 && row.Name == "") || row.PlatformID == "" {
+			continue
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+// ParsePlatformIDsJSON reads a JSON array of PlatformIDRow objects.
+// platform_id may be given as a string or a number.
+func ParsePlatformIDsJSON(r io.Reader) ([]PlatformIDRow, error) {
+	var raw []struct {
+		PlatformIDRow
+		PlatformID json.RawMessage `json:"platform_id"`
+	}
+	if err := json.NewDecoder(r).Decode(&raw); err != nil {
+		return nil, fmt.Errorf("failed to decode platform IDs: %w", err)
+	}
+	rows := make([]PlatformIDRow, len(raw))
+	for i, entry := range raw {
+		if entry.PlayerID == 0 && entry.Name == "" {
+			return nil, fmt.Errorf("entry %d: player_id or name is required", i+1)
+		}
+		rows[i] = entry.PlatformIDRow
+		if err := json.Unmarshal(entry.PlatformID, &rows[i].PlatformID); err != nil {
+			rows[i].PlatformID = string(entry.PlatformID)
+		}
+		if rows[i].PlatformID == "" || rows[i].PlatformID == "null" {
+			return nil, fmt.Errorf("entry %d: platform_id is required", i+1)
+		}
+	}
+	return rows, nil
+}
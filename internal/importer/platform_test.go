@@ -0,0 +1,48 @@
+package importer
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParsePlatformIDsCSV(t *testing.T) {
+	input := `name,team,platform_id,ignored
+Josh Allen,BUF,4984,x
+Christian McCaffrey,SF,,x
+Justin Jefferson,MIN,6794
+`
+	rows, err := ParsePlatformIDsCSV(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("ParsePlatformIDsCSV() error = %v", err)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("got %d rows, want 2 (rows without an ID are skipped)", len(rows))
+	}
+	if rows[0].Name != "Josh Allen" || rows[0].Team != "BUF" || rows[0].PlatformID != "4984" {
+		t.Errorf("row 0 = %+v", rows[0])
+	}
+	if rows[1].PlatformID != "6794" {
+		t.Errorf("row 1 = %+v", rows[1])
+	}
+
+	for _, input := range []string{"name,team\nFoo,BUF\n", "team,platform_id\nBUF,1\n"} {
+		if _, err := ParsePlatformIDsCSV(strings.NewReader(input)); err == nil {
+			t.Errorf("ParsePlatformIDsCSV(%q) error = nil, want error", input)
+		}
+	}
+}
+
+func TestParsePlatformIDsJSON(t *testing.T) {
+	input := `[{"player_id": 3, "platform_id": 4984}, {"name": "Justin Jefferson", "platform_id": "449.p.30123"}]`
+	rows, err := ParsePlatformIDsJSON(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("ParsePlatformIDsJSON() error = %v", err)
+	}
+	if len(rows) != 2 || rows[0].PlayerID != 3 || rows[0].PlatformID != "4984" || rows[1].PlatformID != "449.p.30123" {
+		t.Errorf("rows = %+v", rows)
+	}
+
+	if _, err := ParsePlatformIDsJSON(strings.NewReader(`[{"name": "Foo"}]`)); err == nil {
+		t.Error("ParsePlatformIDsJSON() without platform_id should fail")
+	}
+}
@@ -0,0 +1,120 @@
+package importer
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/vibes/draft-board/internal/scoring"
+)
+
+// ProjectionRow is one player's stat line from a projections file. Players
+// are identified by PlayerID when present, otherwise by name, position and
+// NFL team.
+type ProjectionRow struct {
+	PlayerID int                `json:"player_id"`
+	Name     string             `json:"name"`
+	Team     string             `json:"team"`
+	Position string             `json:"position"`
+	Stats    map[string]float64 `json:"stats"`
+}
+
+// ParseProjectionsCSV reads a projections CSV. The header must include either
+// player_id or name; every other column must be a known stat category or one
+// of team/position.
+func ParseProjectionsCSV(r io.Reader) ([]ProjectionRow, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read header: %w", err)
+	}
+
+	columns := make(map[string]int)
+	for i, col := range header {
+		col = strings.ToLower(strings.TrimSpace(col))
+		switch col {
+		case "player_id", "name", "team", "position":
+		default:
+			if !scoring.IsStat(col) {
+				return nil, fmt.Errorf("unknown column %q", col)
+			}
+		}
+		columns[col] = i
+	}
+	_, hasID := columns["player_id"]
+	_, hasName := columns["name"]
+	if !hasID && !hasName {
+		return nil, fmt.Errorf("header must include player_id or name")
+	}
+
+	var rows []ProjectionRow
+	line := 1
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		line++
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		row := ProjectionRow{Stats: make(map[string]float64)}
+		for col, i := range columns {
+			value := strings.TrimSpace(record[i])
+			switch col {
+			case "player_id":
+				if value == "" {
+					continue
+				}
+				id, err := strconv.Atoi(value)
+				if err != nil {
+					return nil, fmt.Errorf("line %d: invalid player_id %q", line, value)
+				}
+				row.PlayerID = id
+			case "name":
+				row.Name = value
+			case "team":
+				row.Team = value
+			case "position":
+				row.Position = value
+			default:
+				if value == "" {
+					continue
+				}
+				f, err := strconv.ParseFloat(value, 64)
+				if err != nil {
+					return nil, fmt.Errorf("line %d: invalid %s %q", line, col, value)
+				}
+				row.Stats[col] = f
+			}
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+// ParseProjectionsJSON reads a JSON array of ProjectionRow objects.
+func ParseProjectionsJSON(r io.Reader) ([]ProjectionRow, error) {
+	var rows []ProjectionRow
+	if err := json.NewDecoder(r).Decode(&rows); err != nil {
+		return nil, fmt.Errorf("failed to decode projections: %w", err)
+	}
+	for i, row := range rows {
+		for stat := range row.Stats {
+			if !scoring.IsStat(stat) {
+				return nil, fmt.Errorf("entry %d: unknown stat %q", i+1, stat)
+			}
+		}
+		if row.PlayerID == 0 && row.Name == "" {
+			return nil, fmt.Errorf("entry %d: player_id or name is required", i+1)
+		}
+	}
+	return rows, nil
+}
@@ -0,0 +1,113 @@
+package importer
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/vibes/draft-board/internal/models"
+	"github.com/vibes/draft-board/internal/nfl"
+)
+
+func TestParseProjectionsCSV(t *testing.T) {
+	input := `name,team,position,pass_yds,pass_td,rec
+Josh Allen,BUF,QB,4100,30,
+Ja'Marr Chase,CIN,WR,,,105
+`
+	rows, err := ParseProjectionsCSV(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("ParseProjectionsCSV() error = %v", err)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("got %d rows, want 2", len(rows))
+	}
+	if rows[0].Name != "Josh Allen" || rows[0].Stats["pass_yds"] != 4100 || rows[0].Stats["pass_td"] != 30 {
+		t.Errorf("row 0 = %+v", rows[0])
+	}
+	if _, ok := rows[0].Stats["rec"]; ok {
+		t.Errorf("empty cell should not produce a stat, got %+v", rows[0].Stats)
+	}
+	if rows[1].Stats["rec"] != 105 {
+		t.Errorf("row 1 rec = %v, want 105", rows[1].Stats["rec"])
+	}
+}
+
+func TestParseProjectionsCSV_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{name: "unknown column", input: "name,tackles\nFoo,3\n"},
+		{name: "no identity column", input: "team,pass_yds\nBUF,100\n"},
+		{name: "bad number", input: "name,pass_yds\nFoo,lots\n"},
+		{name: "bad player id", input: "player_id,pass_yds\nabc,100\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseProjectionsCSV(strings.NewReader(tt.input)); err == nil {
+				t.Error("ParseProjectionsCSV() expected error, got nil")
+			}
+		})
+	}
+}
+
+func TestParseProjectionsJSON(t *testing.T) {
+	input := `[{"player_id": 7, "stats": {"rush_yds": 1200, "rush_td": 11}}]`
+	rows, err := ParseProjectionsJSON(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("ParseProjectionsJSON() error = %v", err)
+	}
+	if len(rows) != 1 || rows[0].PlayerID != 7 || rows[0].Stats["rush_td"] != 11 {
+		t.Errorf("rows = %+v", rows)
+	}
+
+	if _, err := ParseProjectionsJSON(strings.NewReader(`[{"name": "Foo", "stats": {"dunks": 3}}]`)); err == nil {
+		t.Error("expected error for unknown stat")
+	}
+	if _, err := ParseProjectionsJSON(strings.NewReader(`[{"stats": {}}]`)); err == nil {
+		t.Error("expected error for missing identity")
+	}
+}
+
+func TestMatcher_Match(t *testing.T) {
+	players := []*models.Player{
+		{ID: 1, Name: "Ja'Marr Chase", Team: "CIN", Position: "WR"},
+		{ID: 2, Name: "Mike Williams", Team: "NYJ", Position: "WR"},
+		{ID: 3, Name: "Mike Williams", Team: "PIT", Position: "WR"},
+		{ID: 4, Name: "Josh Allen", Team: "BUF", Position: "QB"},
+		{ID: 5, Name: "Josh Allen", Team: "JAC", Position: "DL", EligiblePositions: "LB"},
+	}
+	m := NewMatcher(players).WithTeams(nfl.NewIndex(nfl.Teams))
+
+	tests := []struct {
+		name     string
+		id       int
+		player   string
+		position string
+		team     string
+		want     int
+	}{
+		{name: "by id", id: 3, want: 3},
+		{name: "unknown id", id: 99, want: 0},
+		{name: "normalized name", player: "JaMarr Chase", want: 1},
+		{name: "position disambiguates", player: "Josh Allen", position: "QB", want: 4},
+		{name: "team disambiguates", player: "Mike Williams", position: "WR", team: "pit", want: 3},
+		{name: "eligible position", player: "Josh Allen", position: "lb", want: 5},
+		{name: "team alias", player: "Josh Allen", team: "JAX", want: 5},
+		{name: "ambiguous", player: "Mike Williams", position: "WR", want: 0},
+		{name: "no match", player: "Nobody", want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := m.Match(tt.id, tt.player, tt.position, tt.team)
+			gotID := 0
+			if got != nil {
+				gotID = got.ID
+			}
+			if gotID != tt.want {
+				t.Errorf("Match() = %d, want %d", gotID, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package importer
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/vibes/draft-board/internal/models"
+)
+
+// RankingRow is one line of a cheat sheet. Rank is the row's position in the
+// file when the file has no rank column; Tier is 0 when the row has none.
+// Formats holds any per-format ranks, keyed by rank format.
+type RankingRow struct {
+	Rank     int
+	Tier     int
+	PlayerID int
+	Name     string
+	Team     string
+	Position string
+	Formats  map[string]int
+}
+
+// formatColumns maps per-format rank columns to their rank format.
+var formatColumns = map[string]string{
+	"ppr_rank":      models.FormatPPR,
+	"half_ppr_rank": models.FormatHalfPPR,
+	"std_rank":      models.FormatStd,
+	"dynasty_rank":  models.FormatDynasty,
+	"sf_rank":       models.FormatSF,
+}
+
+// ParseRankingsCSV reads a rankings CSV. The header must include player_id
+// or name; rank, tier, team, position and the per-format rank columns
+// (ppr_rank, std_rank, ...) are optional and other columns are ignored so
+// exported cheat sheets load as-is.
+func ParseRankingsCSV(r io.Reader) ([]RankingRow, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read header: %w", err)
+	}
+
+	columns := make(map[string]int)
+	for i, col := range header {
+		col = strings.ToLower(strings.TrimSpace(col))
+		switch col {
+		case "rank", "tier", "player_id", "name", "team", "position":
+			columns[col] = i
+		default:
+			if _, ok := formatColumns[col]; ok {
+				columns[col] = i
+			}
+		}
+	}
+	_, hasID := columns["player_id"]
+	_, hasName := columns["name"]
+	if !hasID && !hasName {
+		return nil, fmt.Errorf("header must include player_id or name")
+	}
+
+	var rows []RankingRow
+	line := 1
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		line++
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		row := RankingRow{Rank: len(rows) + 1}
+		for col, i := range columns {
+			if i >= len(record) {
+				continue
+			}
+			value := strings.TrimSpace(record[i])
+			switch col {
+			case "rank":
+				if value == "" {
+					continue
+				}
+				rank, err := strconv.Atoi(value)
+				if err != nil || rank < 1 {
+					return nil, fmt.Errorf("line %d: invalid rank %q", line, value)
+				}
+				row.Rank = rank
+			case "tier":
+				if value == "" {
+					continue
+				}
+				tier, err := strconv.Atoi(value)
+				if err != nil || tier < 1 {
+					return nil, fmt.Errorf("line %d: invalid tier %q", line, value)
+				}
+				row.Tier = tier
+			case "player_id":
+				if value == "" {
+					continue
+				}
+				id, err := strconv.Atoi(value)
+				if err != nil {
+					return nil, fmt.Errorf("line %d: invalid player_id %q", line, value)
+				}
+				row.PlayerID = id
+			case "name":
+				row.Name = value
+			case "team":
+				row.Team = value
+			case "position":
+				row.Position = value
+			default:
+				if value == "" {
+					continue
+				}
+				rank, err := strconv.Atoi(value)
+				if err != nil || rank < 1 {
+					return nil, fmt.Errorf("line %d: invalid %s %q", line, col, value)
+				}
+				if row.Formats == nil {
+					row.Formats = make(map[string]int)
+				}
+				row.Formats[formatColumns[col]] = rank
+			}
+		}
+		if row.PlayerID == 0 && row.Name == "" {
+			continue
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
@@ -0,0 +1,79 @@
+package importer
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseRankingsCSV(t *testing.T) {
+	input := `Rank,Name,Team,Position,Notes
+1,Ja'Marr Chase,CIN,WR,elite
+3,Bijan Robinson,ATL,RB,
+,,,,
+2,Justin Jefferson,MIN,WR,`
+
+	rows, err := ParseRankingsCSV(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("ParseRankingsCSV() error = %v", err)
+	}
+	if len(rows) != 3 {
+		t.Fatalf("len(rows) = %d, want 3", len(rows))
+	}
+	if rows[1].Name != "Bijan Robinson" || rows[1].Rank != 3 || rows[1].Position != "RB" {
+		t.Errorf("rows[1] = %+v", rows[1])
+	}
+}
+
+func TestParseRankingsCSV_RowOrder(t *testing.T) {
+	rows, err := ParseRankingsCSV(strings.NewReader("player_id\n12\n7\n"))
+	if err != nil {
+		t.Fatalf("ParseRankingsCSV() error = %v", err)
+	}
+	if len(rows) != 2 || rows[0].Rank != 1 || rows[1].Rank != 2 || rows[1].PlayerID != 7 {
+		t.Errorf("rows = %+v, want ranks from row order", rows)
+	}
+}
+
+func TestParseRankingsCSV_Tiers(t *testing.T) {
+	rows, err := ParseRankingsCSV(strings.NewReader("name,position,tier\nJosh Allen,QB,1\nJared Goff,QB,\n"))
+	if err != nil {
+		t.Fatalf("ParseRankingsCSV() error = %v", err)
+	}
+	if len(rows) != 2 || rows[0].Tier != 1 || rows[1].Tier != 0 {
+		t.Errorf("rows = %+v, want tier 1 then none", rows)
+	}
+}
+
+func TestParseRankingsCSV_Formats(t *testing.T) {
+	rows, err := ParseRankingsCSV(strings.NewReader("name,ppr_rank,std_rank\nBijan Robinson,2,1\nDe'Von Achane,5,\n"))
+	if err != nil {
+		t.Fatalf("ParseRankingsCSV() error = %v", err)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("len(rows) = %d, want 2", len(rows))
+	}
+	if rows[0].Formats["ppr"] != 2 || rows[0].Formats["std"] != 1 {
+		t.Errorf("rows[0].Formats = %v, want ppr 2 and std 1", rows[0].Formats)
+	}
+	if len(rows[1].Formats) != 1 || rows[1].Formats["ppr"] != 5 {
+		t.Errorf("rows[1].Formats = %v, want only ppr 5", rows[1].Formats)
+	}
+}
+
+func TestParseRankingsCSV_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{name: "no identifier column", input: "rank,team\n1,CIN\n"},
+		{name: "bad rank", input: "rank,name\nfirst,Ja'Marr Chase\n"},
+		{name: "bad tier", input: "tier,name\n0,Ja'Marr Chase\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseRankingsCSV(strings.NewReader(tt.input)); err == nil {
+				t.Error("ParseRankingsCSV() error = nil, want error")
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package importer
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/vibes/draft-board/internal/models"
+)
+
+// StatusRow is one player's availability from an injury report. Status is
+// one of the models player statuses; Note holds the report's detail, such
+// as the injury.
+type StatusRow struct {
+	PlayerID int    `json:"player_id"`
+	Name     string `json:"name"`
+	Team     string `json:"team"`
+	Position string `json:"position"`
+	Status   string `json:"status"`
+	Note     string `json:"note"`
+}
+
+// ParseStatusCSV reads a status CSV. The header must include status and
+// player_id or name; note, team and position are optional and other columns
+// are ignored. Statuses may use injury report abbreviations such as Q or IR.
+func ParseStatusCSV(r io.Reader) ([]StatusRow, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read header: %w", err)
+	}
+
+	columns := make(map[string]int)
+	for i, col := range header {
+		col = strings.ToLower(strings.TrimSpace(col))
+		switch col {
+		case "player_id", "name", "team", "position", "status", "note":
+			columns[col] = i
+		}
+	}
+	_, hasID := columns["player_id"]
+	_, hasName := columns["name"]
+	if !hasID && !hasName {
+		return nil, fmt.Errorf("header must include player_id or name")
+	}
+	if _, ok := columns["status"]; !ok {
+		return nil, fmt.Errorf("header must include status")
+	}
+
+	var rows []StatusRow
+	line := 1
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		line++
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		var row StatusRow
+		for col, i := range columns {
+			if i >= len(record) {
+				continue
+			}
+			value := strings.TrimSpace(record[i])
+			switch col {
+			case "player_id":
+				if value == "" {
+					continue
+				}
+				id, err := strconv.Atoi(value)
+				if err != nil {
+					return nil, fmt.Errorf("line %d: invalid player_id %q", line, value)
+				}
+				row.PlayerID = id
+			case "name":
+				row.Name = value
+			case "team":
+				row.Team = value
+			case "position":
+				row.Position = value
+			case "status":
+				status, ok := models.ParseStatus(value)
+				if !ok {
+					return nil, fmt.Errorf("line %d: unknown status %q", line, value)
+				}
+				row.Status = status
+			case "note":
+				row.Note = value
+			}
+		}
+		if row.PlayerID == 0 && row.Name == "" {
+			continue
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+// ParseStatusJSON reads a JSON array of StatusRow objects.
+func ParseStatusJSON(r io.Reader) ([]StatusRow, error) {
+	var rows []StatusRow
+	if err := json.NewDecoder(r).Decode(&rows); err != nil {
+		return nil, fmt.Errorf("failed to decode statuses: %w", err)
+	}
+	for i := range rows {
+		if rows[i].PlayerID == 0 && rows[i].Name == "" {
+			return nil, fmt.Errorf("entry %d: player_id or name is required", i+1)
+		}
+		status, ok := models.ParseStatus(rows[i].Status)
+		if !ok {
+			return nil, fmt.Errorf("entry %d: unknown status %q", i+1, rows[i].Status)
+		}
+		rows[i].Status = status
+	}
+	return rows, nil
+}
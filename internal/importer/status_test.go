@@ -0,0 +1,65 @@
+package importer
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/vibes/draft-board/internal/models"
+)
+
+func TestParseStatusCSV(t *testing.T) {
+	input := `name,team,status,note,ignored
+Christian McCaffrey,SF,IR,Achilles,x
+Josh Allen,BUF,Q,
+Justin Jefferson,MIN,active,
+`
+	rows, err := ParseStatusCSV(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("ParseStatusCSV() error = %v", err)
+	}
+	if len(rows) != 3 {
+		t.Fatalf("got %d rows, want 3", len(rows))
+	}
+	if rows[0].Status != models.StatusIR || rows[0].Note != "Achilles" || rows[0].Team != "SF" {
+		t.Errorf("row 0 = %+v", rows[0])
+	}
+	if rows[1].Status != models.StatusQuestionable {
+		t.Errorf("row 1 status = %q, want questionable", rows[1].Status)
+	}
+	if rows[2].Status != models.StatusActive {
+		t.Errorf("row 2 status = %q, want active", rows[2].Status)
+	}
+}
+
+func TestParseStatusCSV_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{name: "no identity column", input: "team,status\nBUF,out\n"},
+		{name: "no status column", input: "name,note\nFoo,bar\n"},
+		{name: "unknown status", input: "name,status\nFoo,benched\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseStatusCSV(strings.NewReader(tt.input)); err == nil {
+				t.Error("ParseStatusCSV() error = nil, want error")
+			}
+		})
+	}
+}
+
+func TestParseStatusJSON(t *testing.T) {
+	input := `[{"player_id": 4, "status": "Suspended", "note": "6 games"}, {"name": "Foo", "status": "fa"}]`
+	rows, err := ParseStatusJSON(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("ParseStatusJSON() error = %v", err)
+	}
+	if len(rows) != 2 || rows[0].Status != models.StatusSuspended || rows[1].Status != models.StatusFreeAgent {
+		t.Errorf("rows = %+v", rows)
+	}
+
+	if _, err := ParseStatusJSON(strings.NewReader(`[{"status": "out"}]`)); err == nil {
+		t.Error("ParseStatusJSON() without a player should fail")
+	}
+}
@@ -0,0 +1,319 @@
+// Package league summarizes a league's drafts across seasons: each
+// manager's draft tendencies and the league's all-time superlatives.
+package league
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/vibes/draft-board/internal/models"
+)
+
+// EarlyRounds is how many rounds count as early when looking at what a
+// manager drafts first.
+const EarlyRounds = 3
+
+// minValuePicks is how many picks with an ADP a manager needs before their
+// average value counts for a superlative.
+const minValuePicks = 5
+
+// Owner names who made the pick: its team's manager that season, or the
+// team's name when the team has no manager.
+func Owner(pick models.LeaguePick, managers map[int]string) string {
+	if pick.ManagerID != nil {
+		if name, ok := managers[*pick.ManagerID]; ok {
+			return name
+		}
+	}
+	return pick.TeamName
+}
+
+// Tendency is how one manager has drafted over the league's seasons.
+type Tendency struct {
+	Manager string
+	Drafts  int
+	Picks   int
+
+	// Positions counts every pick by position, Early only those in the
+	// first EarlyRounds rounds.
+	Positions map[string]int
+	Early     map[string]int
+
+	// FirstRound is the average round the manager took their first player
+	// at each position, over the drafts they took one.
+	FirstRound map[string]float64
+
+	// Value is the average of ADP minus pick number over picks with an
+	// ADP: positive when the manager lets players fall to them, negative
+	// when they reach.
+	Value      float64
+	ValuePicks int
+
+	Seasons []SeasonPicks
+}
+
+// SeasonPicks are a manager's early picks in one season's draft.
+type SeasonPicks struct {
+	Season  int
+	DraftID int
+	Picks   []models.LeaguePick
+}
+
+// Share is the fraction of the manager's picks at the position.
+func (t *Tendency) Share(position string) float64 {
+	if t.Picks == 0 {
+		return 0
+	}
+	return float64(t.Positions[position]) / float64(t.Picks)
+}
+
+// Tendencies sums each manager's picks, picks in season order. Managers
+// are sorted by name.
+func Tendencies(picks []models.LeaguePick, managers map[int]string) []*Tendency {
+	byOwner := make(map[string]*Tendency)
+	firstRounds := make(map[string]map[string][]int)
+	seen := make(map[string]map[string]bool)
+
+	for _, pick := range picks {
+		owner := Owner(pick, managers)
+		t, ok := byOwner[owner]
+		if !ok {
+			t = &Tendency{
+				Manager:    owner,
+				Positions:  make(map[string]int),
+				Early:      make(map[string]int),
+				FirstRound: make(map[string]float64),
+			}
+			byOwner[owner] = t
+			firstRounds[owner] = make(map[string][]int)
+		}
+
+		if len(t.Seasons) == 0 || t.Seasons[len(t.Seasons)-1].DraftID != pick.DraftID {
+			t.Drafts++
+			t.Seasons = append(t.Seasons, SeasonPicks{Season: pick.Season, DraftID: pick.DraftID})
+		}
+		t.Picks++
+		t.Positions[pick.Position]++
+		if pick.Round <= EarlyRounds {
+			t.Early[pick.Position]++
+			season := &t.Seasons[len(t.Seasons)-1]
+			season.Picks = append(season.Picks, pick)
+		}
+		if pick.ADPRank != nil {
+			t.Value += float64(*pick.ADPRank - pick.OverallPick)
+			t.ValuePicks++
+		}
+
+		key := fmt.Sprintf("%d:%s", pick.DraftID, owner)
+		if seen[key] == nil {
+			seen[key] = make(map[string]bool)
+		}
+		if !seen[key][pick.Position] {
+			seen[key][pick.Position] = true
+			firstRounds[owner][pick.Position] = append(firstRounds[owner][pick.Position], pick.Round)
+		}
+	}
+
+	tendencies := make([]*Tendency, 0, len(byOwner))
+	for owner, t := range byOwner {
+		if t.ValuePicks > 0 {
+			t.Value /= float64(t.ValuePicks)
+		}
+		for pos, rounds := range firstRounds[owner] {
+			sum := 0
+			for _, round := range rounds {
+				sum += round
+			}
+			t.FirstRound[pos] = float64(sum) / float64(len(rounds))
+		}
+		tendencies = append(tendencies, t)
+	}
+	sort.Slice(tendencies, func(i, j int) bool { return tendencies[i].Manager < tendencies[j].Manager })
+	return tendencies
+}
+
+// Superlative is an all-time league record and who holds it.
+type Superlative struct {
+	Title  string
+	Holder string
+	Season int
+	Detail string
+}
+
+// Superlatives finds the league's all-time records over its picks, in
+// season order. Records nobody has set yet are left out. On a tie the
+// earliest holder keeps the record.
+func Superlatives(picks []models.LeaguePick, managers map[int]string) []Superlative {
+	var records []Superlative
+
+	var reach, steal *models.LeaguePick
+	for i := range picks {
+		pick := &picks[i]
+		if pick.ADPRank == nil {
+			continue
+		}
+		diff := *pick.ADPRank - pick.OverallPick
+		if diff < 0 && (reach == nil || diff < *reach.ADPRank-reach.OverallPick) {
+			reach = pick
+		}
+		if diff > 0 && (steal == nil || diff > *steal.ADPRank-steal.OverallPick) {
+			steal = pick
+		}
+	}
+	if reach != nil {
+		records = append(records, Superlative{
+			Title:  "Biggest Reach",
+			Holder: Owner(*reach, managers),
+			Season: reach.Season,
+			Detail: fmt.Sprintf("%s (%s) at pick %d, ADP %d", reach.PlayerName, reach.Position, reach.OverallPick, *reach.ADPRank),
+		})
+	}
+	if steal != nil {
+		records = append(records, Superlative{
+			Title:  "Biggest Steal",
+			Holder: Owner(*steal, managers),
+			Season: steal.Season,
+			Detail: fmt.Sprintf("%s (%s) at pick %d, ADP %d", steal.PlayerName, steal.Position, steal.OverallPick, *steal.ADPRank),
+		})
+	}
+
+	tendencies := Tendencies(picks, managers)
+	var reacher, patient *Tendency
+	for _, t := range tendencies {
+		if t.ValuePicks < minValuePicks {
+			continue
+		}
+		if t.Value < 0 && (reacher == nil || t.Value < reacher.Value) {
+			reacher = t
+		}
+		if t.Value > 0 && (patient == nil || t.Value > patient.Value) {
+			patient = t
+		}
+	}
+	if reacher != nil {
+		records = append(records, Superlative{
+			Title:  "Reach King",
+			Holder: reacher.Manager,
+			Detail: fmt.Sprintf("Picks players %.1f spots before their ADP on average", -reacher.Value),
+		})
+	}
+	if patient != nil {
+		records = append(records, Superlative{
+			Title:  "Value Hunter",
+			Holder: patient.Manager,
+			Detail: fmt.Sprintf("Picks players %.1f spots after their ADP on average", patient.Value),
+		})
+	}
+
+	if record, ok := mostOften(picks, func(p models.LeaguePick) string {
+		return Owner(p, managers) + "\x00" + fmt.Sprint(p.PlayerID)
+	}, true); ok {
+		records = append(records, Superlative{
+			Title:  "Most Loyal",
+			Holder: Owner(record.pick, managers),
+			Detail: fmt.Sprintf("Drafted %s in %d seasons", record.pick.PlayerName, record.count),
+		})
+	}
+	if record, ok := mostOften(picks, func(p models.LeaguePick) string {
+		if p.PlayerTeam == "" {
+			return ""
+		}
+		return fmt.Sprintf("%d\x00%s\x00%s", p.DraftID, Owner(p, managers), p.PlayerTeam)
+	}, false); ok {
+		records = append(records, Superlative{
+			Title:  "Biggest Homer",
+			Holder: Owner(record.pick, managers),
+			Season: record.pick.Season,
+			Detail: fmt.Sprintf("Drafted %d %s players in one draft", record.count, record.pick.PlayerTeam),
+		})
+	}
+	if record, ok := mostOften(picks, func(p models.LeaguePick) string {
+		return fmt.Sprint(p.PlayerID)
+	}, true); ok {
+		records = append(records, Superlative{
+			Title:  "Most Drafted Player",
+			Holder: record.pick.PlayerName,
+			Detail: fmt.Sprintf("Drafted in %d seasons", record.count),
+		})
+	}
+	if record, ok := mostOften(picks, func(p models.LeaguePick) string {
+		if p.OverallPick != 1 {
+			return ""
+		}
+		return Owner(p, managers)
+	}, false); ok {
+		records = append(records, Superlative{
+			Title:  "Most First Overall Picks",
+			Holder: Owner(record.pick, managers),
+			Detail: fmt.Sprintf("%d first overall picks", record.count),
+		})
+	}
+
+	return records
+}
+
+// Earliest finds the earliest any player at each position has been taken,
+// in the order of positions. Positions never drafted are left out.
+func Earliest(picks []models.LeaguePick, managers map[int]string, positions []string) []Superlative {
+	earliest := make(map[string]models.LeaguePick)
+	for _, pick := range picks {
+		if best, ok := earliest[pick.Position]; !ok || pick.OverallPick < best.OverallPick {
+			earliest[pick.Position] = pick
+		}
+	}
+
+	var records []Superlative
+	for _, pos := range positions {
+		pick, ok := earliest[pos]
+		if !ok {
+			continue
+		}
+		records = append(records, Superlative{
+			Title:  "Earliest " + pos,
+			Holder: Owner(pick, managers),
+			Season: pick.Season,
+			Detail: fmt.Sprintf("%s at pick %d (round %d)", pick.PlayerName, pick.OverallPick, pick.Round),
+		})
+	}
+	return records
+}
+
+type counted struct {
+	pick  models.LeaguePick
+	count int
+}
+
+// mostOften counts picks by key, skipping empty keys, and returns the
+// first pick of the key counted most, at least twice. With perDraft a key
+// counts once per draft.
+func mostOften(picks []models.LeaguePick, key func(models.LeaguePick) string, perDraft bool) (counted, bool) {
+	counts := make(map[string]*counted)
+	drafted := make(map[string]bool)
+	var best *counted
+	for _, pick := range picks {
+		k := key(pick)
+		if k == "" {
+			continue
+		}
+		if perDraft {
+			seen := fmt.Sprintf("%s\x00%d", k, pick.DraftID)
+			if drafted[seen] {
+				continue
+			}
+			drafted[seen] = true
+		}
+		c, ok := counts[k]
+		if !ok {
+			c = &counted{pick: pick}
+			counts[k] = c
+		}
+		c.count++
+		if best == nil || c.count > best.count {
+			best = c
+		}
+	}
+	if best == nil || best.count < 2 {
+		return counted{}, false
+	}
+	return *best, true
+}
@@ -0,0 +1,107 @@
+package league
+
+import (
+	"testing"
+
+	"github.com/vibes/draft-board/internal/models"
+)
+
+func intPtr(i int) *int { return &i }
+
+// testPicks are two seasons of a two-team league. Ann (manager 1) ran the
+// Aces both years; Bob (manager 2) ran the Bears the first year and the
+// second year's Bears had no manager linked.
+func testPicks() []models.LeaguePick {
+	pick := func(draft, season int, team string, manager *int, round, overall int, adp *int, player int, name, pos, pro string) models.LeaguePick {
+		return models.LeaguePick{
+			DraftID: draft, Season: season, TeamName: team, ManagerID: manager,
+			Round: round, OverallPick: overall, ADPRank: adp,
+			PlayerID: player, PlayerName: name, Position: pos, PlayerTeam: pro,
+		}
+	}
+	return []models.LeaguePick{
+		pick(1, 2024, "Aces", intPtr(1), 1, 1, intPtr(1), 10, "Bijan Robinson", "RB", "ATL"),
+		pick(1, 2024, "Bears", intPtr(2), 1, 2, intPtr(8), 11, "Josh Allen", "QB", "BUF"),
+		pick(1, 2024, "Bears", intPtr(2), 2, 3, intPtr(2), 12, "Ja'Marr Chase", "WR", "CIN"),
+		pick(1, 2024, "Aces", intPtr(1), 2, 4, intPtr(12), 13, "Drake London", "WR", "ATL"),
+		pick(2, 2025, "Aces", intPtr(1), 1, 1, intPtr(2), 10, "Bijan Robinson", "RB", "ATL"),
+		pick(2, 2025, "Bears", nil, 1, 2, intPtr(1), 12, "Ja'Marr Chase", "WR", "CIN"),
+		pick(2, 2025, "Bears", nil, 2, 3, nil, 14, "Brock Bowers", "TE", "LVR"),
+		pick(2, 2025, "Aces", intPtr(1), 2, 4, intPtr(3), 15, "Kyren Williams", "RB", "LAR"),
+	}
+}
+
+var testManagers = map[int]string{1: "Ann", 2: "Bob"}
+
+func TestTendencies(t *testing.T) {
+	tendencies := Tendencies(testPicks(), testManagers)
+	if len(tendencies) != 3 {
+		t.Fatalf("Tendencies() = %d managers, want Ann, Bears and Bob", len(tendencies))
+	}
+
+	ann := tendencies[0]
+	if ann.Manager != "Ann" || ann.Drafts != 2 || ann.Picks != 4 {
+		t.Fatalf("Ann = %+v", ann)
+	}
+	if ann.Positions["RB"] != 3 || ann.Share("RB") != 0.75 {
+		t.Errorf("Ann RB picks = %d (%.2f), want 3 (0.75)", ann.Positions["RB"], ann.Share("RB"))
+	}
+	if ann.FirstRound["RB"] != 1 || ann.FirstRound["WR"] != 2 {
+		t.Errorf("Ann first rounds = %v", ann.FirstRound)
+	}
+	// ADP minus pick: 0, +8, +1, -1.
+	if ann.ValuePicks != 4 || ann.Value != 2 {
+		t.Errorf("Ann value = %.2f over %d picks, want 2 over 4", ann.Value, ann.ValuePicks)
+	}
+	if len(ann.Seasons) != 2 || ann.Seasons[1].Season != 2025 || len(ann.Seasons[1].Picks) != 2 {
+		t.Errorf("Ann seasons = %+v", ann.Seasons)
+	}
+
+	if tendencies[1].Manager != "Bears" || tendencies[1].ValuePicks != 1 {
+		t.Errorf("unlinked team = %+v, want it under its team name", tendencies[1])
+	}
+}
+
+func TestSuperlatives(t *testing.T) {
+	records := make(map[string]Superlative)
+	for _, s := range Superlatives(testPicks(), testManagers) {
+		records[s.Title] = s
+	}
+
+	tests := []struct {
+		title, holder string
+		season        int
+	}{
+		{"Biggest Reach", "Bob", 2024},
+		{"Biggest Steal", "Ann", 2024},
+		{"Most Loyal", "Ann", 0},
+		{"Biggest Homer", "Ann", 2024},
+		{"Most Drafted Player", "Bijan Robinson", 0},
+		{"Most First Overall Picks", "Ann", 0},
+	}
+	for _, tt := range tests {
+		got, ok := records[tt.title]
+		if !ok {
+			t.Errorf("missing %q", tt.title)
+			continue
+		}
+		if got.Holder != tt.holder || got.Season != tt.season {
+			t.Errorf("%s = %+v, want %s in %d", tt.title, got, tt.holder, tt.season)
+		}
+	}
+
+	// Nobody has enough picks with an ADP for the average records.
+	if _, ok := records["Value Hunter"]; ok {
+		t.Error("Value Hunter needs more picks")
+	}
+}
+
+func TestEarliest(t *testing.T) {
+	records := Earliest(testPicks(), testManagers, []string{"QB", "RB", "WR", "TE", "K"})
+	if len(records) != 4 {
+		t.Fatalf("Earliest() = %+v, want QB, RB, WR and TE", records)
+	}
+	if records[2].Title != "Earliest WR" || records[2].Holder != "Bears" || records[2].Season != 2025 {
+		t.Errorf("Earliest WR = %+v", records[2])
+	}
+}
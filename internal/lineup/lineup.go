@@ -0,0 +1,153 @@
+package lineup
+
+import (
+	"sort"
+
+	"github.com/vibes/draft-board/internal/models"
+	"github.com/vibes/draft-board/internal/sport"
+)
+
+// Bench is the roster slot name for non-starting spots.
+const Bench = "BN"
+
+// Eligible returns the positions that may fill a roster slot: the flex
+// positions any sport defines for it, or else just the slot's own position.
+func Eligible(slot string) []string {
+	if positions := sport.FlexPositions(slot); positions != nil {
+		return positions
+	}
+	return []string{slot}
+}
+
+// CanFill reports whether a player at position may start in slot.
+func CanFill(slot, position string) bool {
+	for _, pos := range Eligible(slot) {
+		if pos == position {
+			return true
+		}
+	}
+	return false
+}
+
+// CanFillAny reports whether a player eligible at any of positions may
+// start in slot.
+func CanFillAny(slot string, positions []string) bool {
+	for _, pos := range positions {
+		if CanFill(slot, pos) {
+			return true
+		}
+	}
+	return false
+}
+
+// Entry is a rostered player with the points used to rank starters.
+// Positions lists every position a multi-position player may start at;
+// when empty, Position alone counts.
+type Entry struct {
+	PlayerID  int
+	Position  string
+	Positions []string
+	Points    float64
+}
+
+func (e Entry) canFill(slot string) bool {
+	if len(e.Positions) == 0 {
+		return CanFill(slot, e.Position)
+	}
+	return CanFillAny(slot, e.Positions)
+}
+
+// Assignment places a player in a starting slot. PlayerID is zero when the
+// slot could not be filled.
+type Assignment struct {
+	Slot  string
+	Entry Entry
+}
+
+// Lineup is the best starting lineup for a roster.
+type Lineup struct {
+	Starters      []Assignment
+	Bench         []Entry
+	StarterPoints float64
+	BenchPoints   float64
+	EmptySlots    int
+}
+
+// Optimal picks the highest-scoring legal lineup. Players are placed best
+// first, each into an open slot they can fill, narrowest slot first. When
+// none is open, starters already placed are moved between the slots they
+// can fill to make room, so a TE/WR can shift to WR to open the TE slot.
+// Placing players in points order this way maximizes starter points.
+func Optimal(players []Entry, slots []models.RosterSlot) Lineup {
+	pool := make([]Entry, len(players))
+	copy(pool, players)
+	sort.SliceStable(pool, func(i, j int) bool {
+		return pool[i].Points > pool[j].Points
+	})
+
+	ordered := make([]models.RosterSlot, 0, len(slots))
+	for _, s := range slots {
+		if s.Slot != Bench && s.Count > 0 {
+			ordered = append(ordered, s)
+		}
+	}
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return len(Eligible(ordered[i].Slot)) < len(Eligible(ordered[j].Slot))
+	})
+	var open []string
+	for _, s := range ordered {
+		for n := 0; n < s.Count; n++ {
+			open = append(open, s.Slot)
+		}
+	}
+
+	// owner holds the pool index of the player in each slot, or -1.
+	owner := make([]int, len(open))
+	for i := range owner {
+		owner[i] = -1
+	}
+	var place func(p int, moved []bool) bool
+	place = func(p int, moved []bool) bool {
+		for i, slot := range open {
+			if owner[i] == -1 && pool[p].canFill(slot) {
+				owner[i] = p
+				return true
+			}
+		}
+		for i, slot := range open {
+			if moved[i] || !pool[p].canFill(slot) {
+				continue
+			}
+			moved[i] = true
+			if place(owner[i], moved) {
+				owner[i] = p
+				return true
+			}
+		}
+		return false
+	}
+	for p := range pool {
+		place(p, make([]bool, len(open)))
+	}
+
+	var result Lineup
+	used := make([]bool, len(pool))
+	for i, slot := range open {
+		if owner[i] == -1 {
+			result.Starters = append(result.Starters, Assignment{Slot: slot})
+			result.EmptySlots++
+			continue
+		}
+		used[owner[i]] = true
+		result.Starters = append(result.Starters, Assignment{Slot: slot, Entry: pool[owner[i]]})
+		result.StarterPoints += pool[owner[i]].Points
+	}
+
+	for i, p := range pool {
+		if !used[i] {
+			result.Bench = append(result.Bench, p)
+			result.BenchPoints += p.Points
+		}
+	}
+	return result
+}
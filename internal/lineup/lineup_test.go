@@ -0,0 +1,127 @@
+package lineup
+
+import (
+	"testing"
+
+	"github.com/vibes/draft-board/internal/models"
+)
+
+func TestOptimal(t *testing.T) {
+	slots := []models.RosterSlot{
+		{Slot: "QB", Count: 1},
+		{Slot: "RB", Count: 1},
+		{Slot: "WR", Count: 1},
+		{Slot: "FLEX", Count: 1},
+		{Slot: "SUPERFLEX", Count: 1},
+		{Slot: Bench, Count: 5},
+	}
+	players := []Entry{
+		{PlayerID: 1, Position: "QB", Points: 350},
+		{PlayerID: 2, Position: "QB", Points: 300},
+		{PlayerID: 3, Position: "RB", Points: 250},
+		{PlayerID: 4, Position: "RB", Points: 200},
+		{PlayerID: 5, Position: "WR", Points: 220},
+		{PlayerID: 6, Position: "K", Points: 140},
+	}
+
+	got := Optimal(players, slots)
+
+	starters := make(map[string]int)
+	for _, a := range got.Starters {
+		starters[a.Slot] = a.Entry.PlayerID
+	}
+	want := map[string]int{"QB": 1, "RB": 3, "WR": 5, "FLEX": 4, "SUPERFLEX": 2}
+	for slot, id := range want {
+		if starters[slot] != id {
+			t.Errorf("slot %s = player %d, want %d", slot, starters[slot], id)
+		}
+	}
+	if got.StarterPoints != 1320 {
+		t.Errorf("StarterPoints = %v, want 1320", got.StarterPoints)
+	}
+	if len(got.Bench) != 1 || got.Bench[0].PlayerID != 6 || got.BenchPoints != 140 {
+		t.Errorf("Bench = %+v, BenchPoints = %v", got.Bench, got.BenchPoints)
+	}
+	if got.EmptySlots != 0 {
+		t.Errorf("EmptySlots = %d, want 0", got.EmptySlots)
+	}
+}
+
+func TestOptimal_EmptySlots(t *testing.T) {
+	slots := []models.RosterSlot{
+		{Slot: "QB", Count: 1},
+		{Slot: "TE", Count: 1},
+		{Slot: "FLEX", Count: 1},
+	}
+	players := []Entry{
+		{PlayerID: 1, Position: "QB", Points: 300},
+		{PlayerID: 2, Position: "QB", Points: 280},
+	}
+
+	got := Optimal(players, slots)
+	if got.EmptySlots != 2 {
+		t.Errorf("EmptySlots = %d, want 2", got.EmptySlots)
+	}
+	if got.StarterPoints != 300 {
+		t.Errorf("StarterPoints = %v, want 300", got.StarterPoints)
+	}
+	if len(got.Starters) != 3 {
+		t.Errorf("len(Starters) = %d, want 3", len(got.Starters))
+	}
+}
+
+func TestOptimal_MultiPosition(t *testing.T) {
+	slots := []models.RosterSlot{
+		{Slot: "WR", Count: 1},
+		{Slot: "TE", Count: 1},
+		{Slot: "IDP", Count: 1},
+		{Slot: "LB", Count: 1},
+	}
+	players := []Entry{
+		{PlayerID: 1, Position: "TE", Positions: []string{"TE", "WR"}, Points: 200},
+		{PlayerID: 2, Position: "TE", Points: 150},
+		{PlayerID: 3, Position: "DL", Positions: []string{"DL", "LB"}, Points: 120},
+		{PlayerID: 4, Position: "DB", Points: 100},
+	}
+
+	got := Optimal(players, slots)
+
+	starters := make(map[string]int)
+	for _, a := range got.Starters {
+		starters[a.Slot] = a.Entry.PlayerID
+	}
+	want := map[string]int{"WR": 1, "TE": 2, "LB": 3, "IDP": 4}
+	for slot, id := range want {
+		if starters[slot] != id {
+			t.Errorf("slot %s = player %d, want %d", slot, starters[slot], id)
+		}
+	}
+	if got.EmptySlots != 0 || got.StarterPoints != 570 {
+		t.Errorf("EmptySlots = %d, StarterPoints = %v, want 0 and 570", got.EmptySlots, got.StarterPoints)
+	}
+}
+
+func TestCanFill(t *testing.T) {
+	tests := []struct {
+		slot     string
+		position string
+		want     bool
+	}{
+		{"QB", "QB", true},
+		{"QB", "RB", false},
+		{"FLEX", "TE", true},
+		{"FLEX", "QB", false},
+		{"SUPERFLEX", "QB", true},
+		{"IDP", "LB", true},
+		{"G", "PG", true},
+		{"F", "C", false},
+		{"UTIL", "OF", true},
+		{"UTIL", "SP", false},
+	}
+
+	for _, tt := range tests {
+		if got := CanFill(tt.slot, tt.position); got != tt.want {
+			t.Errorf("CanFill(%q, %q) = %v, want %v", tt.slot, tt.position, got, tt.want)
+		}
+	}
+}
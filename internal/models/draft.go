@@ -15,6 +15,30 @@ type Draft struct {
 	CommissionerID  string    `db:"commissioner_id"`
 	CreatedAt       time.Time `db:"created_at"`
 	Completed       bool      `db:"completed"`
+	Season          int       `db:"season"`
+	Sport           string    `db:"sport"`
+	LeagueID        *int      `db:"league_id"`
+
+	// Rookie drafts draft only the season's rookies onto rosters carried
+	// over from PreviousDraftID.
+	Rookie          bool `db:"rookie"`
+	PreviousDraftID *int `db:"previous_draft_id"`
+}
+
+// Snakes reports whether the draft order reverses every round. Rookie
+// drafts are created with snake_draft off, so they go in the same order
+// every round.
+func (d *Draft) Snakes() bool {
+	return d.SnakeDraft
+}
+
+// RookieSeason is the rookie class a rookie draft drafts from, or 0 for
+// drafts open to every player.
+func (d *Draft) RookieSeason() int {
+	if d.Rookie {
+		return d.Season
+	}
+	return 0
 }
 
 func (d *Draft) IsActive() bool {
@@ -137,6 +137,38 @@ func TestDraft_IsPaused(t *testing.T) {
 	}
 }
 
+func TestDraft_Snakes(t *testing.T) {
+	tests := []struct {
+		name  string
+		draft Draft
+		want  bool
+	}{
+		{
+			name:  "snake draft",
+			draft: Draft{SnakeDraft: true},
+			want:  true,
+		},
+		{
+			name:  "linear draft",
+			draft: Draft{SnakeDraft: false},
+			want:  false,
+		},
+		{
+			name:  "snaking rookie draft",
+			draft: Draft{SnakeDraft: true, Rookie: true},
+			want:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.draft.Snakes(); got != tt.want {
+				t.Errorf("Draft.Snakes() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestDraft_CanMakePicks(t *testing.T) {
 	tests := []struct {
 		name   string
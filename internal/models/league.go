@@ -0,0 +1,49 @@
+package models
+
+import "time"
+
+// League groups drafts across seasons. Its franchises and managers persist
+// from one season's draft to the next.
+type League struct {
+	ID        int       `db:"id"`
+	Name      string    `db:"name"`
+	Sport     string    `db:"sport"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// Manager is a person who runs a franchise in a league.
+type Manager struct {
+	ID        int       `db:"id"`
+	LeagueID  int       `db:"league_id"`
+	Name      string    `db:"name"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// Franchise is a league team that persists across seasons. ManagerID is
+// who runs it now; each season's team records who ran it then.
+type Franchise struct {
+	ID        int       `db:"id"`
+	LeagueID  int       `db:"league_id"`
+	Name      string    `db:"name"`
+	ManagerID *int      `db:"manager_id"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// LeaguePick is a pick made in one of a league's drafts with the season,
+// team and player it was made for.
+type LeaguePick struct {
+	DraftID     int
+	DraftName   string
+	Season      int
+	TeamID      int
+	TeamName    string
+	FranchiseID *int
+	ManagerID   *int
+	Round       int
+	OverallPick int
+	ADPRank     *int
+	PlayerID    int
+	PlayerName  string
+	Position    string
+	PlayerTeam  string
+}
@@ -0,0 +1,18 @@
+package models
+
+import "time"
+
+// PlayerMerge audits one duplicate player merged into another. The merged
+// player no longer exists, so its name, team and position are kept here.
+type PlayerMerge struct {
+	ID             int       `db:"id"`
+	KeptPlayerID   int       `db:"kept_player_id"`
+	MergedPlayerID int       `db:"merged_player_id"`
+	MergedName     string    `db:"merged_name"`
+	MergedTeam     string    `db:"merged_team"`
+	MergedPosition string    `db:"merged_position"`
+	PicksMoved     int       `db:"picks_moved"`
+	QueueMoved     int       `db:"queue_moved"`
+	NotesMoved     int       `db:"notes_moved"`
+	MergedAt       time.Time `db:"merged_at"`
+}
@@ -0,0 +1,29 @@
+package models
+
+import "strings"
+
+// NFLTeam is an NFL franchise under its canonical abbreviation, with the
+// other abbreviations sources use for it. ByeWeek is the current season's
+// bye, nil until it is known.
+type NFLTeam struct {
+	ID         int    `db:"id"`
+	Abbr       string `db:"abbr"`
+	Name       string `db:"name"`
+	Conference string `db:"conference"`
+	Division   string `db:"division"`
+	ByeWeek    *int   `db:"bye_week"`
+	Aliases    string `db:"aliases"`
+}
+
+// AliasList returns the team's alternate abbreviations.
+func (t *NFLTeam) AliasList() []string {
+	if t.Aliases == "" {
+		return nil
+	}
+	return strings.Split(t.Aliases, ",")
+}
+
+// DivisionName is the full division name, e.g. "AFC North".
+func (t *NFLTeam) DivisionName() string {
+	return t.Conference + " " + t.Division
+}
@@ -0,0 +1,43 @@
+package models
+
+import (
+	"strings"
+	"time"
+)
+
+// PlayerNote is a manager's annotation on a player for one draft: a
+// free-form note and tags such as "sleeper" or "avoid". A TeamID of 0
+// shares it with the whole draft; otherwise only that team sees it.
+type PlayerNote struct {
+	ID        int       `db:"id"`
+	DraftID   int       `db:"draft_id"`
+	TeamID    int       `db:"team_id"`
+	PlayerID  int       `db:"player_id"`
+	Note      string    `db:"note"`
+	Tags      string    `db:"tags"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+// TagList returns the note's tags.
+func (n *PlayerNote) TagList() []string {
+	if n.Tags == "" {
+		return nil
+	}
+	return strings.Split(n.Tags, ",")
+}
+
+// NormalizeTags turns a comma-separated tag list as typed into the stored
+// form: lower case, trimmed, without blanks or repeats, in the order given.
+func NormalizeTags(raw string) string {
+	seen := make(map[string]bool)
+	var tags []string
+	for _, tag := range strings.Split(raw, ",") {
+		tag = strings.ToLower(strings.Join(strings.Fields(tag), " "))
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		tags = append(tags, tag)
+	}
+	return strings.Join(tags, ",")
+}
@@ -0,0 +1,36 @@
+package models
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNormalizeTags(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		want string
+	}{
+		{name: "empty", raw: "", want: ""},
+		{name: "single", raw: "Sleeper", want: "sleeper"},
+		{name: "trims and collapses spaces", raw: " avoid  -  injury , handcuff", want: "avoid - injury,handcuff"},
+		{name: "drops blanks and repeats", raw: "sleeper,,SLEEPER, ,value", want: "sleeper,value"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NormalizeTags(tt.raw); got != tt.want {
+				t.Errorf("NormalizeTags(%q) = %q, want %q", tt.raw, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPlayerNote_TagList(t *testing.T) {
+	if got := (&PlayerNote{}).TagList(); got != nil {
+		t.Errorf("TagList() = %v, want nil", got)
+	}
+	note := &PlayerNote{Tags: "sleeper,handcuff"}
+	if got := note.TagList(); !reflect.DeepEqual(got, []string{"sleeper", "handcuff"}) {
+		t.Errorf("TagList() = %v, want [sleeper handcuff]", got)
+	}
+}
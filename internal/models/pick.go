@@ -14,3 +14,14 @@ type Pick struct {
 	PickedAt    time.Time `db:"picked_at"`
 }
 
+// ImportedPick is a pick of a draft brought in from outside the app. The
+// drafting team is given by draft position, and the player is either an
+// existing one or, when NewPlayer is set, a custom player to create.
+type ImportedPick struct {
+	Round         int
+	OverallPick   int
+	DraftPosition int
+	PlayerID      int
+	NewPlayer     *Player
+	ADPRank       *int
+}
@@ -0,0 +1,9 @@
+package models
+
+// PlatformID maps a player to their ID on a fantasy hosting site, keyed by
+// the site's platform exporter key.
+type PlatformID struct {
+	PlayerID   int    `db:"player_id"`
+	Platform   string `db:"platform"`
+	PlatformID string `db:"platform_id"`
+}
@@ -1,6 +1,9 @@
 package models
 
-import "time"
+import (
+	"strings"
+	"time"
+)
 
 type Player struct {
 	ID            int       `db:"id"`
@@ -15,17 +18,138 @@ type Player struct {
 	PPRRank       *int      `db:"ppr_rank"`
 	IsCustom      bool      `db:"is_custom"`
 	CreatedAt     time.Time `db:"created_at"`
+
+	Status          string     `db:"status"`
+	StatusNote      string     `db:"status_note"`
+	StatusUpdatedAt *time.Time `db:"status_updated_at"`
+
+	// EligiblePositions lists, comma-separated, the positions besides
+	// Position the player may start at, such as WR for a TE/WR.
+	EligiblePositions string `db:"eligible_positions"`
+
+	// Sport is the key of the sport profile the player belongs to.
+	Sport string `db:"sport"`
+
+	// RookieSeason is the season the player was a rookie, when known.
+	RookieSeason *int `db:"rookie_season"`
+}
+
+// ParsePositions reads a list of positions separated by commas or slashes,
+// upper-cased, without blanks or repeats. "D/ST" is kept whole.
+func ParsePositions(raw string) []string {
+	raw = strings.ToUpper(raw)
+	raw = strings.ReplaceAll(raw, "D/ST", "DST")
+	fields := strings.FieldsFunc(raw, func(r rune) bool { return r == ',' || r == '/' || r == ' ' })
+	var positions []string
+	seen := make(map[string]bool)
+	for _, pos := range fields {
+		if pos == "DST" {
+			pos = "D/ST"
+		}
+		if !seen[pos] {
+			seen[pos] = true
+			positions = append(positions, pos)
+		}
+	}
+	return positions
+}
+
+// Positions returns every position the player may start at, their primary
+// position first.
+func (p *Player) Positions() []string {
+	positions := []string{p.Position}
+	for _, pos := range ParsePositions(p.EligiblePositions) {
+		if pos != p.Position {
+			positions = append(positions, pos)
+		}
+	}
+	return positions
+}
+
+// EligibleAt reports whether the player may start at position.
+func (p *Player) EligibleAt(position string) bool {
+	for _, pos := range p.Positions() {
+		if pos == position {
+			return true
+		}
+	}
+	return false
+}
+
+// PositionLabel shows every position the player is eligible at, e.g. "TE/WR".
+func (p *Player) PositionLabel() string {
+	return strings.Join(p.Positions(), "/")
+}
+
+// Player statuses. Players are active unless an import or a manager says
+// otherwise.
+const (
+	StatusActive       = "active"
+	StatusQuestionable = "questionable"
+	StatusOut          = "out"
+	StatusIR           = "ir"
+	StatusSuspended    = "suspended"
+	StatusFreeAgent    = "free_agent"
+	StatusRetired      = "retired"
+)
+
+// PlayerStatuses lists every player status in display order.
+var PlayerStatuses = []string{StatusActive, StatusQuestionable, StatusOut, StatusIR, StatusSuspended, StatusFreeAgent, StatusRetired}
+
+// statusAliases maps the abbreviations injury reports use to statuses.
+var statusAliases = map[string]string{
+	"":                StatusActive,
+	"a":               StatusActive,
+	"act":             StatusActive,
+	"healthy":         StatusActive,
+	"q":               StatusQuestionable,
+	"d":               StatusQuestionable,
+	"doubtful":        StatusQuestionable,
+	"o":               StatusOut,
+	"injured reserve": StatusIR,
+	"injured_reserve": StatusIR,
+	"pup":             StatusIR,
+	"nfi":             StatusIR,
+	"susp":            StatusSuspended,
+	"sus":             StatusSuspended,
+	"fa":              StatusFreeAgent,
+	"free agent":      StatusFreeAgent,
+	"ufa":             StatusFreeAgent,
+	"ret":             StatusRetired,
+}
+
+// ParseStatus reads a status as written in an import, accepting the status
+// names and common injury report abbreviations in any case.
+func ParseStatus(raw string) (string, bool) {
+	status := strings.ToLower(strings.TrimSpace(raw))
+	if alias, ok := statusAliases[status]; ok {
+		return alias, true
+	}
+	for _, s := range PlayerStatuses {
+		if status == s {
+			return s, true
+		}
+	}
+	return "", false
+}
+
+// IsInjured reports whether the player is hurt or otherwise kept off the
+// field for now.
+func (p *Player) IsInjured() bool {
+	switch p.Status {
+	case StatusQuestionable, StatusOut, StatusIR, StatusSuspended:
+		return true
+	}
+	return false
 }
 
 func (p *Player) GetADPRank(draftType, scoringFormat string) *int {
-	if draftType == "Dynasty" {
+	switch RankFormat(draftType, scoringFormat) {
+	case FormatDynasty:
 		return p.DynastyRank
-	}
-	
-	switch scoringFormat {
-	case "PPR":
+	case FormatPPR:
 		return p.PPRRank
-	case "Half-PPR":
+	case FormatHalfPPR:
 		return p.HalfPPRRank
 	default:
 		return p.StdRank
@@ -0,0 +1,42 @@
+package models
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParsePositions(t *testing.T) {
+	tests := []struct {
+		raw  string
+		want []string
+	}{
+		{"", nil},
+		{"wr", []string{"WR"}},
+		{"TE/WR", []string{"TE", "WR"}},
+		{"dl, lb,DL", []string{"DL", "LB"}},
+		{"D/ST", []string{"D/ST"}},
+	}
+	for _, tt := range tests {
+		if got := ParsePositions(tt.raw); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ParsePositions(%q) = %v, want %v", tt.raw, got, tt.want)
+		}
+	}
+}
+
+func TestPlayer_Positions(t *testing.T) {
+	p := &Player{Position: "TE", EligiblePositions: "WR,TE"}
+	if got := p.Positions(); !reflect.DeepEqual(got, []string{"TE", "WR"}) {
+		t.Errorf("Positions() = %v, want [TE WR]", got)
+	}
+	if got := p.PositionLabel(); got != "TE/WR" {
+		t.Errorf("PositionLabel() = %q, want TE/WR", got)
+	}
+	if !p.EligibleAt("WR") || p.EligibleAt("RB") {
+		t.Errorf("EligibleAt() wrong for %v", p.Positions())
+	}
+
+	single := &Player{Position: "LB"}
+	if got := single.PositionLabel(); got != "LB" {
+		t.Errorf("PositionLabel() = %q, want LB", got)
+	}
+}
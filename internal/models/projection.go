@@ -0,0 +1,11 @@
+package models
+
+import "time"
+
+// Projection holds a player's projected season stat line keyed by stat
+// category (see the scoring package for the known categories).
+type Projection struct {
+	PlayerID  int                `db:"player_id"`
+	Stats     map[string]float64 `db:"-"`
+	UpdatedAt time.Time          `db:"updated_at"`
+}
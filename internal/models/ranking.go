@@ -0,0 +1,87 @@
+package models
+
+import "time"
+
+// DraftRanking is one player's place on a draft's own cheat sheet. When a
+// draft has rankings they replace the global ADP ranks for that draft.
+type DraftRanking struct {
+	ID       int `db:"id"`
+	DraftID  int `db:"draft_id"`
+	PlayerID int `db:"player_id"`
+	Rank     int `db:"rank"`
+}
+
+// Rank formats a ranking source can hold ranks for. They match the player
+// rank columns of the same name.
+const (
+	FormatStd     = "std"
+	FormatHalfPPR = "half_ppr"
+	FormatPPR     = "ppr"
+	FormatDynasty = "dynasty"
+	FormatSF      = "sf"
+)
+
+// RankFormats lists every rank format in display order.
+var RankFormats = []string{FormatPPR, FormatHalfPPR, FormatStd, FormatDynasty, FormatSF}
+
+// scoringRankFormats maps each draft scoring format to the rank format its
+// drafts draft from. Basketball and baseball keep points ranks in the std
+// column and category and roto ranks in the ppr column, as their sport
+// profiles list them.
+var scoringRankFormats = map[string]string{
+	"PPR":        FormatPPR,
+	"Half-PPR":   FormatHalfPPR,
+	"Standard":   FormatStd,
+	"Points":     FormatStd,
+	"Categories": FormatPPR,
+	"Roto":       FormatPPR,
+}
+
+// RankFormat returns the rank format a draft of the given type and scoring
+// format drafts from.
+func RankFormat(draftType, scoringFormat string) string {
+	if draftType == "Dynasty" {
+		return FormatDynasty
+	}
+	if format, ok := scoringRankFormats[scoringFormat]; ok {
+		return format
+	}
+	return FormatStd
+}
+
+// Ranking source kinds. Imported sources hold uploaded rankings; ADP
+// sources hold the historical ADP of our own drafts, and are left out of
+// the consensus so drafts aren't averaged into the rankings they follow.
+const (
+	SourceKindImported = "imported"
+	SourceKindADP      = "adp"
+)
+
+// RankingSource is a named set of rankings for one season, such as one
+// expert's cheat sheet, holding ranks for any of the rank formats.
+type RankingSource struct {
+	ID        int       `db:"id"`
+	Name      string    `db:"name"`
+	CreatedAt time.Time `db:"created_at"`
+	Season    int       `db:"season"`
+	Kind      string    `db:"kind"`
+}
+
+// SourceRank is a player's rank in one format of a ranking source.
+type SourceRank struct {
+	ID       int    `db:"id"`
+	SourceID int    `db:"source_id"`
+	PlayerID int    `db:"player_id"`
+	Format   string `db:"format"`
+	Rank     int    `db:"rank"`
+}
+
+// DraftRankSource selects the ranks a draft uses for ADP: one ranking
+// source, or the consensus of all of them. Drafts without one use the
+// player rank columns.
+type DraftRankSource struct {
+	ID        int  `db:"id"`
+	DraftID   int  `db:"draft_id"`
+	SourceID  *int `db:"source_id"`
+	Consensus bool `db:"consensus"`
+}
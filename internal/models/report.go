@@ -0,0 +1,12 @@
+package models
+
+import "time"
+
+// DraftReport is the stored report card for a completed draft. Report holds
+// the JSON snapshot taken at completion so grades never shift afterwards.
+type DraftReport struct {
+	ID          int       `db:"id"`
+	DraftID     int       `db:"draft_id"`
+	Report      string    `db:"report"`
+	GeneratedAt time.Time `db:"generated_at"`
+}
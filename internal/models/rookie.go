@@ -0,0 +1,34 @@
+package models
+
+import "time"
+
+// FuturePick is a league's pick in a draft not yet created, traded away
+// from the franchise it originally belonged to.
+type FuturePick struct {
+	ID                  int       `db:"id"`
+	LeagueID            int       `db:"league_id"`
+	Season              int       `db:"season"`
+	Round               int       `db:"round"`
+	OriginalFranchiseID int       `db:"original_franchise_id"`
+	OwnerFranchiseID    int       `db:"owner_franchise_id"`
+	Note                string    `db:"note"`
+	CreatedAt           time.Time `db:"created_at"`
+}
+
+// CarriedPlayer is a player already on a rookie draft team's roster,
+// carried over from the league's previous draft.
+type CarriedPlayer struct {
+	DraftID  int `db:"draft_id"`
+	TeamID   int `db:"team_id"`
+	PlayerID int `db:"player_id"`
+}
+
+// RookieDraftPlan is a rookie draft ready to be created: its teams in draft
+// order, the players each team carries over, and the picks traded away
+// from the team whose turn it is. Rosters and Owners refer to teams by
+// draft position.
+type RookieDraftPlan struct {
+	Teams   []Team
+	Rosters map[int][]int
+	Owners  map[int]int
+}
@@ -0,0 +1,11 @@
+package models
+
+// RosterSlot is the number of lineup spots of one kind a draft's teams
+// start each week. Slot is a position (QB, RB, ...) or a flex slot such as
+// FLEX or SUPERFLEX; BN counts bench spots.
+type RosterSlot struct {
+	ID      int    `db:"id"`
+	DraftID int    `db:"draft_id"`
+	Slot    string `db:"slot"`
+	Count   int    `db:"count"`
+}
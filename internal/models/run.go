@@ -0,0 +1,13 @@
+package models
+
+// RunSettings controls positional run alerts for a draft. A run fires when
+// Threshold of the last Window picks share a position; a tier break fires
+// when the best remaining player at the drafted position ranks at least
+// TierGap spots below the player just taken.
+type RunSettings struct {
+	ID        int `db:"id"`
+	DraftID   int `db:"draft_id"`
+	Window    int `db:"pick_window"`
+	Threshold int `db:"threshold"`
+	TierGap   int `db:"tier_gap"`
+}
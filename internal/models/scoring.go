@@ -0,0 +1,12 @@
+package models
+
+// ScoringRule awards Points per unit of Stat in a draft's scoring. An empty
+// Position applies to every player; otherwise the rule overrides the base
+// value for that position only.
+type ScoringRule struct {
+	ID       int     `db:"id"`
+	DraftID  int     `db:"draft_id"`
+	Position string  `db:"position"`
+	Stat     string  `db:"stat"`
+	Points   float64 `db:"points"`
+}
@@ -0,0 +1,12 @@
+package models
+
+import "time"
+
+// Season is an NFL season the database holds players and rankings for.
+// Exactly one season is current; the players table holds its data.
+type Season struct {
+	ID        int       `db:"id"`
+	Year      int       `db:"year"`
+	IsCurrent bool      `db:"is_current"`
+	CreatedAt time.Time `db:"created_at"`
+}
@@ -6,5 +6,10 @@ type Team struct {
 	TeamName      string `db:"team_name"`
 	OwnerName     string `db:"owner_name"`
 	DraftPosition int    `db:"draft_position"`
+
+	// FranchiseID and ManagerID link the team to its league franchise and
+	// the manager who ran it that season, once its draft joins a league.
+	FranchiseID *int `db:"franchise_id"`
+	ManagerID   *int `db:"manager_id"`
 }
 
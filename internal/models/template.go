@@ -0,0 +1,98 @@
+package models
+
+import "time"
+
+// DraftTemplate is a saved draft configuration new drafts can be created
+// from. Config holds the DraftConfig as JSON.
+type DraftTemplate struct {
+	ID        int       `db:"id"`
+	Name      string    `db:"name"`
+	Config    string    `db:"config"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// DraftConfig is everything about a draft that carries over to the next
+// one: its settings, teams, roster slots, scoring rules and run alerts.
+// Custom scoring rules and run settings are empty when the draft used the
+// defaults.
+type DraftConfig struct {
+	Sport         string        `json:"sport"`
+	NumTeams      int           `json:"num_teams"`
+	ScoringFormat string        `json:"scoring_format"`
+	DraftType     string        `json:"draft_type"`
+	QBSetting     string        `json:"qb_setting"`
+	SnakeDraft    bool          `json:"snake_draft"`
+	MaxRounds     int           `json:"max_rounds"`
+	Teams         []ConfigTeam  `json:"teams"`
+	RosterSlots   []ConfigSlot  `json:"roster_slots"`
+	ScoringRules  []ConfigRule  `json:"scoring_rules,omitempty"`
+	RunSettings   *ConfigAlerts `json:"run_settings,omitempty"`
+}
+
+// ConfigTeam is a team as saved in a draft configuration.
+type ConfigTeam struct {
+	TeamName      string `json:"team_name"`
+	OwnerName     string `json:"owner_name"`
+	DraftPosition int    `json:"draft_position"`
+}
+
+// ConfigSlot is a roster slot as saved in a draft configuration.
+type ConfigSlot struct {
+	Slot  string `json:"slot"`
+	Count int    `json:"count"`
+}
+
+// ConfigRule is a scoring rule as saved in a draft configuration.
+type ConfigRule struct {
+	Position string  `json:"position,omitempty"`
+	Stat     string  `json:"stat"`
+	Points   float64 `json:"points"`
+}
+
+// ConfigAlerts are run alert settings as saved in a draft configuration.
+type ConfigAlerts struct {
+	Window    int `json:"window"`
+	Threshold int `json:"threshold"`
+	TierGap   int `json:"tier_gap"`
+}
+
+// NewDraftConfig captures a draft's configuration. runs may be nil.
+func NewDraftConfig(draft *Draft, teams []Team, slots []RosterSlot, rules []ScoringRule, runs *RunSettings) *DraftConfig {
+	cfg := &DraftConfig{
+		Sport:         draft.Sport,
+		NumTeams:      draft.NumTeams,
+		ScoringFormat: draft.ScoringFormat,
+		DraftType:     draft.DraftType,
+		QBSetting:     draft.QBSetting,
+		SnakeDraft:    draft.SnakeDraft,
+		MaxRounds:     draft.MaxRounds,
+	}
+	for _, team := range teams {
+		cfg.Teams = append(cfg.Teams, ConfigTeam{TeamName: team.TeamName, OwnerName: team.OwnerName, DraftPosition: team.DraftPosition})
+	}
+	for _, slot := range slots {
+		cfg.RosterSlots = append(cfg.RosterSlots, ConfigSlot{Slot: slot.Slot, Count: slot.Count})
+	}
+	for _, rule := range rules {
+		cfg.ScoringRules = append(cfg.ScoringRules, ConfigRule{Position: rule.Position, Stat: rule.Stat, Points: rule.Points})
+	}
+	if runs != nil {
+		cfg.RunSettings = &ConfigAlerts{Window: runs.Window, Threshold: runs.Threshold, TierGap: runs.TierGap}
+	}
+	return cfg
+}
+
+// Draft returns a new draft in setup with the configuration's settings.
+func (c *DraftConfig) Draft(name string) *Draft {
+	return &Draft{
+		Name:          name,
+		NumTeams:      c.NumTeams,
+		ScoringFormat: c.ScoringFormat,
+		DraftType:     c.DraftType,
+		QBSetting:     c.QBSetting,
+		SnakeDraft:    c.SnakeDraft,
+		Status:        "setup",
+		MaxRounds:     c.MaxRounds,
+		Sport:         c.Sport,
+	}
+}
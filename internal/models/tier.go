@@ -0,0 +1,12 @@
+package models
+
+// PlayerTier places a player in a tier within their position for one
+// ranking source. Sources are the global format ranks ("ppr", "half_ppr",
+// "std", "dynasty") or a draft's own rankings ("draft:<id>").
+type PlayerTier struct {
+	ID       int    `db:"id"`
+	Source   string `db:"source"`
+	PlayerID int    `db:"player_id"`
+	Position string `db:"position"`
+	Tier     int    `db:"tier"`
+}
@@ -0,0 +1,111 @@
+package names
+
+import (
+	"strings"
+	"unicode"
+)
+
+// suffixes are generational suffixes dropped when comparing player names.
+var suffixes = map[string]bool{
+	"jr": true, "sr": true, "ii": true, "iii": true, "iv": true, "v": true,
+}
+
+// folds maps accented Latin letters to their unaccented form.
+var folds = map[rune]string{
+	'à': "a", 'á': "a", 'â': "a", 'ã': "a", 'ä': "a", 'å': "a", 'ā': "a", 'ă': "a", 'ą': "a",
+	'æ': "ae", 'ç': "c", 'ć': "c", 'č': "c", 'ď': "d", 'đ': "d",
+	'è': "e", 'é': "e", 'ê': "e", 'ë': "e", 'ē': "e", 'ė': "e", 'ę': "e", 'ě': "e",
+	'ì': "i", 'í': "i", 'î': "i", 'ï': "i", 'ī': "i", 'į': "i", 'ı': "i",
+	'ł': "l", 'ñ': "n", 'ń': "n", 'ň': "n",
+	'ò': "o", 'ó': "o", 'ô': "o", 'õ': "o", 'ö': "o", 'ø': "o", 'ō': "o", 'ő': "o", 'œ': "oe",
+	'ŕ': "r", 'ř': "r", 'ś': "s", 'š': "s", 'ş': "s", 'ß': "ss", 'ť': "t", 'ţ': "t",
+	'ù': "u", 'ú': "u", 'û': "u", 'ü': "u", 'ū': "u", 'ů': "u", 'ű': "u",
+	'ý': "y", 'ÿ': "y", 'ź': "z", 'ż': "z", 'ž': "z",
+}
+
+// Normalize reduces a player name to a comparable key: lower case, with
+// accents folded, punctuation removed and generational suffixes dropped, so
+// that "Ja'Marr Chase" and "JaMarr Chase" or "Patrick Mahomes II" and
+// "Patrick Mahomes" compare equal.
+func Normalize(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if folded, ok := folds[r]; ok {
+			b.WriteString(folded)
+			continue
+		}
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			b.WriteRune(r)
+		case unicode.IsSpace(r) || r == '-':
+			b.WriteRune(' ')
+		}
+	}
+
+	words := strings.Fields(b.String())
+	for len(words) > 1 && suffixes[words[len(words)-1]] {
+		words = words[:len(words)-1]
+	}
+	return strings.Join(words, " ")
+}
+
+// Distance is the Levenshtein edit distance between two strings.
+func Distance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
+
+// Similar reports whether a search query loosely matches a player name.
+// Every query word must start, or nearly start, some word of the name;
+// words of three to five letters allow one typo and longer words two.
+func Similar(query, name string) bool {
+	queryWords := strings.Fields(Normalize(query))
+	nameWords := strings.Fields(Normalize(name))
+	if len(queryWords) == 0 || len(nameWords) == 0 {
+		return false
+	}
+	if strings.Join(queryWords, "") == strings.Join(nameWords, "") {
+		return true
+	}
+
+	for _, q := range queryWords {
+		allowed := 1
+		switch {
+		case len(q) <= 2:
+			allowed = 0
+		case len(q) > 5:
+			allowed = 2
+		}
+		matched := false
+		for _, n := range nameWords {
+			prefix := n
+			if len(prefix) > len(q) {
+				prefix = prefix[:len(q)]
+			}
+			if Distance(q, prefix) <= allowed || Distance(q, n) <= allowed {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,68 @@
+package names
+
+import "testing"
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{name: "apostrophe", in: "Ja'Marr Chase", want: "jamarr chase"},
+		{name: "generational suffix", in: "Patrick Mahomes II", want: "patrick mahomes"},
+		{name: "suffix with period", in: "Brian Thomas Jr.", want: "brian thomas"},
+		{name: "hyphenated", in: "Amon-Ra St. Brown", want: "amon ra st brown"},
+		{name: "extra whitespace", in: "  CeeDee   Lamb ", want: "ceedee lamb"},
+		{name: "accents", in: "José Ramírez", want: "jose ramirez"},
+		{name: "single word kept", in: "V", want: "v"},
+		{name: "empty", in: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Normalize(tt.in); got != tt.want {
+				t.Errorf("Normalize(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDistance(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"chase", "chase", 0},
+		{"chse", "chase", 1},
+		{"jamar", "jamarr", 1},
+		{"kitten", "sitting", 3},
+		{"", "abc", 3},
+	}
+	for _, tt := range tests {
+		if got := Distance(tt.a, tt.b); got != tt.want {
+			t.Errorf("Distance(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestSimilar(t *testing.T) {
+	tests := []struct {
+		query string
+		name  string
+		want  bool
+	}{
+		{query: "jamarr chase", name: "Ja'Marr Chase", want: true},
+		{query: "ja marr chase", name: "Ja'Marr Chase", want: true},
+		{query: "jamar chse", name: "Ja'Marr Chase", want: true},
+		{query: "mccaffery", name: "Christian McCaffrey", want: true},
+		{query: "jefferson", name: "Justin Jefferson", want: true},
+		{query: "jose", name: "José Ramírez", want: true},
+		{query: "allen", name: "Ja'Marr Chase", want: false},
+		{query: "", name: "Ja'Marr Chase", want: false},
+	}
+	for _, tt := range tests {
+		if got := Similar(tt.query, tt.name); got != tt.want {
+			t.Errorf("Similar(%q, %q) = %v, want %v", tt.query, tt.name, got, tt.want)
+		}
+	}
+}
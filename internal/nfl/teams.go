@@ -0,0 +1,87 @@
+package nfl
+
+import (
+	"strings"
+
+	"github.com/vibes/draft-board/internal/models"
+)
+
+func bye(week int) *int { return &week }
+
+// Teams are the 32 franchises under the abbreviations the player data uses,
+// with the abbreviations other sources use for them. Bye weeks are for the
+// 2025 season; the database copy is edited as schedules come out.
+var Teams = []models.NFLTeam{
+	{Abbr: "ARI", Name: "Arizona Cardinals", Conference: "NFC", Division: "West", ByeWeek: bye(8), Aliases: "ARZ"},
+	{Abbr: "ATL", Name: "Atlanta Falcons", Conference: "NFC", Division: "South", ByeWeek: bye(5)},
+	{Abbr: "BAL", Name: "Baltimore Ravens", Conference: "AFC", Division: "North", ByeWeek: bye(7), Aliases: "BLT"},
+	{Abbr: "BUF", Name: "Buffalo Bills", Conference: "AFC", Division: "East", ByeWeek: bye(7)},
+	{Abbr: "CAR", Name: "Carolina Panthers", Conference: "NFC", Division: "South", ByeWeek: bye(14)},
+	{Abbr: "CHI", Name: "Chicago Bears", Conference: "NFC", Division: "North", ByeWeek: bye(5)},
+	{Abbr: "CIN", Name: "Cincinnati Bengals", Conference: "AFC", Division: "North", ByeWeek: bye(10)},
+	{Abbr: "CLE", Name: "Cleveland Browns", Conference: "AFC", Division: "North", ByeWeek: bye(9), Aliases: "CLV"},
+	{Abbr: "DAL", Name: "Dallas Cowboys", Conference: "NFC", Division: "East", ByeWeek: bye(10)},
+	{Abbr: "DEN", Name: "Denver Broncos", Conference: "AFC", Division: "West", ByeWeek: bye(12)},
+	{Abbr: "DET", Name: "Detroit Lions", Conference: "NFC", Division: "North", ByeWeek: bye(8)},
+	{Abbr: "GBP", Name: "Green Bay Packers", Conference: "NFC", Division: "North", ByeWeek: bye(5), Aliases: "GB,GNB"},
+	{Abbr: "HOU", Name: "Houston Texans", Conference: "AFC", Division: "South", ByeWeek: bye(6), Aliases: "HST"},
+	{Abbr: "IND", Name: "Indianapolis Colts", Conference: "AFC", Division: "South", ByeWeek: bye(11)},
+	{Abbr: "JAC", Name: "Jacksonville Jaguars", Conference: "AFC", Division: "South", ByeWeek: bye(8), Aliases: "JAX"},
+	{Abbr: "KCC", Name: "Kansas City Chiefs", Conference: "AFC", Division: "West", ByeWeek: bye(10), Aliases: "KC,KAN"},
+	{Abbr: "LVR", Name: "Las Vegas Raiders", Conference: "AFC", Division: "West", ByeWeek: bye(8), Aliases: "LV,OAK"},
+	{Abbr: "LAC", Name: "Los Angeles Chargers", Conference: "AFC", Division: "West", ByeWeek: bye(12), Aliases: "SD,SDG"},
+	{Abbr: "LAR", Name: "Los Angeles Rams", Conference: "NFC", Division: "West", ByeWeek: bye(8), Aliases: "LA,STL,RAM"},
+	{Abbr: "MIA", Name: "Miami Dolphins", Conference: "AFC", Division: "East", ByeWeek: bye(12)},
+	{Abbr: "MIN", Name: "Minnesota Vikings", Conference: "NFC", Division: "North", ByeWeek: bye(6)},
+	{Abbr: "NEP", Name: "New England Patriots", Conference: "AFC", Division: "East", ByeWeek: bye(14), Aliases: "NE,NWE"},
+	{Abbr: "NOS", Name: "New Orleans Saints", Conference: "NFC", Division: "South", ByeWeek: bye(11), Aliases: "NO,NOR"},
+	{Abbr: "NYG", Name: "New York Giants", Conference: "NFC", Division: "East", ByeWeek: bye(14)},
+	{Abbr: "NYJ", Name: "New York Jets", Conference: "AFC", Division: "East", ByeWeek: bye(9)},
+	{Abbr: "PHI", Name: "Philadelphia Eagles", Conference: "NFC", Division: "East", ByeWeek: bye(9)},
+	{Abbr: "PIT", Name: "Pittsburgh Steelers", Conference: "AFC", Division: "North", ByeWeek: bye(5)},
+	{Abbr: "SFO", Name: "San Francisco 49ers", Conference: "NFC", Division: "West", ByeWeek: bye(14), Aliases: "SF,SFX"},
+	{Abbr: "SEA", Name: "Seattle Seahawks", Conference: "NFC", Division: "West", ByeWeek: bye(8)},
+	{Abbr: "TBB", Name: "Tampa Bay Buccaneers", Conference: "NFC", Division: "South", ByeWeek: bye(9), Aliases: "TB,TAM"},
+	{Abbr: "TEN", Name: "Tennessee Titans", Conference: "AFC", Division: "South", ByeWeek: bye(10)},
+	{Abbr: "WAS", Name: "Washington Commanders", Conference: "NFC", Division: "East", ByeWeek: bye(12), Aliases: "WSH,WFT"},
+}
+
+// Index resolves the abbreviations and names sources use to NFL teams.
+type Index struct {
+	byKey map[string]*models.NFLTeam
+}
+
+// NewIndex indexes teams by abbreviation, alias and full name.
+func NewIndex(teams []models.NFLTeam) *Index {
+	ix := &Index{byKey: make(map[string]*models.NFLTeam)}
+	for i := range teams {
+		team := &teams[i]
+		ix.byKey[key(team.Abbr)] = team
+		ix.byKey[key(team.Name)] = team
+		for _, alias := range team.AliasList() {
+			ix.byKey[key(alias)] = team
+		}
+	}
+	return ix
+}
+
+func key(raw string) string {
+	return strings.ToUpper(strings.TrimSpace(raw))
+}
+
+// Lookup returns the team an abbreviation or name refers to, or nil.
+func (ix *Index) Lookup(raw string) *models.NFLTeam {
+	if ix == nil {
+		return nil
+	}
+	return ix.byKey[key(raw)]
+}
+
+// Canonical returns the canonical abbreviation for raw. Free agents and
+// unknown teams come back upper-cased and trimmed.
+func (ix *Index) Canonical(raw string) string {
+	if team := ix.Lookup(raw); team != nil {
+		return team.Abbr
+	}
+	return key(raw)
+}
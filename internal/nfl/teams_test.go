@@ -0,0 +1,58 @@
+package nfl
+
+import "testing"
+
+func TestTeams(t *testing.T) {
+	if len(Teams) != 32 {
+		t.Fatalf("len(Teams) = %d, want 32", len(Teams))
+	}
+	seen := make(map[string]string)
+	divisions := make(map[string]int)
+	for _, team := range Teams {
+		keys := append([]string{team.Abbr}, team.AliasList()...)
+		for _, k := range keys {
+			if other, ok := seen[k]; ok {
+				t.Errorf("%s is used by both %s and %s", k, other, team.Abbr)
+			}
+			seen[k] = team.Abbr
+		}
+		divisions[team.DivisionName()]++
+	}
+	if len(divisions) != 8 {
+		t.Errorf("got %d divisions, want 8", len(divisions))
+	}
+	for division, n := range divisions {
+		if n != 4 {
+			t.Errorf("%s has %d teams, want 4", division, n)
+		}
+	}
+}
+
+func TestIndexCanonical(t *testing.T) {
+	ix := NewIndex(Teams)
+	tests := []struct {
+		raw  string
+		want string
+	}{
+		{"KCC", "KCC"},
+		{"KC", "KCC"},
+		{" sf ", "SFO"},
+		{"JAX", "JAC"},
+		{"OAK", "LVR"},
+		{"Green Bay Packers", "GBP"},
+		{"FA", "FA"},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got := ix.Canonical(tt.raw); got != tt.want {
+			t.Errorf("Canonical(%q) = %q, want %q", tt.raw, got, tt.want)
+		}
+	}
+}
+
+func TestNilIndex(t *testing.T) {
+	var ix *Index
+	if got := ix.Canonical("kc"); got != "KC" {
+		t.Errorf("Canonical() on nil index = %q, want KC", got)
+	}
+}
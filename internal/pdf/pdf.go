@@ -0,0 +1,217 @@
+// Package pdf writes simple print-ready PDF documents: pages of text in
+// Helvetica, filled rectangles and lines. It uses the standard fonts every
+// PDF reader carries, so nothing is embedded and no outside tools are
+// needed.
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// US Letter, in points.
+const (
+	PageWidth  = 612.0
+	PageHeight = 792.0
+)
+
+// Font is one of the standard fonts a page can write in.
+type Font int
+
+const (
+	Regular Font = iota
+	Bold
+)
+
+// Document is a PDF being built, a page at a time.
+type Document struct {
+	Title string
+	pages []*Page
+}
+
+// New returns an empty document.
+func New(title string) *Document {
+	return &Document{Title: title}
+}
+
+// AddPage appends a blank page.
+func (d *Document) AddPage() *Page {
+	p := &Page{}
+	d.pages = append(d.pages, p)
+	return p
+}
+
+// Page is one page of a document. Its methods take coordinates in points
+// from the top left corner of the page, as a page is read, and colors as
+// RGB hex strings such as "3B82F6".
+type Page struct {
+	content bytes.Buffer
+}
+
+// Text writes s with its baseline starting at x, y.
+func (p *Page) Text(x, y float64, font Font, size float64, color, s string) {
+	fmt.Fprintf(&p.content, "BT /F%d %s Tf %s rg %s %s Td (%s) Tj ET\n",
+		font+1, num(size), rgb(color), num(x), num(PageHeight-y), escape(encode(s)))
+}
+
+// TextRight writes s ending at x.
+func (p *Page) TextRight(x, y float64, font Font, size float64, color, s string) {
+	p.Text(x-Width(s, font, size), y, font, size, color, s)
+}
+
+// Rect fills a rectangle whose top left corner is at x, y.
+func (p *Page) Rect(x, y, w, h float64, color string) {
+	fmt.Fprintf(&p.content, "%s rg %s %s %s %s re f\n", rgb(color), num(x), num(PageHeight-y-h), num(w), num(h))
+}
+
+// Line draws a line from x1, y1 to x2, y2.
+func (p *Page) Line(x1, y1, x2, y2, width float64, color string) {
+	fmt.Fprintf(&p.content, "%s RG %s w %s %s m %s %s l S\n", rgb(color), num(width), num(x1), num(PageHeight-y1), num(x2), num(PageHeight-y2))
+}
+
+// Write writes the document as a PDF file.
+func (d *Document) Write(w io.Writer) error {
+	if len(d.pages) == 0 {
+		return fmt.Errorf("document has no pages")
+	}
+
+	// Objects 1 to 5 are the catalog, page tree, info and fonts; each
+	// page then takes two, itself and its content stream.
+	var objects []string
+	kids := make([]string, len(d.pages))
+	for i := range d.pages {
+		kids[i] = fmt.Sprintf("%d 0 R", 6+2*i)
+	}
+	objects = append(objects,
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d /MediaBox [0 0 %s %s] >>", strings.Join(kids, " "), len(d.pages), num(PageWidth), num(PageHeight)),
+		fmt.Sprintf("<< /Title (%s) /Producer (draft-board) >>", escape(encode(d.Title))),
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>",
+	)
+	for i, page := range d.pages {
+		var stream bytes.Buffer
+		zw := zlib.NewWriter(&stream)
+		if _, err := zw.Write(page.content.Bytes()); err != nil {
+			return fmt.Errorf("failed to compress page %d: %w", i+1, err)
+		}
+		if err := zw.Close(); err != nil {
+			return fmt.Errorf("failed to compress page %d: %w", i+1, err)
+		}
+		objects = append(objects,
+			fmt.Sprintf("<< /Type /Page /Parent 2 0 R /Resources << /Font << /F1 4 0 R /F2 5 0 R >> >> /Contents %d 0 R >>", 7+2*i),
+			fmt.Sprintf("<< /Length %d /Filter /FlateDecode >>\nstream\n%s\nendstream", stream.Len(), stream.String()),
+		)
+	}
+
+	var out bytes.Buffer
+	out.WriteString("%PDF-1.4\n%\xE2\xE3\xCF\xD3\n")
+	offsets := make([]int, len(objects))
+	for i, obj := range objects {
+		offsets[i] = out.Len()
+		fmt.Fprintf(&out, "%d 0 obj\n%s\nendobj\n", i+1, obj)
+	}
+	xref := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R /Info 3 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+
+	_, err := w.Write(out.Bytes())
+	return err
+}
+
+// Width returns how wide s is written in a font, in points.
+func Width(s string, font Font, size float64) float64 {
+	table := &helvetica
+	if font == Bold {
+		table = &helveticaBold
+	}
+	total := 0
+	for _, b := range []byte(encode(s)) {
+		if b >= ' ' && b <= '~' {
+			total += table[b-' ']
+		} else {
+			total += 556
+		}
+	}
+	return float64(total) * size / 1000
+}
+
+// Fit cuts s to fit in width points, ending in "..." when cut.
+func Fit(s string, font Font, size, width float64) string {
+	if Width(s, font, size) <= width {
+		return s
+	}
+	runes := []rune(s)
+	for len(runes) > 0 {
+		runes = runes[:len(runes)-1]
+		cut := strings.TrimSpace(string(runes)) + "..."
+		if Width(cut, font, size) <= width {
+			return cut
+		}
+	}
+	return ""
+}
+
+// encode converts s to WinAnsiEncoding, which matches Latin-1 for the
+// letters player names use. Characters it lacks become question marks.
+func encode(s string) string {
+	b := make([]byte, 0, len(s))
+	for _, r := range s {
+		switch {
+		case r >= ' ' && r <= '~', r >= 0xA0 && r <= 0xFF:
+			b = append(b, byte(r))
+		case r == '’':
+			b = append(b, '\'')
+		default:
+			b = append(b, '?')
+		}
+	}
+	return string(b)
+}
+
+func escape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "(", `\(`, ")", `\)`).Replace(s)
+}
+
+func num(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// rgb returns a hex color as PDF color components, black if it is not one.
+func rgb(hex string) string {
+	n, err := strconv.ParseUint(strings.TrimPrefix(hex, "#"), 16, 32)
+	if err != nil || len(strings.TrimPrefix(hex, "#")) != 6 {
+		n = 0
+	}
+	c := func(v uint64) string {
+		return strconv.FormatFloat(float64(v)/255, 'f', 3, 64)
+	}
+	return c(n>>16&0xFF) + " " + c(n>>8&0xFF) + " " + c(n&0xFF)
+}
+
+// helvetica and helveticaBold are the widths of the printable ASCII
+// characters, from space to tilde, in thousandths of the font size.
+var helvetica = [95]int{
+	278, 278, 355, 556, 556, 889, 667, 191, 333, 333, 389, 584, 278, 333, 278, 278,
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 278, 278, 584, 584, 584, 556,
+	1015, 667, 667, 722, 722, 667, 611, 778, 722, 278, 500, 667, 556, 833, 722, 778,
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 278, 278, 278, 469, 556,
+	333, 556, 556, 500, 556, 556, 278, 556, 556, 222, 222, 500, 222, 833, 556, 556,
+	556, 556, 333, 500, 278, 556, 500, 722, 500, 500, 500, 334, 260, 334, 584,
+}
+
+var helveticaBold = [95]int{
+	278, 333, 474, 556, 556, 889, 722, 238, 333, 333, 389, 584, 278, 333, 278, 278,
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 333, 333, 584, 584, 584, 611,
+	975, 722, 722, 722, 722, 667, 611, 778, 722, 278, 556, 722, 611, 833, 722, 778,
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 333, 278, 333, 584, 556,
+	333, 556, 611, 556, 611, 556, 333, 611, 611, 278, 278, 556, 278, 889, 611, 611,
+	611, 611, 389, 556, 333, 611, 556, 778, 556, 556, 500, 389, 280, 389, 584,
+}
@@ -0,0 +1,109 @@
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestWrite(t *testing.T) {
+	doc := New("Home League (2026)")
+	page := doc.AddPage()
+	page.Rect(36, 36, 540, 24, "1F2335")
+	page.Text(40, 52, Bold, 14, "FFFFFF", "Aces (Ann)")
+	page.Line(36, 70, 576, 70, 0.5, "334155")
+	doc.AddPage().Text(40, 52, Regular, 10, "000000", "José Ramírez")
+
+	var buf bytes.Buffer
+	if err := doc.Write(&buf); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	out := buf.Bytes()
+	if !bytes.HasPrefix(out, []byte("%PDF-1.4\n")) || !bytes.HasSuffix(out, []byte("%%EOF\n")) {
+		t.Fatal("missing PDF header or trailer")
+	}
+
+	// Every xref entry points at its object.
+	xref := regexp.MustCompile(`startxref\n(\d+)\n`).FindSubmatch(out)
+	if xref == nil {
+		t.Fatal("missing startxref")
+	}
+	start, _ := strconv.Atoi(string(xref[1]))
+	if !bytes.HasPrefix(out[start:], []byte("xref\n0 10\n")) {
+		t.Fatalf("startxref %d does not point at a 10 entry xref table", start)
+	}
+	entries := regexp.MustCompile(`(\d{10}) 00000 n `).FindAllSubmatch(out[start:], -1)
+	if len(entries) != 9 {
+		t.Fatalf("%d xref entries, want 9", len(entries))
+	}
+	for i, e := range entries {
+		offset, _ := strconv.Atoi(string(e[1]))
+		if want := strconv.Itoa(i+1) + " 0 obj"; !bytes.HasPrefix(out[offset:], []byte(want)) {
+			t.Errorf("xref entry %d points at %q", i+1, out[offset:offset+10])
+		}
+	}
+	if !bytes.Contains(out, []byte("/Count 2")) || !bytes.Contains(out, []byte(`/Title (Home League \(2026\))`)) {
+		t.Error("missing page count or title")
+	}
+
+	streams := regexp.MustCompile(`(?s)stream\n(.*?)\nendstream`).FindAllSubmatch(out, -1)
+	if len(streams) != 2 {
+		t.Fatalf("%d content streams, want 2", len(streams))
+	}
+	first := inflate(t, streams[0][1])
+	for _, want := range []string{
+		"BT /F2 14 Tf 1.000 1.000 1.000 rg 40 740 Td (Aces \\(Ann\\)) Tj ET",
+		"36 732 540 24 re f",
+		"36 722 m 576 722 l S",
+	} {
+		if !strings.Contains(first, want) {
+			t.Errorf("page 1 missing %q in %s", want, first)
+		}
+	}
+	if second := inflate(t, streams[1][1]); !strings.Contains(second, "(Jos\xe9 Ram\xedrez)") {
+		t.Errorf("page 2 should write names in WinAnsi: %q", second)
+	}
+}
+
+func inflate(t *testing.T, data []byte) string {
+	t.Helper()
+	zr, err := zlib.NewReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := io.ReadAll(zr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(out)
+}
+
+func TestWriteNoPages(t *testing.T) {
+	if err := New("Empty").Write(io.Discard); err == nil {
+		t.Error("Write() with no pages should fail")
+	}
+}
+
+func TestWidth(t *testing.T) {
+	// H e l l o in Helvetica: 722 + 556 + 222 + 222 + 556.
+	if got := Width("Hello", Regular, 10); got != 22.78 {
+		t.Errorf("Width(Hello) = %v, want 22.78", got)
+	}
+	if Width("Hello", Bold, 10) <= Width("Hello", Regular, 10) {
+		t.Error("bold should be wider")
+	}
+}
+
+func TestFit(t *testing.T) {
+	if got := Fit("Josh Allen", Regular, 10, 100); got != "Josh Allen" {
+		t.Errorf("Fit() = %q", got)
+	}
+	got := Fit("Christian McCaffrey", Regular, 10, 50)
+	if !strings.HasSuffix(got, "...") || Width(got, Regular, 10) > 50 {
+		t.Errorf("Fit() = %q, %v wide", got, Width(got, Regular, 10))
+	}
+}
@@ -0,0 +1,94 @@
+package platform
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+func init() {
+	Register(sleeper{})
+	Register(&csvExporter{
+		key:    "espn",
+		name:   "ESPN",
+		header: []string{"Round", "Pick", "Overall", "Team", "Player ID", "Player"},
+		row: func(p Pick) []string {
+			return []string{strconv.Itoa(p.Round), strconv.Itoa(p.PickInRound), strconv.Itoa(p.OverallPick), p.TeamName, p.PlayerID, p.PlayerName}
+		},
+	})
+	Register(&csvExporter{
+		key:    "yahoo",
+		name:   "Yahoo",
+		header: []string{"round", "pick", "team_slot", "player_key"},
+		row: func(p Pick) []string {
+			return []string{strconv.Itoa(p.Round), strconv.Itoa(p.PickInRound), strconv.Itoa(p.Slot), p.PlayerID}
+		},
+	})
+}
+
+// sleeper writes the picks as a JSON array in the shape of Sleeper's draft
+// picks, with pick_no the overall pick and draft_slot the team's position.
+type sleeper struct{}
+
+func (sleeper) Key() string         { return "sleeper" }
+func (sleeper) Name() string        { return "Sleeper" }
+func (sleeper) Extension() string   { return "json" }
+func (sleeper) ContentType() string { return "application/json" }
+
+func (sleeper) Export(w io.Writer, draft *Draft) error {
+	if err := validate(draft); err != nil {
+		return err
+	}
+	type sleeperPick struct {
+		Round     int    `json:"round"`
+		PickNo    int    `json:"pick_no"`
+		DraftSlot int    `json:"draft_slot"`
+		PlayerID  string `json:"player_id"`
+	}
+	picks := []sleeperPick{}
+	for _, round := range Rounds(draft) {
+		for _, p := range round {
+			picks = append(picks, sleeperPick{Round: p.Round, PickNo: p.OverallPick, DraftSlot: p.Slot, PlayerID: p.PlayerID})
+		}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(picks); err != nil {
+		return fmt.Errorf("failed to write picks: %w", err)
+	}
+	return nil
+}
+
+// csvExporter writes one CSV row per pick, round by round, for platforms
+// whose offline draft entry takes a pick list.
+type csvExporter struct {
+	key    string
+	name   string
+	header []string
+	row    func(Pick) []string
+}
+
+func (e *csvExporter) Key() string         { return e.key }
+func (e *csvExporter) Name() string        { return e.name }
+func (e *csvExporter) Extension() string   { return "csv" }
+func (e *csvExporter) ContentType() string { return "text/csv" }
+
+func (e *csvExporter) Export(w io.Writer, draft *Draft) error {
+	if err := validate(draft); err != nil {
+		return err
+	}
+	cw := csv.NewWriter(w)
+	cw.Write(e.header)
+	for _, round := range Rounds(draft) {
+		for _, p := range round {
+			cw.Write(e.row(p))
+		}
+	}
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return fmt.Errorf("failed to write picks: %w", err)
+	}
+	return nil
+}
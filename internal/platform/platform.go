@@ -0,0 +1,101 @@
+// Package platform writes finished drafts in the files fantasy hosting
+// sites take for offline draft import: the picks round by round, with each
+// player given by the site's own player ID.
+package platform
+
+import (
+	"fmt"
+	"io"
+	"sort"
+)
+
+// Pick is one pick of a draft, with the player already mapped to the
+// platform's ID for them.
+type Pick struct {
+	Round       int
+	PickInRound int
+	OverallPick int
+	// Slot is the draft position of the team making the pick, which is
+	// not the pick in round in snake rounds or for traded picks.
+	Slot       int
+	TeamName   string
+	PlayerID   string
+	PlayerName string
+	Position   string
+}
+
+// Draft is a draft to export, its picks in overall pick order.
+type Draft struct {
+	Name  string
+	Teams int
+	Picks []Pick
+}
+
+// Exporter writes a draft in one platform's import format.
+type Exporter interface {
+	// Key names the platform in URLs and the player ID table.
+	Key() string
+	// Name is the platform's display name.
+	Name() string
+	// Extension is the file extension of the export, without the dot.
+	Extension() string
+	// ContentType is the MIME type of the export.
+	ContentType() string
+	Export(w io.Writer, draft *Draft) error
+}
+
+var exporters = make(map[string]Exporter)
+
+// Register adds an exporter, replacing any with the same key.
+func Register(e Exporter) {
+	exporters[e.Key()] = e
+}
+
+// Get returns the exporter for a platform key.
+func Get(key string) (Exporter, bool) {
+	e, ok := exporters[key]
+	return e, ok
+}
+
+// All returns every registered exporter, by name.
+func All() []Exporter {
+	all := make([]Exporter, 0, len(exporters))
+	for _, e := range exporters {
+		all = append(all, e)
+	}
+	sort.Slice(all, func(i, j int) bool {
+		return all[i].Name() < all[j].Name()
+	})
+	return all
+}
+
+// Rounds groups a draft's picks by round, in pick order within a round.
+func Rounds(draft *Draft) [][]Pick {
+	var rounds [][]Pick
+	for _, pick := range draft.Picks {
+		for len(rounds) < pick.Round {
+			rounds = append(rounds, nil)
+		}
+		rounds[pick.Round-1] = append(rounds[pick.Round-1], pick)
+	}
+	for _, round := range rounds {
+		sort.SliceStable(round, func(i, j int) bool {
+			return round[i].PickInRound < round[j].PickInRound
+		})
+	}
+	return rounds
+}
+
+// validate checks that every pick has a player ID and a round, which every
+// platform's format needs.
+func validate(draft *Draft) error {
+	for _, pick := range draft.Picks {
+		if pick.Round < 1 {
+			return fmt.Errorf("pick %d has no round", pick.OverallPick)
+		}
+		if pick.PlayerID == "" {
+			return fmt.Errorf("pick %d (%s) has no platform player ID", pick.OverallPick, pick.PlayerName)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package platform
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+// testDraft is two rounds of a two team snake draft, the second round's
+// picks listed out of order.
+func testDraft() *Draft {
+	return &Draft{
+		Name:  "Home League",
+		Teams: 2,
+		Picks: []Pick{
+			{Round: 1, PickInRound: 1, OverallPick: 1, Slot: 1, TeamName: "Aces", PlayerID: "9509", PlayerName: "Bijan Robinson", Position: "RB"},
+			{Round: 1, PickInRound: 2, OverallPick: 2, Slot: 2, TeamName: "Bears, Inc", PlayerID: "7564", PlayerName: "Ja'Marr Chase", Position: "WR"},
+			{Round: 2, PickInRound: 2, OverallPick: 4, Slot: 1, TeamName: "Aces", PlayerID: "4984", PlayerName: "Josh Allen", Position: "QB"},
+			{Round: 2, PickInRound: 1, OverallPick: 3, Slot: 2, TeamName: "Bears, Inc", PlayerID: "6794", PlayerName: "Justin Jefferson", Position: "WR"},
+		},
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	var keys []string
+	for _, e := range All() {
+		keys = append(keys, e.Key())
+		if got, ok := Get(e.Key()); !ok || got != e {
+			t.Errorf("Get(%q) = %v, %v", e.Key(), got, ok)
+		}
+	}
+	if got := strings.Join(keys, ","); got != "espn,sleeper,yahoo" {
+		t.Errorf("All() keys = %s, want espn,sleeper,yahoo", got)
+	}
+	if _, ok := Get("myfantasyleague"); ok {
+		t.Error("Get() found an unregistered platform")
+	}
+}
+
+func TestRounds(t *testing.T) {
+	rounds := Rounds(testDraft())
+	if len(rounds) != 2 {
+		t.Fatalf("%d rounds, want 2", len(rounds))
+	}
+	if rounds[1][0].OverallPick != 3 || rounds[1][1].OverallPick != 4 {
+		t.Errorf("round 2 = %+v, want picks 3 then 4", rounds[1])
+	}
+}
+
+func TestSleeper(t *testing.T) {
+	e, _ := Get("sleeper")
+	var buf bytes.Buffer
+	if err := e.Export(&buf, testDraft()); err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+	var picks []struct {
+		Round     int    `json:"round"`
+		PickNo    int    `json:"pick_no"`
+		DraftSlot int    `json:"draft_slot"`
+		PlayerID  string `json:"player_id"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &picks); err != nil {
+		t.Fatalf("not JSON: %v", err)
+	}
+	if len(picks) != 4 {
+		t.Fatalf("%d picks, want 4", len(picks))
+	}
+	if p := picks[2]; p.Round != 2 || p.PickNo != 3 || p.DraftSlot != 2 || p.PlayerID != "6794" {
+		t.Errorf("pick 3 = %+v", p)
+	}
+}
+
+func TestCSV(t *testing.T) {
+	e, _ := Get("espn")
+	var buf bytes.Buffer
+	if err := e.Export(&buf, testDraft()); err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+	want := `Round,Pick,Overall,Team,Player ID,Player
+1,1,1,Aces,9509,Bijan Robinson
+1,2,2,"Bears, Inc",7564,Ja'Marr Chase
+2,1,3,"Bears, Inc",6794,Justin Jefferson
+2,2,4,Aces,4984,Josh Allen
+`
+	if got := buf.String(); got != want {
+		t.Errorf("Export() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestExportUnmapped(t *testing.T) {
+	draft := testDraft()
+	draft.Picks[1].PlayerID = ""
+	for _, e := range All() {
+		err := e.Export(&bytes.Buffer{}, draft)
+		if err == nil || !strings.Contains(err.Error(), "Ja'Marr Chase") {
+			t.Errorf("%s Export() error = %v, want the unmapped player named", e.Key(), err)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package projections
+
+import (
+	"sort"
+
+	"github.com/vibes/draft-board/internal/lineup"
+	"github.com/vibes/draft-board/internal/models"
+	"github.com/vibes/draft-board/internal/scoring"
+)
+
+// TeamProjection is a team's projected season points from its best
+// starting lineup.
+type TeamProjection struct {
+	TeamID        int           `json:"team_id"`
+	TeamName      string        `json:"team_name"`
+	Rank          int           `json:"rank"`
+	StarterPoints float64       `json:"starter_points"`
+	BenchPoints   float64       `json:"bench_points"`
+	Unprojected   int           `json:"unprojected"`
+	Lineup        lineup.Lineup `json:"-"`
+}
+
+// Standings ranks teams by projected starting-lineup points. Drafted players
+// without a projection count as zero points and are tallied in Unprojected.
+func Standings(
+	teams []models.Team,
+	picks []models.Pick,
+	players map[int]*models.Player,
+	projections map[int]*models.Projection,
+	rules scoring.Ruleset,
+	slots []models.RosterSlot,
+) []TeamProjection {
+	rosters := make(map[int][]lineup.Entry)
+	unprojected := make(map[int]int)
+	for _, pick := range picks {
+		player, ok := players[pick.PlayerID]
+		if !ok {
+			continue
+		}
+		entry := lineup.Entry{PlayerID: player.ID, Position: player.Position, Positions: player.Positions()}
+		if proj, ok := projections[player.ID]; ok {
+			entry.Points = rules.Points(player.Position, proj.Stats)
+		} else {
+			unprojected[pick.TeamID]++
+		}
+		rosters[pick.TeamID] = append(rosters[pick.TeamID], entry)
+	}
+
+	standings := make([]TeamProjection, 0, len(teams))
+	for _, team := range teams {
+		best := lineup.Optimal(rosters[team.ID], slots)
+		standings = append(standings, TeamProjection{
+			TeamID:        team.ID,
+			TeamName:      team.TeamName,
+			StarterPoints: best.StarterPoints,
+			BenchPoints:   best.BenchPoints,
+			Unprojected:   unprojected[team.ID],
+			Lineup:        best,
+		})
+	}
+
+	sort.SliceStable(standings, func(i, j int) bool {
+		return standings[i].StarterPoints > standings[j].StarterPoints
+	})
+	for i := range standings {
+		standings[i].Rank = i + 1
+	}
+	return standings
+}
@@ -0,0 +1,48 @@
+package projections
+
+import (
+	"testing"
+
+	"github.com/vibes/draft-board/internal/models"
+	"github.com/vibes/draft-board/internal/scoring"
+)
+
+func TestStandings(t *testing.T) {
+	teams := []models.Team{
+		{ID: 1, TeamName: "Alpha", DraftPosition: 1},
+		{ID: 2, TeamName: "Bravo", DraftPosition: 2},
+	}
+	players := map[int]*models.Player{
+		10: {ID: 10, Name: "QB One", Position: "QB"},
+		11: {ID: 11, Name: "WR One", Position: "WR"},
+		20: {ID: 20, Name: "QB Two", Position: "QB"},
+		21: {ID: 21, Name: "WR Two", Position: "WR"},
+	}
+	picks := []models.Pick{
+		{TeamID: 1, PlayerID: 10},
+		{TeamID: 2, PlayerID: 20},
+		{TeamID: 2, PlayerID: 21},
+		{TeamID: 1, PlayerID: 11},
+	}
+	projections := map[int]*models.Projection{
+		10: {PlayerID: 10, Stats: map[string]float64{scoring.StatPassTD: 30}},
+		20: {PlayerID: 20, Stats: map[string]float64{scoring.StatPassTD: 35}},
+		21: {PlayerID: 21, Stats: map[string]float64{scoring.StatReceptions: 100, scoring.StatRecYds: 1000}},
+	}
+	slots := []models.RosterSlot{{Slot: "QB", Count: 1}, {Slot: "WR", Count: 1}}
+
+	got := Standings(teams, picks, players, projections, scoring.Preset("PPR"), slots)
+
+	if len(got) != 2 {
+		t.Fatalf("len(Standings) = %d, want 2", len(got))
+	}
+	if got[0].TeamID != 2 || got[0].Rank != 1 {
+		t.Errorf("first = team %d rank %d, want team 2 rank 1", got[0].TeamID, got[0].Rank)
+	}
+	if got[0].StarterPoints != 340 {
+		t.Errorf("Bravo StarterPoints = %v, want 340", got[0].StarterPoints)
+	}
+	if got[1].StarterPoints != 120 || got[1].Unprojected != 1 {
+		t.Errorf("Alpha = %+v, want 120 points and 1 unprojected", got[1])
+	}
+}
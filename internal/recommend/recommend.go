@@ -0,0 +1,84 @@
+package recommend
+
+import (
+	"sort"
+
+	"github.com/vibes/draft-board/internal/lineup"
+	"github.com/vibes/draft-board/internal/models"
+)
+
+// needBoost scales the rank of players who would fill an open starting
+// slot, so a need is preferred over a slightly better-ranked luxury.
+const needBoost = 0.8
+
+// unranked is the effective rank of players with no ADP rank.
+const unranked = 999
+
+// statusPenalty scales the rank of players who may not play, so a healthy
+// player is preferred over a slightly better-ranked injured one.
+var statusPenalty = map[string]float64{
+	models.StatusQuestionable: 1.15,
+	models.StatusOut:          1.5,
+	models.StatusIR:           3,
+	models.StatusSuspended:    2,
+	models.StatusFreeAgent:    1.5,
+	models.StatusRetired:      10,
+}
+
+// Suggestion is a recommended pick for the team on the clock.
+type Suggestion struct {
+	Player *models.Player
+	Rank   *int
+	Need   bool
+	Score  float64
+}
+
+// Suggest ranks available players for a team. available should already be
+// in rank order; rank looks up each player's ADP rank for the draft and
+// roster holds the players the team has drafted. Injured and unsigned
+// players are down-weighted by status. Lower scores are better.
+func Suggest(available []*models.Player, rank func(*models.Player) *int, roster []*models.Player, slots []models.RosterSlot, n int) []Suggestion {
+	entries := make([]lineup.Entry, len(roster))
+	for i, player := range roster {
+		entries[i] = lineup.Entry{
+			PlayerID:  player.ID,
+			Position:  player.Position,
+			Positions: player.Positions(),
+			Points:    float64(len(roster) - i),
+		}
+	}
+	current := lineup.Optimal(entries, slots)
+	var openSlots []string
+	for _, a := range current.Starters {
+		if a.Entry.Position == "" {
+			openSlots = append(openSlots, a.Slot)
+		}
+	}
+
+	suggestions := make([]Suggestion, 0, len(available))
+	for _, player := range available {
+		s := Suggestion{Player: player, Rank: rank(player), Score: unranked}
+		if s.Rank != nil {
+			s.Score = float64(*s.Rank)
+		}
+		if penalty, ok := statusPenalty[player.Status]; ok {
+			s.Score *= penalty
+		}
+		for _, slot := range openSlots {
+			if lineup.CanFillAny(slot, player.Positions()) {
+				s.Need = true
+				s.Score *= needBoost
+				break
+			}
+		}
+		suggestions = append(suggestions, s)
+	}
+
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		return suggestions[i].Score < suggestions[j].Score
+	})
+	if len(suggestions) > n {
+		suggestions = suggestions[:n]
+	}
+	return suggestions
+}
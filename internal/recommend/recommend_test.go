@@ -0,0 +1,83 @@
+package recommend
+
+import (
+	"testing"
+
+	"github.com/vibes/draft-board/internal/models"
+)
+
+func TestSuggest(t *testing.T) {
+	ranks := map[int]int{1: 10, 2: 11, 3: 40}
+	available := []*models.Player{
+		{ID: 1, Name: "RB Three", Position: "RB"},
+		{ID: 2, Name: "QB One", Position: "QB"},
+		{ID: 3, Name: "TE One", Position: "TE"},
+		{ID: 4, Name: "Unranked WR", Position: "WR"},
+	}
+	rank := func(p *models.Player) *int {
+		if r, ok := ranks[p.ID]; ok {
+			return &r
+		}
+		return nil
+	}
+	slots := []models.RosterSlot{{Slot: "QB", Count: 1}, {Slot: "RB", Count: 2}, {Slot: "TE", Count: 1}}
+
+	roster := []*models.Player{{ID: 8, Position: "RB"}, {ID: 9, Position: "RB"}}
+	got := Suggest(available, rank, roster, slots, 3)
+
+	if len(got) != 3 {
+		t.Fatalf("len(Suggest) = %d, want 3", len(got))
+	}
+	// RBs are full, so the QB need jumps the better-ranked RB
+	if got[0].Player.ID != 2 || !got[0].Need {
+		t.Errorf("first = %+v, want QB One as a need", got[0])
+	}
+	if got[1].Player.ID != 1 || got[1].Need {
+		t.Errorf("second = %+v, want RB Three without need", got[1])
+	}
+	if got[2].Player.ID != 3 {
+		t.Errorf("third = %+v, want TE One", got[2])
+	}
+}
+
+func TestSuggestDownWeightsInjured(t *testing.T) {
+	ranks := map[int]int{1: 10, 2: 12, 3: 20}
+	available := []*models.Player{
+		{ID: 1, Name: "WR Out", Position: "WR", Status: models.StatusOut},
+		{ID: 2, Name: "WR Healthy", Position: "WR", Status: models.StatusActive},
+		{ID: 3, Name: "WR Questionable", Position: "WR", Status: models.StatusQuestionable},
+	}
+	rank := func(p *models.Player) *int {
+		r := ranks[p.ID]
+		return &r
+	}
+	slots := []models.RosterSlot{{Slot: "WR", Count: 1}}
+
+	got := Suggest(available, rank, []*models.Player{{ID: 9, Position: "WR"}}, slots, 3)
+
+	want := []int{2, 1, 3}
+	for i, id := range want {
+		if got[i].Player.ID != id {
+			t.Errorf("suggestion %d = %s, want player %d", i, got[i].Player.Name, id)
+		}
+	}
+}
+
+func TestSuggestMultiPositionNeed(t *testing.T) {
+	ranks := map[int]int{1: 10, 2: 11}
+	available := []*models.Player{
+		{ID: 1, Name: "DL One", Position: "DL"},
+		{ID: 2, Name: "Edge Rusher", Position: "DL", EligiblePositions: "LB"},
+	}
+	rank := func(p *models.Player) *int {
+		r := ranks[p.ID]
+		return &r
+	}
+	slots := []models.RosterSlot{{Slot: "DL", Count: 1}, {Slot: "LB", Count: 1}}
+
+	got := Suggest(available, rank, []*models.Player{{ID: 9, Position: "DL"}}, slots, 2)
+
+	if got[0].Player.ID != 2 || !got[0].Need {
+		t.Errorf("first = %+v, want the DL/LB as a need", got[0])
+	}
+}
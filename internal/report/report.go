@@ -0,0 +1,426 @@
+package report
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/vibes/draft-board/internal/lineup"
+	"github.com/vibes/draft-board/internal/models"
+	"github.com/vibes/draft-board/internal/projections"
+)
+
+// Component weights for a team's overall score. Components without data (no
+// ADP ranks or no projections) are dropped and the rest reweighted.
+const (
+	weightValue    = 0.35
+	weightStarters = 0.35
+	weightBalance  = 0.15
+	weightByes     = 0.15
+)
+
+// PickValue is one pick measured against the player's ADP rank. A positive
+// ValueDiff means the player went later than ADP.
+type PickValue struct {
+	PickID      int      `json:"pick_id"`
+	Round       int      `json:"round"`
+	OverallPick int      `json:"overall_pick"`
+	TeamID      int      `json:"team_id"`
+	TeamName    string   `json:"team_name"`
+	PlayerID    int      `json:"player_id"`
+	PlayerName  string   `json:"player_name"`
+	Position    string   `json:"position"`
+	Positions   []string `json:"positions"`
+	NFLTeam     string   `json:"nfl_team"`
+	ADPRank     *int     `json:"adp_rank"`
+	ValueDiff   int      `json:"value_diff"`
+}
+
+// TeamGrade is a team's report card. Component scores run 0-100; a nil
+// component had no data to grade.
+type TeamGrade struct {
+	TeamID          int         `json:"team_id"`
+	TeamName        string      `json:"team_name"`
+	OwnerName       string      `json:"owner_name"`
+	Grade           string      `json:"grade"`
+	Score           float64     `json:"score"`
+	ValueScore      *float64    `json:"value_score"`
+	StarterScore    *float64    `json:"starter_score"`
+	BalanceScore    float64     `json:"balance_score"`
+	ByeScore        float64     `json:"bye_score"`
+	TotalValue      int         `json:"total_value"`
+	ProjectedPoints float64     `json:"projected_points"`
+	ProjectedRank   int         `json:"projected_rank"`
+	EmptySlots      int         `json:"empty_slots"`
+	ByeConflicts    int         `json:"bye_conflicts"`
+	BestPick        *PickValue  `json:"best_pick"`
+	WorstPick       *PickValue  `json:"worst_pick"`
+	Picks           []PickValue `json:"picks"`
+}
+
+// Superlative is a league-wide award.
+type Superlative struct {
+	Title    string `json:"title"`
+	TeamName string `json:"team_name"`
+	Detail   string `json:"detail"`
+}
+
+// Report is the league draft report card.
+type Report struct {
+	DraftID      int           `json:"draft_id"`
+	DraftName    string        `json:"draft_name"`
+	GeneratedAt  time.Time     `json:"generated_at"`
+	Teams        []TeamGrade   `json:"teams"`
+	BestPicks    []PickValue   `json:"best_picks"`
+	WorstPicks   []PickValue   `json:"worst_picks"`
+	Superlatives []Superlative `json:"superlatives"`
+}
+
+// Input is everything a report is built from.
+type Input struct {
+	Draft     *models.Draft
+	Teams     []models.Team
+	Picks     []models.Pick
+	Players   map[int]*models.Player
+	Standings []projections.TeamProjection
+	Slots     []models.RosterSlot
+}
+
+// Build grades every team and collects league superlatives.
+func Build(in Input) *Report {
+	rep := &Report{
+		DraftID:     in.Draft.ID,
+		DraftName:   in.Draft.Name,
+		GeneratedAt: time.Now().UTC(),
+	}
+
+	teamNames := make(map[int]string)
+	for _, t := range in.Teams {
+		teamNames[t.ID] = t.TeamName
+	}
+
+	byTeam := make(map[int][]PickValue)
+	var valued []PickValue
+	for _, pick := range in.Picks {
+		player, ok := in.Players[pick.PlayerID]
+		if !ok {
+			continue
+		}
+		pv := PickValue{
+			PickID:      pick.ID,
+			Round:       pick.Round,
+			OverallPick: pick.OverallPick,
+			TeamID:      pick.TeamID,
+			TeamName:    teamNames[pick.TeamID],
+			PlayerID:    player.ID,
+			PlayerName:  player.Name,
+			Position:    player.Position,
+			Positions:   player.Positions(),
+			NFLTeam:     player.Team,
+			ADPRank:     pick.ADPRank,
+		}
+		if pick.ADPRank != nil {
+			pv.ValueDiff = *pick.ADPRank - pick.OverallPick
+			valued = append(valued, pv)
+		}
+		byTeam[pick.TeamID] = append(byTeam[pick.TeamID], pv)
+	}
+
+	standings := make(map[int]projections.TeamProjection)
+	hasProjections := false
+	for _, s := range in.Standings {
+		standings[s.TeamID] = s
+		if s.StarterPoints != 0 {
+			hasProjections = true
+		}
+	}
+
+	for _, team := range in.Teams {
+		picks := byTeam[team.ID]
+		grade := TeamGrade{
+			TeamID:    team.ID,
+			TeamName:  team.TeamName,
+			OwnerName: team.OwnerName,
+			Picks:     picks,
+		}
+		for i := range picks {
+			p := picks[i]
+			if p.ADPRank == nil {
+				continue
+			}
+			grade.TotalValue += p.ValueDiff
+			if grade.BestPick == nil || p.ValueDiff > grade.BestPick.ValueDiff {
+				grade.BestPick = &p
+			}
+			if grade.WorstPick == nil || p.ValueDiff < grade.WorstPick.ValueDiff {
+				grade.WorstPick = &p
+			}
+		}
+
+		starters := startingLineup(picks, standings[team.ID], hasProjections, in.Slots)
+		grade.EmptySlots = starters.EmptySlots
+		grade.BalanceScore = balanceScore(picks, starters)
+		grade.ByeConflicts = byeConflicts(starters, in.Players)
+		grade.ByeScore = clamp(100 - 15*float64(grade.ByeConflicts))
+
+		if s, ok := standings[team.ID]; ok {
+			grade.ProjectedPoints = s.StarterPoints
+			grade.ProjectedRank = s.Rank
+		}
+
+		rep.Teams = append(rep.Teams, grade)
+	}
+
+	if len(valued) > 0 {
+		scaleComponent(rep.Teams, func(g *TeamGrade) float64 { return float64(g.TotalValue) },
+			func(g *TeamGrade, v float64) { g.ValueScore = &v })
+	}
+	if hasProjections {
+		scaleComponent(rep.Teams, func(g *TeamGrade) float64 { return g.ProjectedPoints },
+			func(g *TeamGrade, v float64) { g.StarterScore = &v })
+	}
+
+	for i := range rep.Teams {
+		g := &rep.Teams[i]
+		total, weight := weightBalance*g.BalanceScore+weightByes*g.ByeScore, weightBalance+weightByes
+		if g.ValueScore != nil {
+			total += weightValue * *g.ValueScore
+			weight += weightValue
+		}
+		if g.StarterScore != nil {
+			total += weightStarters * *g.StarterScore
+			weight += weightStarters
+		}
+		g.Score = total / weight
+		g.Grade = letterGrade(g.Score)
+	}
+
+	sort.SliceStable(rep.Teams, func(i, j int) bool {
+		return rep.Teams[i].Score > rep.Teams[j].Score
+	})
+
+	sort.SliceStable(valued, func(i, j int) bool {
+		return valued[i].ValueDiff > valued[j].ValueDiff
+	})
+	rep.BestPicks = topPicks(valued, 5)
+	reversed := make([]PickValue, len(valued))
+	for i := range valued {
+		reversed[len(valued)-1-i] = valued[i]
+	}
+	rep.WorstPicks = topPicks(reversed, 5)
+
+	rep.Superlatives = superlatives(rep, valued)
+	return rep
+}
+
+// startingLineup returns the team's best lineup. Projected points rank the
+// starters when available; otherwise earlier picks are assumed better.
+func startingLineup(picks []PickValue, standing projections.TeamProjection, projected bool, slots []models.RosterSlot) lineup.Lineup {
+	if projected && len(standing.Lineup.Starters) > 0 {
+		return standing.Lineup
+	}
+	entries := make([]lineup.Entry, 0, len(picks))
+	for _, p := range picks {
+		entries = append(entries, lineup.Entry{
+			PlayerID:  p.PlayerID,
+			Position:  p.Position,
+			Positions: p.Positions,
+			Points:    -float64(p.OverallPick),
+		})
+	}
+	return lineup.Optimal(entries, slots)
+}
+
+// balanceScore penalises unfilled starting slots and starting positions
+// with no backup on the roster.
+func balanceScore(picks []PickValue, starters lineup.Lineup) float64 {
+	counts := make(map[string]int)
+	for _, p := range picks {
+		counts[p.Position]++
+	}
+	needed := make(map[string]int)
+	for _, a := range starters.Starters {
+		eligible := lineup.Eligible(a.Slot)
+		if len(eligible) == 1 {
+			needed[eligible[0]]++
+		}
+	}
+
+	score := 100 - 20*float64(starters.EmptySlots)
+	for pos, n := range needed {
+		if pos == "K" || pos == "D/ST" {
+			continue
+		}
+		if counts[pos] <= n {
+			score -= 5
+		}
+	}
+	return clamp(score)
+}
+
+// byeConflicts counts, over all weeks, starters beyond the first who share a
+// bye week.
+func byeConflicts(starters lineup.Lineup, players map[int]*models.Player) int {
+	weeks := make(map[int]int)
+	for _, a := range starters.Starters {
+		player, ok := players[a.Entry.PlayerID]
+		if !ok || player.ByeWeek == nil {
+			continue
+		}
+		weeks[*player.ByeWeek]++
+	}
+	conflicts := 0
+	for _, n := range weeks {
+		if n > 1 {
+			conflicts += n - 1
+		}
+	}
+	return conflicts
+}
+
+// scaleComponent maps a raw team metric onto 50-100 relative to the league.
+func scaleComponent(teams []TeamGrade, get func(*TeamGrade) float64, set func(*TeamGrade, float64)) {
+	if len(teams) == 0 {
+		return
+	}
+	lo, hi := get(&teams[0]), get(&teams[0])
+	for i := range teams {
+		v := get(&teams[i])
+		if v < lo {
+			lo = v
+		}
+		if v > hi {
+			hi = v
+		}
+	}
+	for i := range teams {
+		scaled := 75.0
+		if hi > lo {
+			scaled = 50 + 50*(get(&teams[i])-lo)/(hi-lo)
+		}
+		set(&teams[i], scaled)
+	}
+}
+
+func letterGrade(score float64) string {
+	switch {
+	case score >= 93:
+		return "A+"
+	case score >= 87:
+		return "A"
+	case score >= 83:
+		return "A-"
+	case score >= 80:
+		return "B+"
+	case score >= 75:
+		return "B"
+	case score >= 70:
+		return "B-"
+	case score >= 67:
+		return "C+"
+	case score >= 60:
+		return "C"
+	case score >= 55:
+		return "C-"
+	case score >= 50:
+		return "D"
+	default:
+		return "F"
+	}
+}
+
+func superlatives(rep *Report, valued []PickValue) []Superlative {
+	var out []Superlative
+	if len(rep.Teams) > 0 {
+		best := rep.Teams[0]
+		out = append(out, Superlative{
+			Title:    "Best Draft",
+			TeamName: best.TeamName,
+			Detail:   fmt.Sprintf("Grade %s (%.0f)", best.Grade, best.Score),
+		})
+	}
+	if len(valued) > 0 {
+		steal := valued[0]
+		if steal.ValueDiff > 0 {
+			out = append(out, Superlative{
+				Title:    "Biggest Steal",
+				TeamName: steal.TeamName,
+				Detail:   fmt.Sprintf("%s at pick %d (ADP %d)", steal.PlayerName, steal.OverallPick, *steal.ADPRank),
+			})
+		}
+		reach := valued[len(valued)-1]
+		if reach.ValueDiff < 0 {
+			out = append(out, Superlative{
+				Title:    "Biggest Reach",
+				TeamName: reach.TeamName,
+				Detail:   fmt.Sprintf("%s at pick %d (ADP %d)", reach.PlayerName, reach.OverallPick, *reach.ADPRank),
+			})
+		}
+	}
+
+	var champ *TeamGrade
+	var byes *TeamGrade
+	for i := range rep.Teams {
+		g := &rep.Teams[i]
+		if g.ProjectedRank == 1 && g.StarterScore != nil {
+			champ = g
+		}
+		if g.ByeConflicts > 0 && (byes == nil || g.ByeConflicts > byes.ByeConflicts) {
+			byes = g
+		}
+	}
+	if champ != nil {
+		out = append(out, Superlative{
+			Title:    "Projected Champion",
+			TeamName: champ.TeamName,
+			Detail:   fmt.Sprintf("%.1f projected starter points", champ.ProjectedPoints),
+		})
+	}
+	if byes != nil {
+		out = append(out, Superlative{
+			Title:    "Bye Week Blues",
+			TeamName: byes.TeamName,
+			Detail:   fmt.Sprintf("%d starter bye conflicts", byes.ByeConflicts),
+		})
+	}
+
+	var hoarder *TeamGrade
+	hoardPos, hoardCount := "", 0
+	for i := range rep.Teams {
+		counts := make(map[string]int)
+		for _, p := range rep.Teams[i].Picks {
+			counts[p.Position]++
+			if counts[p.Position] > hoardCount {
+				hoarder, hoardPos, hoardCount = &rep.Teams[i], p.Position, counts[p.Position]
+			}
+		}
+	}
+	if hoarder != nil && hoardCount > 1 {
+		out = append(out, Superlative{
+			Title:    "Position Hoarder",
+			TeamName: hoarder.TeamName,
+			Detail:   fmt.Sprintf("%d %s", hoardCount, hoardPos),
+		})
+	}
+
+	return out
+}
+
+func topPicks(picks []PickValue, n int) []PickValue {
+	if len(picks) < n {
+		n = len(picks)
+	}
+	out := make([]PickValue, n)
+	copy(out, picks[:n])
+	return out
+}
+
+func clamp(score float64) float64 {
+	if score < 0 {
+		return 0
+	}
+	if score > 100 {
+		return 100
+	}
+	return score
+}
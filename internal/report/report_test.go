@@ -0,0 +1,102 @@
+package report
+
+import (
+	"testing"
+
+	"github.com/vibes/draft-board/internal/models"
+	"github.com/vibes/draft-board/internal/projections"
+)
+
+func intPtr(i int) *int { return &i }
+
+func TestBuild(t *testing.T) {
+	draft := &models.Draft{ID: 1, Name: "Test League", NumTeams: 2}
+	teams := []models.Team{
+		{ID: 1, TeamName: "Alpha", DraftPosition: 1},
+		{ID: 2, TeamName: "Bravo", DraftPosition: 2},
+	}
+	players := map[int]*models.Player{
+		10: {ID: 10, Name: "QB One", Position: "QB", ByeWeek: intPtr(7)},
+		11: {ID: 11, Name: "WR One", Position: "WR", ByeWeek: intPtr(7)},
+		20: {ID: 20, Name: "QB Two", Position: "QB", ByeWeek: intPtr(5)},
+		21: {ID: 21, Name: "WR Two", Position: "WR", ByeWeek: intPtr(9)},
+	}
+	picks := []models.Pick{
+		{ID: 1, TeamID: 1, PlayerID: 10, Round: 1, OverallPick: 1, ADPRank: intPtr(3)},
+		{ID: 2, TeamID: 2, PlayerID: 20, Round: 1, OverallPick: 2, ADPRank: intPtr(1)},
+		{ID: 3, TeamID: 2, PlayerID: 21, Round: 2, OverallPick: 3, ADPRank: intPtr(8)},
+		{ID: 4, TeamID: 1, PlayerID: 11, Round: 2, OverallPick: 4, ADPRank: intPtr(2)},
+	}
+	slots := []models.RosterSlot{{Slot: "QB", Count: 1}, {Slot: "WR", Count: 1}}
+
+	rep := Build(Input{Draft: draft, Teams: teams, Picks: picks, Players: players, Slots: slots})
+
+	if len(rep.Teams) != 2 {
+		t.Fatalf("len(Teams) = %d, want 2", len(rep.Teams))
+	}
+	// Bravo: +4 value and no bye conflicts; Alpha: 0 value, both starters on bye week 7
+	if rep.Teams[0].TeamName != "Bravo" {
+		t.Errorf("top team = %s, want Bravo", rep.Teams[0].TeamName)
+	}
+	alpha := rep.Teams[1]
+	if alpha.TotalValue != 0 || alpha.ByeConflicts != 1 {
+		t.Errorf("Alpha value %d conflicts %d, want 0 and 1", alpha.TotalValue, alpha.ByeConflicts)
+	}
+	if alpha.BestPick == nil || alpha.BestPick.PlayerID != 10 {
+		t.Errorf("Alpha best pick = %+v, want QB One", alpha.BestPick)
+	}
+	if alpha.WorstPick == nil || alpha.WorstPick.PlayerID != 11 {
+		t.Errorf("Alpha worst pick = %+v, want WR One", alpha.WorstPick)
+	}
+	if alpha.StarterScore != nil {
+		t.Error("StarterScore should be nil without projections")
+	}
+	if rep.BestPicks[0].PlayerID != 21 || rep.WorstPicks[0].PlayerID != 11 {
+		t.Errorf("best/worst = %d/%d, want 21/11", rep.BestPicks[0].PlayerID, rep.WorstPicks[0].PlayerID)
+	}
+
+	titles := make(map[string]string)
+	for _, s := range rep.Superlatives {
+		titles[s.Title] = s.TeamName
+	}
+	if titles["Biggest Steal"] != "Bravo" || titles["Biggest Reach"] != "Alpha" {
+		t.Errorf("superlatives = %v", titles)
+	}
+}
+
+func TestBuild_UsesProjections(t *testing.T) {
+	draft := &models.Draft{ID: 1, NumTeams: 2}
+	teams := []models.Team{{ID: 1, TeamName: "Alpha"}, {ID: 2, TeamName: "Bravo"}}
+	standings := []projections.TeamProjection{
+		{TeamID: 2, TeamName: "Bravo", Rank: 1, StarterPoints: 300},
+		{TeamID: 1, TeamName: "Alpha", Rank: 2, StarterPoints: 100},
+	}
+
+	rep := Build(Input{Draft: draft, Teams: teams, Standings: standings})
+
+	if rep.Teams[0].TeamName != "Bravo" || rep.Teams[0].StarterScore == nil {
+		t.Fatalf("top team = %+v, want Bravo with a starter score", rep.Teams[0])
+	}
+	if *rep.Teams[0].StarterScore != 100 || *rep.Teams[1].StarterScore != 50 {
+		t.Errorf("starter scores = %v/%v, want 100/50", *rep.Teams[0].StarterScore, *rep.Teams[1].StarterScore)
+	}
+}
+
+func TestLetterGrade(t *testing.T) {
+	tests := []struct {
+		score float64
+		want  string
+	}{
+		{100, "A+"},
+		{88, "A"},
+		{76, "B"},
+		{61, "C"},
+		{52, "D"},
+		{10, "F"},
+	}
+	for _, tt := range tests {
+		if got := letterGrade(tt.score); got != tt.want {
+			t.Errorf("letterGrade(%v) = %s, want %s", tt.score, got, tt.want)
+		}
+	}
+}
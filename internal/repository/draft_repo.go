@@ -17,11 +17,11 @@ func NewDraftRepository(db *sql.DB) *DraftRepository {
 
 func (r *DraftRepository) Create(draft *models.Draft) error {
 	query := `
-		INSERT INTO drafts (name, num_teams, scoring_format, draft_type, qb_setting, snake_draft, status, max_rounds, commissioner_id)
-		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+		INSERT INTO drafts (name, num_teams, scoring_format, draft_type, qb_setting, snake_draft, status, max_rounds, commissioner_id, season, sport)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, COALESCE(NULLIF(?, ''), 'football'))
 	`
 	result, err := r.db.Exec(query, draft.Name, draft.NumTeams, draft.ScoringFormat, draft.DraftType,
-		draft.QBSetting, draft.SnakeDraft, draft.Status, draft.MaxRounds, draft.CommissionerID)
+		draft.QBSetting, draft.SnakeDraft, draft.Status, draft.MaxRounds, draft.CommissionerID, draft.Season, draft.Sport)
 	if err != nil {
 		return fmt.Errorf("failed to create draft: %w", err)
 	}
@@ -42,6 +42,7 @@ func (r *DraftRepository) GetByID(id int) (*models.Draft, error) {
 		&draft.ID, &draft.Name, &draft.NumTeams, &draft.ScoringFormat,
 		&draft.DraftType, &draft.QBSetting, &draft.SnakeDraft, &draft.Status,
 		&draft.MaxRounds, &draft.CommissionerID, &draft.CreatedAt, &draft.Completed,
+		&draft.Season, &draft.Sport, &draft.LeagueID, &draft.Rookie, &draft.PreviousDraftID,
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -93,6 +94,7 @@ func (r *DraftRepository) List() ([]*models.Draft, error) {
 			&draft.ID, &draft.Name, &draft.NumTeams, &draft.ScoringFormat,
 			&draft.DraftType, &draft.QBSetting, &draft.SnakeDraft, &draft.Status,
 			&draft.MaxRounds, &draft.CommissionerID, &draft.CreatedAt, &draft.Completed,
+			&draft.Season, &draft.Sport, &draft.LeagueID, &draft.Rookie, &draft.PreviousDraftID,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan draft: %w", err)
@@ -103,3 +105,202 @@ func (r *DraftRepository) List() ([]*models.Draft, error) {
 	return drafts, nil
 }
 
+
+// CreateFromConfig creates a draft with the teams, roster slots, scoring
+// rules and run settings of a saved configuration in one transaction.
+func (r *DraftRepository) CreateFromConfig(draft *models.Draft, cfg *models.DraftConfig) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO drafts (name, num_teams, scoring_format, draft_type, qb_setting, snake_draft, status, max_rounds, commissioner_id, season, sport)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, COALESCE(NULLIF(?, ''), 'football'))
+	`
+	result, err := tx.Exec(query, draft.Name, draft.NumTeams, draft.ScoringFormat, draft.DraftType,
+		draft.QBSetting, draft.SnakeDraft, draft.Status, draft.MaxRounds, draft.CommissionerID, draft.Season, draft.Sport)
+	if err != nil {
+		return fmt.Errorf("failed to create draft: %w", err)
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("failed to get last insert id: %w", err)
+	}
+
+	for _, team := range cfg.Teams {
+		query := `INSERT INTO teams (draft_id, team_name, owner_name, draft_position) VALUES (?, ?, ?, ?)`
+		if _, err := tx.Exec(query, id, team.TeamName, team.OwnerName, team.DraftPosition); err != nil {
+			return fmt.Errorf("failed to create team: %w", err)
+		}
+	}
+	if err := insertDraftSettings(tx, id, cfg); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	draft.ID = int(id)
+	return nil
+}
+
+// insertDraftSettings saves a configuration's roster slots, scoring rules
+// and run settings for a new draft.
+func insertDraftSettings(tx *sql.Tx, id int64, cfg *models.DraftConfig) error {
+	for _, slot := range cfg.RosterSlots {
+		query := `INSERT INTO roster_slots (draft_id, slot, count) VALUES (?, ?, ?)`
+		if _, err := tx.Exec(query, id, slot.Slot, slot.Count); err != nil {
+			return fmt.Errorf("failed to create roster slot: %w", err)
+		}
+	}
+	for _, rule := range cfg.ScoringRules {
+		query := `INSERT INTO scoring_rules (draft_id, position, stat, points) VALUES (?, ?, ?, ?)`
+		if _, err := tx.Exec(query, id, rule.Position, rule.Stat, rule.Points); err != nil {
+			return fmt.Errorf("failed to create scoring rule: %w", err)
+		}
+	}
+	if runs := cfg.RunSettings; runs != nil {
+		query := `INSERT INTO run_settings (draft_id, pick_window, threshold, tier_gap) VALUES (?, ?, ?, ?)`
+		if _, err := tx.Exec(query, id, runs.Window, runs.Threshold, runs.TierGap); err != nil {
+			return fmt.Errorf("failed to save run settings: %w", err)
+		}
+	}
+	return nil
+}
+
+// CreateRookieDraft creates a dynasty league's rookie draft from its plan
+// in one transaction: the draft, its teams in order, the rosters they carry
+// over, the owners of traded picks and the settings of cfg, whose teams are
+// ignored.
+func (r *DraftRepository) CreateRookieDraft(draft *models.Draft, plan *models.RookieDraftPlan, cfg *models.DraftConfig) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO drafts (name, num_teams, scoring_format, draft_type, qb_setting, snake_draft, status, max_rounds, commissioner_id, season, sport, league_id, rookie, previous_draft_id)
+		VALUES (?, ?, ?, ?, ?, 0, ?, ?, ?, ?, COALESCE(NULLIF(?, ''), 'football'), ?, 1, ?)
+	`
+	result, err := tx.Exec(query, draft.Name, draft.NumTeams, draft.ScoringFormat, draft.DraftType,
+		draft.QBSetting, draft.Status, draft.MaxRounds, draft.CommissionerID, draft.Season, draft.Sport,
+		draft.LeagueID, draft.PreviousDraftID)
+	if err != nil {
+		return fmt.Errorf("failed to create draft: %w", err)
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("failed to get last insert id: %w", err)
+	}
+
+	teamIDs := make(map[int]int64)
+	for _, team := range plan.Teams {
+		query := `INSERT INTO teams (draft_id, team_name, owner_name, draft_position, franchise_id, manager_id) VALUES (?, ?, ?, ?, ?, ?)`
+		result, err := tx.Exec(query, id, team.TeamName, team.OwnerName, team.DraftPosition, team.FranchiseID, team.ManagerID)
+		if err != nil {
+			return fmt.Errorf("failed to create team: %w", err)
+		}
+		if teamIDs[team.DraftPosition], err = result.LastInsertId(); err != nil {
+			return fmt.Errorf("failed to get last insert id: %w", err)
+		}
+	}
+	for position, playerIDs := range plan.Rosters {
+		for _, playerID := range playerIDs {
+			query := `INSERT OR IGNORE INTO carried_players (draft_id, team_id, player_id) VALUES (?, ?, ?)`
+			if _, err := tx.Exec(query, id, teamIDs[position], playerID); err != nil {
+				return fmt.Errorf("failed to carry over player: %w", err)
+			}
+		}
+	}
+	for overall, position := range plan.Owners {
+		query := `INSERT INTO pick_owners (draft_id, overall_pick, team_id) VALUES (?, ?, ?)`
+		if _, err := tx.Exec(query, id, overall, teamIDs[position]); err != nil {
+			return fmt.Errorf("failed to save pick owner: %w", err)
+		}
+	}
+	if err := insertDraftSettings(tx, id, cfg); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	draft.ID = int(id)
+	draft.SnakeDraft = false
+	draft.Rookie = true
+	return nil
+}
+
+// Import records a draft held outside the app, with its teams, picks and
+// roster slots, in one transaction. Picks of players not yet in the
+// database create them first. A draft with a CreatedAt is dated then
+// rather than the day it was imported.
+func (r *DraftRepository) Import(draft *models.Draft, teams []models.Team, picks []models.ImportedPick, slots []models.RosterSlot) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var createdAt interface{}
+	if !draft.CreatedAt.IsZero() {
+		createdAt = draft.CreatedAt.UTC().Format("2006-01-02 15:04:05")
+	}
+	query := `
+		INSERT INTO drafts (name, num_teams, scoring_format, draft_type, qb_setting, snake_draft, status, max_rounds, commissioner_id, completed, season, sport, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, COALESCE(NULLIF(?, ''), 'football'), COALESCE(?, CURRENT_TIMESTAMP))
+	`
+	result, err := tx.Exec(query, draft.Name, draft.NumTeams, draft.ScoringFormat, draft.DraftType,
+		draft.QBSetting, draft.SnakeDraft, draft.Status, draft.MaxRounds, draft.CommissionerID, draft.Completed,
+		draft.Season, draft.Sport, createdAt)
+	if err != nil {
+		return fmt.Errorf("failed to create draft: %w", err)
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("failed to get last insert id: %w", err)
+	}
+
+	teamIDs := make(map[int]int64)
+	for _, team := range teams {
+		query := `INSERT INTO teams (draft_id, team_name, owner_name, draft_position) VALUES (?, ?, ?, ?)`
+		result, err := tx.Exec(query, id, team.TeamName, team.OwnerName, team.DraftPosition)
+		if err != nil {
+			return fmt.Errorf("failed to create team: %w", err)
+		}
+		if teamIDs[team.DraftPosition], err = result.LastInsertId(); err != nil {
+			return fmt.Errorf("failed to get last insert id: %w", err)
+		}
+	}
+	for _, pick := range picks {
+		if pick.NewPlayer != nil {
+			if err := insertPlayer(tx, pick.NewPlayer); err != nil {
+				return err
+			}
+			pick.PlayerID = pick.NewPlayer.ID
+		}
+		teamID, ok := teamIDs[pick.DraftPosition]
+		if !ok {
+			return fmt.Errorf("pick %d: no team at draft position %d", pick.OverallPick, pick.DraftPosition)
+		}
+		query := `INSERT INTO picks (draft_id, team_id, player_id, round, overall_pick, is_traded, adp_rank) VALUES (?, ?, ?, ?, ?, 0, ?)`
+		if _, err := tx.Exec(query, id, teamID, pick.PlayerID, pick.Round, pick.OverallPick, pick.ADPRank); err != nil {
+			return fmt.Errorf("failed to create pick: %w", err)
+		}
+	}
+	if err := insertDraftSettings(tx, id, models.NewDraftConfig(draft, nil, slots, nil, nil)); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	draft.ID = int(id)
+	return nil
+}
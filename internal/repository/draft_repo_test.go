@@ -2,6 +2,7 @@ package repository
 
 import (
 	"testing"
+	"time"
 
 	"github.com/vibes/draft-board/internal/database"
 	"github.com/vibes/draft-board/internal/models"
@@ -180,3 +181,123 @@ func TestDraftRepository_Delete(t *testing.T) {
 		t.Error("GetByID() expected error for deleted draft, got nil")
 	}
 }
+
+func TestDraftRepository_CreateRookieDraft(t *testing.T) {
+	db := database.NewTestDB(t)
+	defer database.CloseTestDB(t, db)
+
+	repo := NewDraftRepository(db)
+	playerRepo := NewPlayerRepository(db)
+	pickRepo := NewPickRepository(db)
+
+	rookieSeason := 2026
+	veteran := &models.Player{Name: "Bijan Robinson", Team: "ATL", Position: "RB"}
+	rookie := &models.Player{Name: "Jeremiyah Love", Team: "ARI", Position: "RB", RookieSeason: &rookieSeason}
+	for _, p := range []*models.Player{veteran, rookie} {
+		if err := playerRepo.Create(p); err != nil {
+			t.Fatalf("Failed to create player: %v", err)
+		}
+	}
+
+	leagueID := 1
+	draft := &models.Draft{
+		Name: "Rookie Draft", NumTeams: 2, ScoringFormat: "PPR", DraftType: "Dynasty",
+		Status: "setup", MaxRounds: 2, Season: 2026, LeagueID: &leagueID,
+	}
+	plan := &models.RookieDraftPlan{
+		Teams: []models.Team{
+			{TeamName: "Bears", OwnerName: "Bob", DraftPosition: 1},
+			{TeamName: "Aces", OwnerName: "Ann", DraftPosition: 2},
+		},
+		Rosters: map[int][]int{2: {veteran.ID}},
+		Owners:  map[int]int{3: 2},
+	}
+	cfg := &models.DraftConfig{RosterSlots: []models.ConfigSlot{{Slot: "RB", Count: 2}}}
+	if err := repo.CreateRookieDraft(draft, plan, cfg); err != nil {
+		t.Fatalf("CreateRookieDraft() error = %v", err)
+	}
+
+	got, err := repo.GetByID(draft.ID)
+	if err != nil {
+		t.Fatalf("GetByID() error = %v", err)
+	}
+	if !got.Rookie || got.SnakeDraft || got.RookieSeason() != 2026 || *got.LeagueID != leagueID {
+		t.Errorf("GetByID() = %+v, want a linear rookie draft in the league", got)
+	}
+
+	teams, _ := NewTeamRepository(db).GetByDraft(draft.ID)
+	carried, err := pickRepo.CarriedPlayers(draft.ID)
+	if err != nil || len(carried) != 1 || carried[0].TeamID != teams[1].ID || carried[0].PlayerID != veteran.ID {
+		t.Errorf("CarriedPlayers() = %+v, %v, want Bijan on the Aces", carried, err)
+	}
+	owners, err := pickRepo.Owners(draft.ID)
+	if err != nil || owners[3] != teams[1].ID {
+		t.Errorf("Owners() = %v, %v, want pick 3 owned by the Aces", owners, err)
+	}
+
+	available, err := playerRepo.GetAvailable(draft.ID, PlayerFilters{RookieSeason: got.RookieSeason()})
+	if err != nil {
+		t.Fatalf("GetAvailable() error = %v", err)
+	}
+	if len(available) != 1 || available[0].ID != rookie.ID {
+		t.Errorf("GetAvailable() = %+v, want only the rookie", available)
+	}
+}
+
+func TestDraftRepository_Import(t *testing.T) {
+	db := database.NewTestDB(t)
+	defer database.CloseTestDB(t, db)
+
+	repo := NewDraftRepository(db)
+	playerRepo := NewPlayerRepository(db)
+	existing := &models.Player{Name: "Bijan Robinson", Team: "ATL", Position: "RB"}
+	if err := playerRepo.Create(existing); err != nil {
+		t.Fatalf("Failed to create player: %v", err)
+	}
+
+	adp := 3
+	draft := &models.Draft{
+		Name: "Paper Draft", NumTeams: 2, ScoringFormat: "PPR", DraftType: "Redraft",
+		Status: "completed", Completed: true, SnakeDraft: true, MaxRounds: 1, Season: 2025,
+		CreatedAt: time.Date(2025, time.August, 30, 0, 0, 0, 0, time.UTC),
+	}
+	teams := []models.Team{
+		{TeamName: "Aces", OwnerName: "Ann", DraftPosition: 1},
+		{TeamName: "Bears", OwnerName: "Bob", DraftPosition: 2},
+	}
+	picks := []models.ImportedPick{
+		{Round: 1, OverallPick: 1, DraftPosition: 1, PlayerID: existing.ID, ADPRank: &adp},
+		{Round: 1, OverallPick: 2, DraftPosition: 2, NewPlayer: &models.Player{Name: "Deep Sleeper", Team: "NYG", Position: "WR", IsCustom: true}},
+	}
+	if err := repo.Import(draft, teams, picks, []models.RosterSlot{{Slot: "WR", Count: 2}}); err != nil {
+		t.Fatalf("Import() error = %v", err)
+	}
+
+	got, err := repo.GetByID(draft.ID)
+	if err != nil || !got.Completed || got.Season != 2025 {
+		t.Fatalf("GetByID() = %+v, %v", got, err)
+	}
+	if held, _ := NewPickRepository(db).GetCompleted(HistoryFilter{From: "2025-08-30", To: "2025-08-30"}); len(held) != 2 {
+		t.Errorf("GetCompleted() on the draft day = %d picks, want 2", len(held))
+	}
+	saved, err := NewPickRepository(db).GetByDraft(draft.ID)
+	if err != nil || len(saved) != 2 {
+		t.Fatalf("GetByDraft() = %+v, %v", saved, err)
+	}
+	if saved[0].PlayerID != existing.ID || saved[0].ADPRank == nil || *saved[0].ADPRank != 3 {
+		t.Errorf("pick 1 = %+v", saved[0])
+	}
+	sleeper, err := playerRepo.GetByID(saved[1].PlayerID)
+	if err != nil || sleeper.Name != "Deep Sleeper" || !sleeper.IsCustom {
+		t.Errorf("pick 2 player = %+v, %v", sleeper, err)
+	}
+
+	bad := []models.ImportedPick{{Round: 1, OverallPick: 1, DraftPosition: 3, PlayerID: existing.ID}}
+	if err := repo.Import(&models.Draft{Name: "Bad", NumTeams: 2}, teams, bad, nil); err == nil {
+		t.Error("Import() with a pick for no team should fail")
+	}
+	drafts, _ := repo.List()
+	if len(drafts) != 1 {
+		t.Errorf("failed import left %d drafts, want 1", len(drafts))
+	}
+}
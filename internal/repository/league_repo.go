@@ -0,0 +1,380 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/vibes/draft-board/internal/models"
+)
+
+type LeagueRepository struct {
+	db *sql.DB
+}
+
+func NewLeagueRepository(db *sql.DB) *LeagueRepository {
+	return &LeagueRepository{db: db}
+}
+
+func (r *LeagueRepository) Create(league *models.League) error {
+	query := `INSERT INTO leagues (name, sport) VALUES (?, COALESCE(NULLIF(?, ''), 'football'))`
+	result, err := r.db.Exec(query, league.Name, league.Sport)
+	if err != nil {
+		return fmt.Errorf("failed to create league: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("failed to get last insert id: %w", err)
+	}
+
+	league.ID = int(id)
+	return nil
+}
+
+func (r *LeagueRepository) GetByID(id int) (*models.League, error) {
+	league := &models.League{}
+	err := r.db.QueryRow(`SELECT * FROM leagues WHERE id = ?`, id).
+		Scan(&league.ID, &league.Name, &league.Sport, &league.CreatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("league not found")
+		}
+		return nil, fmt.Errorf("failed to get league: %w", err)
+	}
+	return league, nil
+}
+
+// List returns every league by name.
+func (r *LeagueRepository) List() ([]*models.League, error) {
+	rows, err := r.db.Query(`SELECT * FROM leagues ORDER BY name`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list leagues: %w", err)
+	}
+	defer rows.Close()
+
+	var leagues []*models.League
+	for rows.Next() {
+		league := &models.League{}
+		if err := rows.Scan(&league.ID, &league.Name, &league.Sport, &league.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan league: %w", err)
+		}
+		leagues = append(leagues, league)
+	}
+	return leagues, nil
+}
+
+// Managers returns the league's managers by name.
+func (r *LeagueRepository) Managers(leagueID int) ([]models.Manager, error) {
+	rows, err := r.db.Query(`SELECT * FROM managers WHERE league_id = ? ORDER BY name`, leagueID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get managers: %w", err)
+	}
+	defer rows.Close()
+
+	var managers []models.Manager
+	for rows.Next() {
+		var m models.Manager
+		if err := rows.Scan(&m.ID, &m.LeagueID, &m.Name, &m.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan manager: %w", err)
+		}
+		managers = append(managers, m)
+	}
+	return managers, nil
+}
+
+// Franchises returns the league's franchises by name.
+func (r *LeagueRepository) Franchises(leagueID int) ([]models.Franchise, error) {
+	rows, err := r.db.Query(`SELECT * FROM franchises WHERE league_id = ? ORDER BY name`, leagueID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get franchises: %w", err)
+	}
+	defer rows.Close()
+
+	var franchises []models.Franchise
+	for rows.Next() {
+		var f models.Franchise
+		if err := rows.Scan(&f.ID, &f.LeagueID, &f.Name, &f.ManagerID, &f.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan franchise: %w", err)
+		}
+		franchises = append(franchises, f)
+	}
+	return franchises, nil
+}
+
+// SaveFranchise creates the franchise, or renames it and changes its
+// manager when it has an ID. A manager name not yet in the league adds the
+// manager; an empty one leaves the franchise without a manager.
+func (r *LeagueRepository) SaveFranchise(franchise *models.Franchise, managerName string) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	franchise.ManagerID, err = managerID(tx, franchise.LeagueID, managerName)
+	if err != nil {
+		return err
+	}
+
+	if franchise.ID == 0 {
+		result, err := tx.Exec(`INSERT INTO franchises (league_id, name, manager_id) VALUES (?, ?, ?)`,
+			franchise.LeagueID, franchise.Name, franchise.ManagerID)
+		if err != nil {
+			return fmt.Errorf("failed to create franchise: %w", err)
+		}
+		id, err := result.LastInsertId()
+		if err != nil {
+			return fmt.Errorf("failed to get last insert id: %w", err)
+		}
+		franchise.ID = int(id)
+	} else {
+		_, err := tx.Exec(`UPDATE franchises SET name = ?, manager_id = ? WHERE id = ? AND league_id = ?`,
+			franchise.Name, franchise.ManagerID, franchise.ID, franchise.LeagueID)
+		if err != nil {
+			return fmt.Errorf("failed to update franchise: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// managerID returns the ID of the league's manager with the name, adding
+// the manager if there is none. An empty name has no manager.
+func managerID(tx *sql.Tx, leagueID int, name string) (*int, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, nil
+	}
+	var id int
+	err := tx.QueryRow(`SELECT id FROM managers WHERE league_id = ? AND name = ? COLLATE NOCASE`, leagueID, name).Scan(&id)
+	if err == sql.ErrNoRows {
+		result, err := tx.Exec(`INSERT INTO managers (league_id, name) VALUES (?, ?)`, leagueID, name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create manager: %w", err)
+		}
+		newID, err := result.LastInsertId()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get last insert id: %w", err)
+		}
+		id = int(newID)
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get manager: %w", err)
+	}
+	return &id, nil
+}
+
+// Drafts returns the league's drafts by season, oldest first.
+func (r *LeagueRepository) Drafts(leagueID int) ([]*models.Draft, error) {
+	rows, err := r.db.Query(`SELECT * FROM drafts WHERE league_id = ? ORDER BY season, created_at, id`, leagueID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get league drafts: %w", err)
+	}
+	defer rows.Close()
+
+	var drafts []*models.Draft
+	for rows.Next() {
+		draft := &models.Draft{}
+		err := rows.Scan(
+			&draft.ID, &draft.Name, &draft.NumTeams, &draft.ScoringFormat,
+			&draft.DraftType, &draft.QBSetting, &draft.SnakeDraft, &draft.Status,
+			&draft.MaxRounds, &draft.CommissionerID, &draft.CreatedAt, &draft.Completed,
+			&draft.Season, &draft.Sport, &draft.LeagueID, &draft.Rookie, &draft.PreviousDraftID,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan draft: %w", err)
+		}
+		drafts = append(drafts, draft)
+	}
+	return drafts, nil
+}
+
+// AttachDraft adds the draft to the league as its season's draft. Each of
+// the draft's teams not yet linked is linked to the franchise with its
+// name, and to the manager named as its owner, adding either if the league
+// has none. A new franchise is run by the team's owner.
+func (r *LeagueRepository) AttachDraft(leagueID, draftID int) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(`UPDATE drafts SET league_id = ? WHERE id = ?`, leagueID, draftID); err != nil {
+		return fmt.Errorf("failed to attach draft: %w", err)
+	}
+
+	rows, err := tx.Query(`SELECT id, team_name, COALESCE(owner_name, '') FROM teams WHERE draft_id = ? AND franchise_id IS NULL`, draftID)
+	if err != nil {
+		return fmt.Errorf("failed to get teams: %w", err)
+	}
+	type unlinked struct {
+		id          int
+		name, owner string
+	}
+	var teams []unlinked
+	for rows.Next() {
+		var team unlinked
+		if err := rows.Scan(&team.id, &team.name, &team.owner); err != nil {
+			rows.Close()
+			return fmt.Errorf("failed to scan team: %w", err)
+		}
+		teams = append(teams, team)
+	}
+	rows.Close()
+
+	for _, team := range teams {
+		manager, err := managerID(tx, leagueID, team.owner)
+		if err != nil {
+			return err
+		}
+
+		var franchiseID int
+		err = tx.QueryRow(`SELECT id FROM franchises WHERE league_id = ? AND name = ? COLLATE NOCASE`, leagueID, team.name).Scan(&franchiseID)
+		if err == sql.ErrNoRows {
+			result, err := tx.Exec(`INSERT INTO franchises (league_id, name, manager_id) VALUES (?, ?, ?)`, leagueID, team.name, manager)
+			if err != nil {
+				return fmt.Errorf("failed to create franchise: %w", err)
+			}
+			id, err := result.LastInsertId()
+			if err != nil {
+				return fmt.Errorf("failed to get last insert id: %w", err)
+			}
+			franchiseID = int(id)
+		} else if err != nil {
+			return fmt.Errorf("failed to get franchise: %w", err)
+		}
+
+		if _, err := tx.Exec(`UPDATE teams SET franchise_id = ?, manager_id = ? WHERE id = ?`, franchiseID, manager, team.id); err != nil {
+			return fmt.Errorf("failed to link team: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// DetachDraft removes the draft from its league and unlinks its teams.
+func (r *LeagueRepository) DetachDraft(draftID int) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(`UPDATE drafts SET league_id = NULL WHERE id = ?`, draftID); err != nil {
+		return fmt.Errorf("failed to detach draft: %w", err)
+	}
+	if _, err := tx.Exec(`UPDATE teams SET franchise_id = NULL, manager_id = NULL WHERE draft_id = ?`, draftID); err != nil {
+		return fmt.Errorf("failed to unlink teams: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// LinkTeam sets the franchise a team was and the manager who ran it.
+func (r *LeagueRepository) LinkTeam(teamID int, franchiseID, managerID *int) error {
+	if _, err := r.db.Exec(`UPDATE teams SET franchise_id = ?, manager_id = ? WHERE id = ?`, franchiseID, managerID, teamID); err != nil {
+		return fmt.Errorf("failed to link team: %w", err)
+	}
+	return nil
+}
+
+// Picks returns every pick made in the league's drafts, by season and then
+// pick order. Players deleted since keep their pick with an empty name.
+func (r *LeagueRepository) Picks(leagueID int) ([]models.LeaguePick, error) {
+	query := `
+		SELECT d.id, d.name, d.season, t.id, t.team_name, t.franchise_id, t.manager_id,
+		       p.round, p.overall_pick, p.adp_rank, p.player_id,
+		       COALESCE(pl.name, ''), COALESCE(pl.position, ''), COALESCE(ps.team, pl.team, '')
+		FROM picks p
+		JOIN teams t ON t.id = p.team_id
+		JOIN drafts d ON d.id = p.draft_id
+		LEFT JOIN players pl ON pl.id = p.player_id
+		LEFT JOIN player_seasons ps ON ps.player_id = p.player_id AND ps.season = d.season
+		WHERE d.league_id = ?
+		ORDER BY d.season, d.created_at, d.id, p.overall_pick
+	`
+	rows, err := r.db.Query(query, leagueID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get league picks: %w", err)
+	}
+	defer rows.Close()
+
+	var picks []models.LeaguePick
+	for rows.Next() {
+		var p models.LeaguePick
+		err := rows.Scan(&p.DraftID, &p.DraftName, &p.Season, &p.TeamID, &p.TeamName, &p.FranchiseID, &p.ManagerID,
+			&p.Round, &p.OverallPick, &p.ADPRank, &p.PlayerID, &p.PlayerName, &p.Position, &p.PlayerTeam)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan league pick: %w", err)
+		}
+		picks = append(picks, p)
+	}
+	return picks, nil
+}
+
+// FuturePicks returns the league's traded picks by season, round and
+// original franchise.
+func (r *LeagueRepository) FuturePicks(leagueID int) ([]models.FuturePick, error) {
+	query := `SELECT * FROM future_picks WHERE league_id = ? ORDER BY season, round, original_franchise_id`
+	rows, err := r.db.Query(query, leagueID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get future picks: %w", err)
+	}
+	defer rows.Close()
+
+	var picks []models.FuturePick
+	for rows.Next() {
+		var p models.FuturePick
+		err := rows.Scan(&p.ID, &p.LeagueID, &p.Season, &p.Round, &p.OriginalFranchiseID,
+			&p.OwnerFranchiseID, &p.Note, &p.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan future pick: %w", err)
+		}
+		picks = append(picks, p)
+	}
+	return picks, nil
+}
+
+// SaveFuturePick records who owns a franchise's pick in a season's round,
+// replacing any earlier trade of the same pick. A pick traded back to its
+// original franchise is no longer recorded.
+func (r *LeagueRepository) SaveFuturePick(pick *models.FuturePick) error {
+	if pick.OwnerFranchiseID == pick.OriginalFranchiseID {
+		query := `DELETE FROM future_picks WHERE league_id = ? AND season = ? AND round = ? AND original_franchise_id = ?`
+		if _, err := r.db.Exec(query, pick.LeagueID, pick.Season, pick.Round, pick.OriginalFranchiseID); err != nil {
+			return fmt.Errorf("failed to save future pick: %w", err)
+		}
+		return nil
+	}
+
+	query := `
+		INSERT INTO future_picks (league_id, season, round, original_franchise_id, owner_franchise_id, note)
+		VALUES (?, ?, ?, ?, ?, ?)
+		ON CONFLICT(league_id, season, round, original_franchise_id)
+		DO UPDATE SET owner_franchise_id = excluded.owner_franchise_id, note = excluded.note
+	`
+	_, err := r.db.Exec(query, pick.LeagueID, pick.Season, pick.Round, pick.OriginalFranchiseID,
+		pick.OwnerFranchiseID, pick.Note)
+	if err != nil {
+		return fmt.Errorf("failed to save future pick: %w", err)
+	}
+	return nil
+}
+
+// DeleteFuturePick removes a traded pick from the league.
+func (r *LeagueRepository) DeleteFuturePick(leagueID, id int) error {
+	if _, err := r.db.Exec(`DELETE FROM future_picks WHERE id = ? AND league_id = ?`, id, leagueID); err != nil {
+		return fmt.Errorf("failed to delete future pick: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/vibes/draft-board/internal/database"
+	"github.com/vibes/draft-board/internal/models"
+)
+
+func TestLeagueRepository_AttachDraft(t *testing.T) {
+	db := database.NewTestDB(t)
+	defer database.CloseTestDB(t, db)
+
+	repo := NewLeagueRepository(db)
+	league := &models.League{Name: "Home League"}
+	if err := repo.Create(league); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if err := repo.SaveFranchise(&models.Franchise{LeagueID: league.ID, Name: "Aces"}, "Ann"); err != nil {
+		t.Fatalf("SaveFranchise() error = %v", err)
+	}
+
+	draftRepo := NewDraftRepository(db)
+	teamRepo := NewTeamRepository(db)
+	playerRepo := NewPlayerRepository(db)
+	pickRepo := NewPickRepository(db)
+	player := &models.Player{Name: "Bijan Robinson", Team: "ATL", Position: "RB"}
+	if err := playerRepo.Create(player); err != nil {
+		t.Fatalf("Failed to create player: %v", err)
+	}
+
+	for _, season := range []int{2024, 2025} {
+		draft := &models.Draft{Name: "Draft", NumTeams: 2, ScoringFormat: "PPR", DraftType: "Redraft", Status: "setup", MaxRounds: 2, Season: season}
+		if err := draftRepo.Create(draft); err != nil {
+			t.Fatalf("Failed to create draft: %v", err)
+		}
+		aces := &models.Team{DraftID: draft.ID, TeamName: "ACES", OwnerName: "ann", DraftPosition: 1}
+		bears := &models.Team{DraftID: draft.ID, TeamName: "Bears", OwnerName: "Bob", DraftPosition: 2}
+		for _, team := range []*models.Team{aces, bears} {
+			if err := teamRepo.Create(team); err != nil {
+				t.Fatalf("Failed to create team: %v", err)
+			}
+		}
+		if err := pickRepo.Create(&models.Pick{DraftID: draft.ID, TeamID: aces.ID, PlayerID: player.ID, Round: 1, OverallPick: 1}); err != nil {
+			t.Fatalf("Failed to create pick: %v", err)
+		}
+		if err := repo.AttachDraft(league.ID, draft.ID); err != nil {
+			t.Fatalf("AttachDraft() error = %v", err)
+		}
+	}
+
+	franchises, _ := repo.Franchises(league.ID)
+	managers, _ := repo.Managers(league.ID)
+	if len(franchises) != 2 || len(managers) != 2 {
+		t.Fatalf("franchises = %+v, managers = %+v, want Aces and Bears run by Ann and Bob", franchises, managers)
+	}
+
+	drafts, err := repo.Drafts(league.ID)
+	if err != nil || len(drafts) != 2 || drafts[0].Season != 2024 || *drafts[1].LeagueID != league.ID {
+		t.Fatalf("Drafts() = %+v, %v", drafts, err)
+	}
+
+	picks, err := repo.Picks(league.ID)
+	if err != nil {
+		t.Fatalf("Picks() error = %v", err)
+	}
+	if len(picks) != 2 || picks[0].Season != 2024 || picks[0].PlayerName != "Bijan Robinson" {
+		t.Fatalf("Picks() = %+v", picks)
+	}
+	if picks[0].FranchiseID == nil || *picks[0].FranchiseID != franchises[0].ID || *picks[1].ManagerID != *franchises[0].ManagerID {
+		t.Errorf("picks not linked to the Aces and Ann: %+v", picks)
+	}
+
+	if err := repo.DetachDraft(drafts[0].ID); err != nil {
+		t.Fatalf("DetachDraft() error = %v", err)
+	}
+	teams, _ := teamRepo.GetByDraft(drafts[0].ID)
+	if teams[0].FranchiseID != nil {
+		t.Errorf("detached team still linked: %+v", teams[0])
+	}
+	if drafts, _ := repo.Drafts(league.ID); len(drafts) != 1 {
+		t.Errorf("Drafts() after detach = %d, want 1", len(drafts))
+	}
+}
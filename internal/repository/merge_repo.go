@@ -0,0 +1,220 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/vibes/draft-board/internal/models"
+)
+
+type MergeRepository struct {
+	db *sql.DB
+}
+
+func NewMergeRepository(db *sql.DB) *MergeRepository {
+	return &MergeRepository{db: db}
+}
+
+// playerRankTables hold at most one row per player and key; a merged
+// player's rows move to the survivor unless it already has its own.
+var playerRankTables = []string{
+	"draft_rankings", "player_tiers", "source_ranks", "player_projections", "player_seasons",
+	"carried_players", "player_platform_ids",
+}
+
+// Merge folds duplicate players into the one kept: their picks, queue
+// entries and notes move to the survivor, the duplicates are deleted and
+// each merge is recorded, all in one transaction. It fails without changes
+// if two of the players were picked in the same draft.
+func (r *MergeRepository) Merge(keepID int, mergeIDs []int) ([]models.PlayerMerge, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var merges []models.PlayerMerge
+	for _, mergeID := range mergeIDs {
+		merge, err := mergePlayer(tx, keepID, mergeID)
+		if err != nil {
+			return nil, err
+		}
+		merges = append(merges, *merge)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return merges, nil
+}
+
+func mergePlayer(tx *sql.Tx, keepID, mergeID int) (*models.PlayerMerge, error) {
+	if keepID == mergeID {
+		return nil, fmt.Errorf("cannot merge player %d into itself", keepID)
+	}
+
+	merge := &models.PlayerMerge{KeptPlayerID: keepID, MergedPlayerID: mergeID}
+	err := tx.QueryRow(`SELECT name, team, position FROM players WHERE id = ?`, mergeID).
+		Scan(&merge.MergedName, &merge.MergedTeam, &merge.MergedPosition)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("player %d not found", mergeID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get player: %w", err)
+	}
+	var exists int
+	if err := tx.QueryRow(`SELECT COUNT(*) FROM players WHERE id = ?`, keepID).Scan(&exists); err != nil {
+		return nil, fmt.Errorf("failed to get player: %w", err)
+	}
+	if exists == 0 {
+		return nil, fmt.Errorf("player %d not found", keepID)
+	}
+
+	var draftID int
+	err = tx.QueryRow(`
+		SELECT a.draft_id FROM picks a
+		JOIN picks b ON b.draft_id = a.draft_id
+		WHERE a.player_id = ? AND b.player_id = ?
+		LIMIT 1
+	`, keepID, mergeID).Scan(&draftID)
+	if err == nil {
+		return nil, fmt.Errorf("both players were picked in draft %d", draftID)
+	}
+	if err != sql.ErrNoRows {
+		return nil, fmt.Errorf("failed to check picks: %w", err)
+	}
+
+	result, err := tx.Exec(`UPDATE picks SET player_id = ? WHERE player_id = ?`, keepID, mergeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to move picks: %w", err)
+	}
+	merge.PicksMoved = rowsAffected(result)
+
+	// A team that queued both keeps its entry for the survivor.
+	_, err = tx.Exec(`
+		DELETE FROM draft_queue WHERE player_id = ? AND EXISTS (
+			SELECT 1 FROM draft_queue k
+			WHERE k.draft_id = draft_queue.draft_id AND k.team_id = draft_queue.team_id AND k.player_id = ?
+		)
+	`, mergeID, keepID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to remove duplicate queue entries: %w", err)
+	}
+	result, err = tx.Exec(`UPDATE draft_queue SET player_id = ? WHERE player_id = ?`, keepID, mergeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to move queue entries: %w", err)
+	}
+	merge.QueueMoved = rowsAffected(result)
+
+	if merge.NotesMoved, err = mergeNotes(tx, keepID, mergeID); err != nil {
+		return nil, err
+	}
+
+	for _, table := range playerRankTables {
+		if _, err := tx.Exec(`UPDATE OR IGNORE `+table+` SET player_id = ? WHERE player_id = ?`, keepID, mergeID); err != nil {
+			return nil, fmt.Errorf("failed to move %s: %w", table, err)
+		}
+		if _, err := tx.Exec(`DELETE FROM `+table+` WHERE player_id = ?`, mergeID); err != nil {
+			return nil, fmt.Errorf("failed to clear %s: %w", table, err)
+		}
+	}
+
+	if _, err := tx.Exec(`DELETE FROM players WHERE id = ?`, mergeID); err != nil {
+		return nil, fmt.Errorf("failed to delete player: %w", err)
+	}
+
+	result, err = tx.Exec(`
+		INSERT INTO player_merges (kept_player_id, merged_player_id, merged_name, merged_team, merged_position, picks_moved, queue_moved, notes_moved)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+	`, keepID, mergeID, merge.MergedName, merge.MergedTeam, merge.MergedPosition, merge.PicksMoved, merge.QueueMoved, merge.NotesMoved)
+	if err != nil {
+		return nil, fmt.Errorf("failed to record merge: %w", err)
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get last insert id: %w", err)
+	}
+	merge.ID = int(id)
+	return merge, nil
+}
+
+// mergeNotes moves the duplicate's notes to the survivor. Where a team
+// noted both players in a draft, the texts are joined and the tags combined.
+func mergeNotes(tx *sql.Tx, keepID, mergeID int) (int, error) {
+	rows, err := tx.Query(`
+		SELECT d.id, k.id, k.note, d.note, k.tags, d.tags
+		FROM player_notes d
+		JOIN player_notes k ON k.draft_id = d.draft_id AND k.team_id = d.team_id AND k.player_id = ?
+		WHERE d.player_id = ?
+	`, keepID, mergeID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get notes: %w", err)
+	}
+	type combined struct {
+		dupID, keepID int
+		note, tags    string
+	}
+	var both []combined
+	for rows.Next() {
+		var c combined
+		var keepNote, dupNote, keepTags, dupTags string
+		if err := rows.Scan(&c.dupID, &c.keepID, &keepNote, &dupNote, &keepTags, &dupTags); err != nil {
+			rows.Close()
+			return 0, fmt.Errorf("failed to scan note: %w", err)
+		}
+		c.note = keepNote
+		if dupNote != "" && dupNote != keepNote {
+			if c.note != "" {
+				c.note += "\n"
+			}
+			c.note += dupNote
+		}
+		c.tags = models.NormalizeTags(keepTags + "," + dupTags)
+		both = append(both, c)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, fmt.Errorf("failed to get notes: %w", err)
+	}
+
+	for _, c := range both {
+		if _, err := tx.Exec(`UPDATE player_notes SET note = ?, tags = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ?`, c.note, c.tags, c.keepID); err != nil {
+			return 0, fmt.Errorf("failed to merge note: %w", err)
+		}
+		if _, err := tx.Exec(`DELETE FROM player_notes WHERE id = ?`, c.dupID); err != nil {
+			return 0, fmt.Errorf("failed to delete note: %w", err)
+		}
+	}
+
+	result, err := tx.Exec(`UPDATE player_notes SET player_id = ? WHERE player_id = ?`, keepID, mergeID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to move notes: %w", err)
+	}
+	return len(both) + rowsAffected(result), nil
+}
+
+// List returns the merges made, newest first.
+func (r *MergeRepository) List() ([]models.PlayerMerge, error) {
+	rows, err := r.db.Query(`SELECT * FROM player_merges ORDER BY merged_at DESC, id DESC`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get player merges: %w", err)
+	}
+	defer rows.Close()
+
+	var merges []models.PlayerMerge
+	for rows.Next() {
+		var m models.PlayerMerge
+		err := rows.Scan(&m.ID, &m.KeptPlayerID, &m.MergedPlayerID, &m.MergedName, &m.MergedTeam,
+			&m.MergedPosition, &m.PicksMoved, &m.QueueMoved, &m.NotesMoved, &m.MergedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan player merge: %w", err)
+		}
+		merges = append(merges, m)
+	}
+	return merges, nil
+}
+
+func rowsAffected(result sql.Result) int {
+	n, _ := result.RowsAffected()
+	return int(n)
+}
@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/vibes/draft-board/internal/database"
+	"github.com/vibes/draft-board/internal/models"
+)
+
+func TestMergeRepository_Merge(t *testing.T) {
+	db := database.NewTestDB(t)
+	defer database.CloseTestDB(t, db)
+
+	playerRepo := NewPlayerRepository(db)
+	keep := &models.Player{Name: "Josh Allen", Team: "BUF", Position: "QB"}
+	dup := &models.Player{Name: "Josh Allen", Team: "BUF", Position: "QB", IsCustom: true}
+	for _, p := range []*models.Player{keep, dup} {
+		if err := playerRepo.Create(p); err != nil {
+			t.Fatalf("Failed to create player: %v", err)
+		}
+	}
+
+	pickRepo := NewPickRepository(db)
+	if err := pickRepo.Create(&models.Pick{DraftID: 1, TeamID: 1, PlayerID: dup.ID, Round: 1, OverallPick: 1}); err != nil {
+		t.Fatalf("Failed to create pick: %v", err)
+	}
+	queueRepo := NewQueueRepository(db)
+	queued := []*models.QueueItem{
+		{DraftID: 2, TeamID: 1, PlayerID: keep.ID, QueueOrder: 1},
+		{DraftID: 2, TeamID: 1, PlayerID: dup.ID, QueueOrder: 2},
+		{DraftID: 2, TeamID: 2, PlayerID: dup.ID, QueueOrder: 1},
+	}
+	for _, q := range queued {
+		if err := queueRepo.Create(q); err != nil {
+			t.Fatalf("Failed to create queue item: %v", err)
+		}
+	}
+	noteRepo := NewNoteRepository(db)
+	notes := []*models.PlayerNote{
+		{DraftID: 2, PlayerID: keep.ID, Note: "rushing floor", Tags: "target"},
+		{DraftID: 2, PlayerID: dup.ID, Note: "elite arm", Tags: "target, stack"},
+	}
+	for _, n := range notes {
+		if err := noteRepo.Save(n); err != nil {
+			t.Fatalf("Failed to save note: %v", err)
+		}
+	}
+
+	repo := NewMergeRepository(db)
+	merged, err := repo.Merge(keep.ID, []int{dup.ID})
+	if err != nil {
+		t.Fatalf("Merge() error = %v", err)
+	}
+	if len(merged) != 1 {
+		t.Fatalf("Merge() recorded %d merges, want 1", len(merged))
+	}
+	merge := merged[0]
+	if merge.PicksMoved != 1 || merge.QueueMoved != 1 || merge.NotesMoved != 1 {
+		t.Errorf("Merge() moved picks=%d queue=%d notes=%d, want 1 each", merge.PicksMoved, merge.QueueMoved, merge.NotesMoved)
+	}
+
+	if p, _ := playerRepo.GetByID(dup.ID); p != nil {
+		t.Error("merged player still exists")
+	}
+	picks, err := pickRepo.GetByDraft(1)
+	if err != nil {
+		t.Fatalf("GetByDraft() error = %v", err)
+	}
+	if len(picks) != 1 || picks[0].PlayerID != keep.ID {
+		t.Errorf("picks = %+v, want one pick of player %d", picks, keep.ID)
+	}
+	for team, want := range map[int]int{1: 1, 2: 1} {
+		items, err := queueRepo.GetByTeam(2, team)
+		if err != nil {
+			t.Fatalf("GetByTeam() error = %v", err)
+		}
+		if len(items) != want || items[0].PlayerID != keep.ID {
+			t.Errorf("team %d queue = %+v, want only player %d", team, items, keep.ID)
+		}
+	}
+	note, err := noteRepo.Get(2, 0, keep.ID)
+	if err != nil || note == nil {
+		t.Fatalf("Get() = %v, %v", note, err)
+	}
+	if note.Note != "rushing floor\nelite arm" || note.Tags != "target,stack" {
+		t.Errorf("merged note = %q [%s]", note.Note, note.Tags)
+	}
+
+	merges, err := repo.List()
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(merges) != 1 || merges[0].MergedPlayerID != dup.ID || merges[0].MergedName != "Josh Allen" {
+		t.Errorf("List() = %+v", merges)
+	}
+}
+
+func TestMergeRepository_MergePickedInSameDraft(t *testing.T) {
+	db := database.NewTestDB(t)
+	defer database.CloseTestDB(t, db)
+
+	playerRepo := NewPlayerRepository(db)
+	keep := &models.Player{Name: "Josh Allen", Team: "BUF", Position: "QB"}
+	other := &models.Player{Name: "Josh Allen", Team: "BUF", Position: "QB"}
+	dup := &models.Player{Name: "Josh Allen", Team: "BUF", Position: "QB"}
+	for _, p := range []*models.Player{keep, other, dup} {
+		if err := playerRepo.Create(p); err != nil {
+			t.Fatalf("Failed to create player: %v", err)
+		}
+	}
+	pickRepo := NewPickRepository(db)
+	for i, id := range []int{keep.ID, dup.ID} {
+		if err := pickRepo.Create(&models.Pick{DraftID: 1, TeamID: 1, PlayerID: id, Round: 1, OverallPick: i + 1}); err != nil {
+			t.Fatalf("Failed to create pick: %v", err)
+		}
+	}
+
+	repo := NewMergeRepository(db)
+	if _, err := repo.Merge(keep.ID, []int{other.ID, dup.ID}); err == nil {
+		t.Fatal("Merge() should fail when both players were picked in one draft")
+	}
+	for _, id := range []int{other.ID, dup.ID} {
+		if p, _ := playerRepo.GetByID(id); p == nil {
+			t.Errorf("failed merge deleted player %d", id)
+		}
+	}
+	if merges, _ := repo.List(); len(merges) != 0 {
+		t.Errorf("failed merge was recorded: %+v", merges)
+	}
+}
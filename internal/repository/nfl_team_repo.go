@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/vibes/draft-board/internal/models"
+)
+
+type NFLTeamRepository struct {
+	db *sql.DB
+}
+
+func NewNFLTeamRepository(db *sql.DB) *NFLTeamRepository {
+	return &NFLTeamRepository{db: db}
+}
+
+// List returns the NFL teams ordered by conference, division and name.
+func (r *NFLTeamRepository) List() ([]models.NFLTeam, error) {
+	rows, err := r.db.Query(`SELECT * FROM nfl_teams ORDER BY conference, division, name`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get NFL teams: %w", err)
+	}
+	defer rows.Close()
+
+	var teams []models.NFLTeam
+	for rows.Next() {
+		var t models.NFLTeam
+		if err := rows.Scan(&t.ID, &t.Abbr, &t.Name, &t.Conference, &t.Division, &t.ByeWeek, &t.Aliases); err != nil {
+			return nil, fmt.Errorf("failed to scan NFL team: %w", err)
+		}
+		teams = append(teams, t)
+	}
+	return teams, nil
+}
+
+// Update saves a team's bye week and aliases. Its players take the new bye
+// week in the same transaction.
+func (r *NFLTeamRepository) Update(team *models.NFLTeam) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.Exec(`UPDATE nfl_teams SET bye_week = ?, aliases = ? WHERE abbr = ?`, team.ByeWeek, team.Aliases, team.Abbr)
+	if err != nil {
+		return fmt.Errorf("failed to update NFL team: %w", err)
+	}
+	if rowsAffected(result) == 0 {
+		return fmt.Errorf("NFL team %s not found", team.Abbr)
+	}
+	if team.ByeWeek != nil {
+		if _, err := tx.Exec(`UPDATE players SET bye_week = ? WHERE team = ? AND sport = 'football'`, team.ByeWeek, team.Abbr); err != nil {
+			return fmt.Errorf("failed to update player bye weeks: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/vibes/draft-board/internal/database"
+	"github.com/vibes/draft-board/internal/models"
+)
+
+func TestNFLTeamRepository_Update(t *testing.T) {
+	db := database.NewTestDB(t)
+	defer database.CloseTestDB(t, db)
+
+	repo := NewNFLTeamRepository(db)
+	teams, err := repo.List()
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(teams) != 32 {
+		t.Fatalf("List() = %d teams, want 32", len(teams))
+	}
+
+	playerRepo := NewPlayerRepository(db)
+	player := &models.Player{Name: "Alpha", Team: "KCC", Position: "QB"}
+	if err := playerRepo.Create(player); err != nil {
+		t.Fatalf("Failed to create player: %v", err)
+	}
+
+	week := 4
+	if err := repo.Update(&models.NFLTeam{Abbr: "KCC", ByeWeek: &week, Aliases: "KC,KAN"}); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	got, err := playerRepo.GetByID(player.ID)
+	if err != nil {
+		t.Fatalf("GetByID() error = %v", err)
+	}
+	if got.ByeWeek == nil || *got.ByeWeek != 4 {
+		t.Errorf("player bye = %v, want 4", got.ByeWeek)
+	}
+
+	if err := repo.Update(&models.NFLTeam{Abbr: "XXX"}); err == nil {
+		t.Error("Update() of an unknown team should fail")
+	}
+}
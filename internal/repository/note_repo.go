@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/vibes/draft-board/internal/models"
+)
+
+type NoteRepository struct {
+	db *sql.DB
+}
+
+func NewNoteRepository(db *sql.DB) *NoteRepository {
+	return &NoteRepository{db: db}
+}
+
+// Save stores a note, replacing the one the team already has on the player
+// in the draft. A note with no text or tags is removed.
+func (r *NoteRepository) Save(note *models.PlayerNote) error {
+	note.Tags = models.NormalizeTags(note.Tags)
+	if note.Note == "" && note.Tags == "" {
+		return r.Delete(note.DraftID, note.TeamID, note.PlayerID)
+	}
+
+	query := `
+		INSERT INTO player_notes (draft_id, team_id, player_id, note, tags)
+		VALUES (?, ?, ?, ?, ?)
+		ON CONFLICT(draft_id, team_id, player_id) DO UPDATE SET
+			note = excluded.note, tags = excluded.tags, updated_at = CURRENT_TIMESTAMP
+	`
+	if _, err := r.db.Exec(query, note.DraftID, note.TeamID, note.PlayerID, note.Note, note.Tags); err != nil {
+		return fmt.Errorf("failed to save player note: %w", err)
+	}
+	return nil
+}
+
+// Get returns a team's note on a player, or nil if it has none.
+func (r *NoteRepository) Get(draftID, teamID, playerID int) (*models.PlayerNote, error) {
+	query := `SELECT * FROM player_notes WHERE draft_id = ? AND team_id = ? AND player_id = ?`
+	note := &models.PlayerNote{}
+	err := r.db.QueryRow(query, draftID, teamID, playerID).Scan(
+		&note.ID, &note.DraftID, &note.TeamID, &note.PlayerID, &note.Note, &note.Tags, &note.UpdatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get player note: %w", err)
+	}
+	return note, nil
+}
+
+// GetByDraft returns every note in a draft, shared notes first.
+func (r *NoteRepository) GetByDraft(draftID int) ([]models.PlayerNote, error) {
+	query := `SELECT * FROM player_notes WHERE draft_id = ? ORDER BY team_id, player_id`
+	rows, err := r.db.Query(query, draftID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get player notes: %w", err)
+	}
+	defer rows.Close()
+
+	var notes []models.PlayerNote
+	for rows.Next() {
+		var note models.PlayerNote
+		err := rows.Scan(&note.ID, &note.DraftID, &note.TeamID, &note.PlayerID, &note.Note, &note.Tags, &note.UpdatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan player note: %w", err)
+		}
+		notes = append(notes, note)
+	}
+
+	return notes, nil
+}
+
+func (r *NoteRepository) Delete(draftID, teamID, playerID int) error {
+	query := `DELETE FROM player_notes WHERE draft_id = ? AND team_id = ? AND player_id = ?`
+	if _, err := r.db.Exec(query, draftID, teamID, playerID); err != nil {
+		return fmt.Errorf("failed to delete player note: %w", err)
+	}
+	return nil
+}
+
+// DeleteByDraft removes every note in a draft.
+func (r *NoteRepository) DeleteByDraft(draftID int) error {
+	if _, err := r.db.Exec(`DELETE FROM player_notes WHERE draft_id = ?`, draftID); err != nil {
+		return fmt.Errorf("failed to delete player notes: %w", err)
+	}
+	return nil
+}
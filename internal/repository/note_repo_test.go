@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/vibes/draft-board/internal/database"
+	"github.com/vibes/draft-board/internal/models"
+)
+
+func TestNoteRepository_FiltersAvailable(t *testing.T) {
+	db := database.NewTestDB(t)
+	defer database.CloseTestDB(t, db)
+
+	playerRepo := NewPlayerRepository(db)
+	rank := func(i int) *int { return &i }
+	players := []*models.Player{
+		{Name: "Alpha", Team: "KC", Position: "QB", PPRRank: rank(1)},
+		{Name: "Bravo", Team: "BUF", Position: "RB", PPRRank: rank(2)},
+		{Name: "Charlie", Team: "MIA", Position: "WR", PPRRank: rank(3)},
+	}
+	for _, p := range players {
+		if err := playerRepo.Create(p); err != nil {
+			t.Fatalf("Failed to create player: %v", err)
+		}
+	}
+
+	repo := NewNoteRepository(db)
+	notes := []*models.PlayerNote{
+		{DraftID: 1, PlayerID: players[0].ID, Tags: "Sleeper"},
+		{DraftID: 1, TeamID: 7, PlayerID: players[1].ID, Note: "handcuff for Alpha", Tags: "sleeper, handcuff"},
+		{DraftID: 2, PlayerID: players[2].ID, Tags: "sleeper"},
+	}
+	for _, n := range notes {
+		if err := repo.Save(n); err != nil {
+			t.Fatalf("Save() error = %v", err)
+		}
+	}
+
+	tests := []struct {
+		name    string
+		filters PlayerFilters
+		want    []string
+	}{
+		{name: "shared tag", filters: PlayerFilters{Tag: "sleeper"}, want: []string{"Alpha"}},
+		{name: "team sees its own", filters: PlayerFilters{Tag: "sleeper", NotesTeamID: 7}, want: []string{"Alpha", "Bravo"}},
+		{name: "whole tag only", filters: PlayerFilters{Tag: "hand", NotesTeamID: 7}, want: nil},
+		{name: "note text", filters: PlayerFilters{NoteSearch: "alpha", NotesTeamID: 7}, want: []string{"Bravo"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.filters.ScoringFormat = "PPR"
+			got, err := playerRepo.GetAvailable(1, tt.filters)
+			if err != nil {
+				t.Fatalf("GetAvailable() error = %v", err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("GetAvailable() returned %d players, want %v", len(got), tt.want)
+			}
+			for i := range tt.want {
+				if got[i].Name != tt.want[i] {
+					t.Errorf("GetAvailable()[%d] = %s, want %s", i, got[i].Name, tt.want[i])
+				}
+			}
+		})
+	}
+
+	if err := repo.Save(&models.PlayerNote{DraftID: 1, PlayerID: players[0].ID}); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	if note, err := repo.Get(1, 0, players[0].ID); err != nil || note != nil {
+		t.Errorf("Get() after clearing = %v, %v, want nil", note, err)
+	}
+}
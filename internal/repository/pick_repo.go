@@ -110,9 +110,11 @@ func (r *PickRepository) CountByDraft(draftID int) (int, error) {
 	return count, nil
 }
 
+// GetDraftedPlayerIDs returns the players no longer available in a draft:
+// those picked and, in a rookie draft, those carried over on a roster.
 func (r *PickRepository) GetDraftedPlayerIDs(draftID int) ([]int, error) {
-	query := `SELECT player_id FROM picks WHERE draft_id = ?`
-	rows, err := r.db.Query(query, draftID)
+	query := `SELECT player_id FROM picks WHERE draft_id = ? UNION ALL SELECT player_id FROM carried_players WHERE draft_id = ?`
+	rows, err := r.db.Query(query, draftID, draftID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get drafted player IDs: %w", err)
 	}
@@ -143,3 +145,114 @@ func (r *PickRepository) Update(pick *models.Pick) error {
 	return nil
 }
 
+
+// HistoryFilter selects completed drafts for historical ADP. Zero values
+// match every draft; From and To are inclusive YYYY-MM-DD dates the draft
+// was created.
+type HistoryFilter struct {
+	Sport         string
+	ScoringFormat string
+	DraftType     string
+	NumTeams      int
+	From          string
+	To            string
+}
+
+// GetCompleted returns the picks of every completed draft matching the filter.
+// Rookie drafts are left out: they draft only rookies onto carried rosters,
+// so their picks say nothing about where a player goes in a full draft.
+func (r *PickRepository) GetCompleted(filter HistoryFilter) ([]models.Pick, error) {
+	query := `
+		SELECT p.* FROM picks p
+		JOIN drafts d ON d.id = p.draft_id
+		WHERE (d.status = 'completed' OR d.completed = 1)
+		  AND COALESCE(d.rookie, 0) = 0
+	`
+	var args []interface{}
+	if filter.Sport != "" {
+		query += " AND d.sport = ?"
+		args = append(args, filter.Sport)
+	}
+	if filter.ScoringFormat != "" {
+		query += " AND d.scoring_format = ?"
+		args = append(args, filter.ScoringFormat)
+	}
+	if filter.DraftType != "" {
+		query += " AND d.draft_type = ?"
+		args = append(args, filter.DraftType)
+	}
+	if filter.NumTeams > 0 {
+		query += " AND d.num_teams = ?"
+		args = append(args, filter.NumTeams)
+	}
+	if filter.From != "" {
+		query += " AND date(d.created_at) >= ?"
+		args = append(args, filter.From)
+	}
+	if filter.To != "" {
+		query += " AND date(d.created_at) <= ?"
+		args = append(args, filter.To)
+	}
+	query += " ORDER BY p.draft_id, p.overall_pick"
+
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get completed picks: %w", err)
+	}
+	defer rows.Close()
+
+	var picks []models.Pick
+	for rows.Next() {
+		var pick models.Pick
+		err := rows.Scan(
+			&pick.ID, &pick.DraftID, &pick.TeamID, &pick.PlayerID, &pick.Round,
+			&pick.OverallPick, &pick.IsTraded, &pick.ADPRank, &pick.PickedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan pick: %w", err)
+		}
+		picks = append(picks, pick)
+	}
+
+	return picks, nil
+}
+
+// Owners returns the teams that own a draft's traded picks, keyed by
+// overall pick number.
+func (r *PickRepository) Owners(draftID int) (map[int]int, error) {
+	rows, err := r.db.Query(`SELECT overall_pick, team_id FROM pick_owners WHERE draft_id = ?`, draftID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pick owners: %w", err)
+	}
+	defer rows.Close()
+
+	owners := make(map[int]int)
+	for rows.Next() {
+		var overall, teamID int
+		if err := rows.Scan(&overall, &teamID); err != nil {
+			return nil, fmt.Errorf("failed to scan pick owner: %w", err)
+		}
+		owners[overall] = teamID
+	}
+	return owners, nil
+}
+
+// CarriedPlayers returns the players a rookie draft's teams carried over
+// from the league's previous draft.
+func (r *PickRepository) CarriedPlayers(draftID int) ([]models.CarriedPlayer, error) {
+	rows, err := r.db.Query(`SELECT * FROM carried_players WHERE draft_id = ? ORDER BY team_id, player_id`, draftID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get carried players: %w", err)
+	}
+	defer rows.Close()
+
+	var carried []models.CarriedPlayer
+	for rows.Next() {
+		var c models.CarriedPlayer
+		if err := rows.Scan(&c.DraftID, &c.TeamID, &c.PlayerID); err != nil {
+			return nil, fmt.Errorf("failed to scan carried player: %w", err)
+		}
+		carried = append(carried, c)
+	}
+	return carried, nil
+}
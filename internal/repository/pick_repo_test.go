@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/vibes/draft-board/internal/database"
+	"github.com/vibes/draft-board/internal/models"
+)
+
+func TestPickRepository_GetCompletedSkipsRookieDrafts(t *testing.T) {
+	db := database.NewTestDB(t)
+	defer database.CloseTestDB(t, db)
+
+	draftRepo := NewDraftRepository(db)
+	pickRepo := NewPickRepository(db)
+	player := &models.Player{Name: "Jeremiyah Love", Team: "ARI", Position: "RB"}
+	if err := NewPlayerRepository(db).Create(player); err != nil {
+		t.Fatalf("Failed to create player: %v", err)
+	}
+
+	startup := &models.Draft{
+		Name: "Startup", NumTeams: 2, ScoringFormat: "PPR", DraftType: "Dynasty",
+		Status: "completed", Completed: true, SnakeDraft: true, MaxRounds: 1, Season: 2026,
+	}
+	teams := []models.Team{
+		{TeamName: "Aces", OwnerName: "Ann", DraftPosition: 1},
+		{TeamName: "Bears", OwnerName: "Bob", DraftPosition: 2},
+	}
+	picks := []models.ImportedPick{{Round: 1, OverallPick: 2, DraftPosition: 2, PlayerID: player.ID}}
+	if err := draftRepo.Import(startup, teams, picks, nil); err != nil {
+		t.Fatalf("Import() error = %v", err)
+	}
+
+	rookie := &models.Draft{
+		Name: "Rookie Draft", NumTeams: 2, ScoringFormat: "PPR", DraftType: "Dynasty",
+		Status: "setup", MaxRounds: 1, Season: 2026,
+	}
+	plan := &models.RookieDraftPlan{Teams: teams}
+	if err := draftRepo.CreateRookieDraft(rookie, plan, &models.DraftConfig{}); err != nil {
+		t.Fatalf("CreateRookieDraft() error = %v", err)
+	}
+	rookieTeams, _ := NewTeamRepository(db).GetByDraft(rookie.ID)
+	if err := pickRepo.Create(&models.Pick{DraftID: rookie.ID, TeamID: rookieTeams[0].ID, PlayerID: player.ID, Round: 1, OverallPick: 1}); err != nil {
+		t.Fatalf("Failed to create pick: %v", err)
+	}
+	rookie.Status = "completed"
+	if err := draftRepo.Update(rookie); err != nil {
+		t.Fatalf("Failed to complete rookie draft: %v", err)
+	}
+
+	got, err := pickRepo.GetCompleted(HistoryFilter{DraftType: "Dynasty"})
+	if err != nil {
+		t.Fatalf("GetCompleted() error = %v", err)
+	}
+	if len(got) != 1 || got[0].DraftID != startup.ID {
+		t.Errorf("GetCompleted() = %+v, want only the startup pick", got)
+	}
+}
+
+func TestPickRepository_GetCompletedBySport(t *testing.T) {
+	db := database.NewTestDB(t)
+	defer database.CloseTestDB(t, db)
+
+	draftRepo := NewDraftRepository(db)
+	teams := []models.Team{
+		{TeamName: "Aces", OwnerName: "Ann", DraftPosition: 1},
+		{TeamName: "Bears", OwnerName: "Bob", DraftPosition: 2},
+	}
+	drafts := make(map[string]*models.Draft)
+	for _, s := range []struct{ sport, format string }{{"basketball", "Points"}, {"baseball", "Points"}} {
+		player := &models.Player{Name: s.sport + " star", Team: "ATL", Position: "C", Sport: s.sport}
+		if err := NewPlayerRepository(db).Create(player); err != nil {
+			t.Fatalf("Failed to create player: %v", err)
+		}
+		draft := &models.Draft{
+			Name: s.sport, NumTeams: 2, ScoringFormat: s.format, DraftType: "Redraft", Sport: s.sport,
+			Status: "completed", Completed: true, SnakeDraft: true, MaxRounds: 1, Season: 2026,
+		}
+		picks := []models.ImportedPick{{Round: 1, OverallPick: 1, DraftPosition: 1, PlayerID: player.ID}}
+		if err := draftRepo.Import(draft, teams, picks, nil); err != nil {
+			t.Fatalf("Import() error = %v", err)
+		}
+		drafts[s.sport] = draft
+	}
+
+	got, err := NewPickRepository(db).GetCompleted(HistoryFilter{Sport: "baseball", ScoringFormat: "Points"})
+	if err != nil {
+		t.Fatalf("GetCompleted() error = %v", err)
+	}
+	if len(got) != 1 || got[0].DraftID != drafts["baseball"].ID {
+		t.Errorf("GetCompleted() = %+v, want only the baseball pick", got)
+	}
+}
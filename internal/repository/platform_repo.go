@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/vibes/draft-board/internal/models"
+)
+
+type PlatformRepository struct {
+	db *sql.DB
+}
+
+func NewPlatformRepository(db *sql.DB) *PlatformRepository {
+	return &PlatformRepository{db: db}
+}
+
+// IDs returns a platform's player IDs by our player ID.
+func (r *PlatformRepository) IDs(platform string) (map[int]string, error) {
+	rows, err := r.db.Query(`SELECT player_id, platform_id FROM player_platform_ids WHERE platform = ?`, platform)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get platform IDs: %w", err)
+	}
+	defer rows.Close()
+
+	ids := make(map[int]string)
+	for rows.Next() {
+		var playerID int
+		var platformID string
+		if err := rows.Scan(&playerID, &platformID); err != nil {
+			return nil, fmt.Errorf("failed to scan platform ID: %w", err)
+		}
+		ids[playerID] = platformID
+	}
+	return ids, nil
+}
+
+// Counts returns how many players each platform has an ID for.
+func (r *PlatformRepository) Counts() (map[string]int, error) {
+	rows, err := r.db.Query(`SELECT platform, COUNT(*) FROM player_platform_ids GROUP BY platform`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count platform IDs: %w", err)
+	}
+	defer rows.Close()
+
+	counts := make(map[string]int)
+	for rows.Next() {
+		var platform string
+		var count int
+		if err := rows.Scan(&platform, &count); err != nil {
+			return nil, fmt.Errorf("failed to scan platform count: %w", err)
+		}
+		counts[platform] = count
+	}
+	return counts, nil
+}
+
+// Save sets players' IDs on their platforms. A player's earlier ID on a
+// platform is replaced, and a platform ID given to a new player is taken
+// from the player who had it.
+func (r *PlatformRepository) Save(ids []models.PlatformID) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	for _, id := range ids {
+		if _, err := tx.Exec(`DELETE FROM player_platform_ids WHERE platform = ? AND platform_id = ? AND player_id != ?`,
+			id.Platform, id.PlatformID, id.PlayerID); err != nil {
+			return fmt.Errorf("failed to clear platform ID: %w", err)
+		}
+		query := `
+			INSERT INTO player_platform_ids (player_id, platform, platform_id) VALUES (?, ?, ?)
+			ON CONFLICT(platform, player_id) DO UPDATE SET platform_id = excluded.platform_id
+		`
+		if _, err := tx.Exec(query, id.PlayerID, id.Platform, id.PlatformID); err != nil {
+			return fmt.Errorf("failed to save platform ID: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
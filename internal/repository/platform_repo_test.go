@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/vibes/draft-board/internal/database"
+	"github.com/vibes/draft-board/internal/models"
+)
+
+func TestPlatformRepository_Save(t *testing.T) {
+	db := database.NewTestDB(t)
+	defer database.CloseTestDB(t, db)
+
+	repo := NewPlatformRepository(db)
+	err := repo.Save([]models.PlatformID{
+		{PlayerID: 1, Platform: "sleeper", PlatformID: "4046"},
+		{PlayerID: 2, Platform: "sleeper", PlatformID: "6794"},
+		{PlayerID: 1, Platform: "espn", PlatformID: "3918298"},
+	})
+	if err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	// A player's new ID replaces the old one, and an ID moved to another
+	// player is taken from the first.
+	err = repo.Save([]models.PlatformID{
+		{PlayerID: 1, Platform: "sleeper", PlatformID: "4984"},
+		{PlayerID: 3, Platform: "sleeper", PlatformID: "6794"},
+	})
+	if err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	ids, err := repo.IDs("sleeper")
+	if err != nil {
+		t.Fatalf("IDs() error = %v", err)
+	}
+	if len(ids) != 2 || ids[1] != "4984" || ids[3] != "6794" {
+		t.Errorf("IDs(sleeper) = %v, want 1:4984 3:6794", ids)
+	}
+
+	counts, err := repo.Counts()
+	if err != nil {
+		t.Fatalf("Counts() error = %v", err)
+	}
+	if counts["sleeper"] != 2 || counts["espn"] != 1 {
+		t.Errorf("Counts() = %v", counts)
+	}
+}
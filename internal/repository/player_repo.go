@@ -4,25 +4,64 @@ import (
 	"database/sql"
 	"fmt"
 	"strings"
+	"sync"
 
+	"github.com/vibes/draft-board/internal/database"
 	"github.com/vibes/draft-board/internal/models"
+	"github.com/vibes/draft-board/internal/names"
 )
 
 type PlayerRepository struct {
 	db *sql.DB
+
+	// season scopes reads to an archived season; 0 reads the players
+	// table as it stands.
+	season int
+	search *searchIndex
+}
+
+type searchIndex struct {
+	once      sync.Once
+	available bool
 }
 
 func NewPlayerRepository(db *sql.DB) *PlayerRepository {
-	return &PlayerRepository{db: db}
+	return &PlayerRepository{db: db, search: &searchIndex{}}
+}
+
+// ForSeason returns a view of the players as they were in a season: the
+// team, bye week and ranks archived when the season was rolled over.
+// Players with nothing archived for it, including every player in the
+// current season, read as they stand.
+func (r *PlayerRepository) ForSeason(season int) *PlayerRepository {
+	return &PlayerRepository{db: r.db, season: season, search: r.search}
+}
+
+// playersTable is the players table, or for a season view a subquery with
+// the same columns holding the archived values.
+func (r *PlayerRepository) playersTable() string {
+	if r.season == 0 {
+		return "players"
+	}
+	archived := func(col string) string {
+		return fmt.Sprintf("CASE WHEN ps.id IS NULL THEN pl.%s ELSE ps.%s END AS %s", col, col, col)
+	}
+	return fmt.Sprintf(`(SELECT pl.id, pl.name, %s, pl.position, %s, %s, %s, %s, %s, %s, pl.is_custom, pl.created_at,
+		pl.status, pl.status_note, pl.status_updated_at, pl.eligible_positions, pl.sport, pl.rookie_season
+		FROM players pl LEFT JOIN player_seasons ps ON ps.player_id = pl.id AND ps.season = %d)`,
+		archived("team"), archived("bye_week"), archived("dynasty_rank"), archived("sf_rank"),
+		archived("std_rank"), archived("half_ppr_rank"), archived("ppr_rank"), r.season)
 }
 
 func (r *PlayerRepository) GetByID(id int) (*models.Player, error) {
-	query := `SELECT * FROM players WHERE id = ?`
+	query := `SELECT * FROM ` + r.playersTable() + ` WHERE id = ?`
 	player := &models.Player{}
 	err := r.db.QueryRow(query, id).Scan(
 		&player.ID, &player.Name, &player.Team, &player.Position, &player.ByeWeek,
 		&player.DynastyRank, &player.SFRank, &player.StdRank, &player.HalfPPRRank,
 		&player.PPRRank, &player.IsCustom, &player.CreatedAt,
+		&player.Status, &player.StatusNote, &player.StatusUpdatedAt, &player.EligiblePositions, &player.Sport,
+		&player.RookieSeason,
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -33,56 +72,145 @@ func (r *PlayerRepository) GetByID(id int) (*models.Player, error) {
 	return player, nil
 }
 
+// GetAvailable lists players matching the filters in ADP order, using the
+// draft's own rankings where it has them, then its chosen ranking source or
+// consensus, then the player rank columns. A search
+// matches names and teams through the FTS5 index, ranked by relevance and
+// then ADP, or by LIKE when the index is unavailable. Searches that match
+// nothing fall back to typo-tolerant matching on names. Players can also be
+// filtered by the tags and text of their notes, and by status.
 func (r *PlayerRepository) GetAvailable(draftID int, filters PlayerFilters) ([]*models.Player, error) {
+	search := names.Normalize(filters.Search)
+	if filters.Search != "" && search == "" {
+		return nil, nil
+	}
+	useIndex := search != "" && r.hasSearchIndex()
+
 	query := `
-		SELECT p.* FROM players p
+		SELECT p.* FROM ` + r.playersTable() + ` p
 	`
-	args := []interface{}{}
+	if useIndex {
+		query += " JOIN players_fts ON players_fts.rowid = p.id"
+	}
+	format := models.RankFormat(filters.DraftType, filters.ScoringFormat)
+	query += ` LEFT JOIN draft_rankings dr ON dr.player_id = p.id AND dr.draft_id = ?
+		LEFT JOIN draft_rank_sources ds ON ds.draft_id = ?
+		LEFT JOIN source_ranks sr ON sr.player_id = p.id AND sr.source_id = ds.source_id AND sr.format = ?
+		LEFT JOIN drafts d ON d.id = ds.draft_id
+		LEFT JOIN consensus_ranks cr ON cr.player_id = p.id AND ds.consensus = 1 AND cr.format = ? AND cr.season = d.season`
+	args := []interface{}{draftID, draftID, format, format}
 	whereClause := ""
 
 	if !filters.IncludeDrafted {
-		whereClause = " WHERE p.id NOT IN (SELECT player_id FROM picks WHERE draft_id = ?)"
-		args = append(args, draftID)
+		// Players carried over onto a rookie draft's rosters are taken too.
+		whereClause = " WHERE p.id NOT IN (SELECT player_id FROM picks WHERE draft_id = ?)" +
+			" AND p.id NOT IN (SELECT player_id FROM carried_players WHERE draft_id = ?)"
+		args = append(args, draftID, draftID)
+	}
+
+	if filters.RookieSeason != 0 {
+		clause := "p.rookie_season = ?"
+		args = append(args, filters.RookieSeason)
+		if whereClause == "" {
+			whereClause = " WHERE " + clause
+		} else {
+			whereClause += " AND " + clause
+		}
+	}
+
+	if filters.Sport != "" {
+		clause := "p.sport = ?"
+		args = append(args, filters.Sport)
+		if whereClause == "" {
+			whereClause = " WHERE " + clause
+		} else {
+			whereClause += " AND " + clause
+		}
 	}
 
 	if len(filters.Positions) > 0 {
-		placeholders := make([]string, len(filters.Positions))
+		// A player matches at their primary or any eligible position.
+		conditions := make([]string, len(filters.Positions))
 		for i, pos := range filters.Positions {
+			conditions[i] = "p.position = ? OR ',' || p.eligible_positions || ',' LIKE ?"
+			args = append(args, pos, "%,"+pos+",%")
+		}
+		if whereClause == "" {
+			whereClause = fmt.Sprintf(" WHERE (%s)", strings.Join(conditions, " OR "))
+		} else {
+			whereClause += fmt.Sprintf(" AND (%s)", strings.Join(conditions, " OR "))
+		}
+	}
+
+	if len(filters.Statuses) > 0 {
+		placeholders := make([]string, len(filters.Statuses))
+		for i, status := range filters.Statuses {
 			placeholders[i] = "?"
-			args = append(args, pos)
+			args = append(args, status)
 		}
+		clause := fmt.Sprintf("p.status IN (%s)", strings.Join(placeholders, ","))
 		if whereClause == "" {
-			whereClause = fmt.Sprintf(" WHERE p.position IN (%s)", strings.Join(placeholders, ","))
+			whereClause = " WHERE " + clause
 		} else {
-			whereClause += fmt.Sprintf(" AND p.position IN (%s)", strings.Join(placeholders, ","))
+			whereClause += " AND " + clause
 		}
 	}
 
-	if filters.Search != "" {
-		searchPattern := "%" + filters.Search + "%"
+	if search != "" {
+		var clause string
+		if useIndex {
+			clause = "players_fts MATCH ?"
+			args = append(args, matchQuery(search))
+		} else {
+			clause = "(" + database.SearchName("p.name") + " LIKE ? OR p.team LIKE ?)"
+			args = append(args, "%"+search+"%", "%"+filters.Search+"%")
+		}
+		if whereClause == "" {
+			whereClause = " WHERE " + clause
+		} else {
+			whereClause += " AND " + clause
+		}
+	}
+
+	if filters.Tag != "" || filters.NoteSearch != "" {
+		clause := "p.id IN (SELECT player_id FROM player_notes WHERE draft_id = ? AND team_id IN (0, ?)"
+		args = append(args, draftID, filters.NotesTeamID)
+		if filters.Tag != "" {
+			clause += " AND ',' || tags || ',' LIKE ?"
+			args = append(args, "%,"+filters.Tag+",%")
+		}
+		if filters.NoteSearch != "" {
+			clause += " AND (note LIKE ? OR tags LIKE ?)"
+			args = append(args, "%"+filters.NoteSearch+"%", "%"+filters.NoteSearch+"%")
+		}
+		clause += ")"
 		if whereClause == "" {
-			whereClause = " WHERE (p.name LIKE ? OR p.team LIKE ?)"
+			whereClause = " WHERE " + clause
 		} else {
-			whereClause += " AND (p.name LIKE ? OR p.team LIKE ?)"
+			whereClause += " AND " + clause
 		}
-		args = append(args, searchPattern, searchPattern)
 	}
 
 	query += whereClause
 
 	// Order by rank based on draft type and scoring format
 	// SQLite doesn't support NULLS LAST, so we use COALESCE to handle NULLs
-	if filters.DraftType == "Dynasty" {
-		query += " ORDER BY COALESCE(p.dynasty_rank, 9999) ASC"
-	} else {
-		switch filters.ScoringFormat {
-		case "PPR":
-			query += " ORDER BY COALESCE(p.ppr_rank, 9999) ASC"
-		case "Half-PPR":
-			query += " ORDER BY COALESCE(p.half_ppr_rank, 9999) ASC"
-		default:
-			query += " ORDER BY COALESCE(p.std_rank, 9999) ASC"
-		}
+	// A draft's own rankings come first; players it leaves unranked follow
+	// by its ranking source or consensus, then in global ADP order.
+	query += " ORDER BY "
+	if useIndex {
+		query += "players_fts.rank, "
+	}
+	query += "COALESCE(dr.rank, 9999) ASC, COALESCE(sr.rank, cr.avg_rank, 9999) ASC, COALESCE(cr.best_rank, 9999) ASC, "
+	switch format {
+	case models.FormatDynasty:
+		query += "COALESCE(p.dynasty_rank, 9999) ASC"
+	case models.FormatPPR:
+		query += "COALESCE(p.ppr_rank, 9999) ASC"
+	case models.FormatHalfPPR:
+		query += "COALESCE(p.half_ppr_rank, 9999) ASC"
+	default:
+		query += "COALESCE(p.std_rank, 9999) ASC"
 	}
 
 	if filters.Limit > 0 {
@@ -102,6 +230,85 @@ func (r *PlayerRepository) GetAvailable(draftID int, filters PlayerFilters) ([]*
 			&player.ID, &player.Name, &player.Team, &player.Position, &player.ByeWeek,
 			&player.DynastyRank, &player.SFRank, &player.StdRank, &player.HalfPPRRank,
 			&player.PPRRank, &player.IsCustom, &player.CreatedAt,
+			&player.Status, &player.StatusNote, &player.StatusUpdatedAt, &player.EligiblePositions, &player.Sport,
+			&player.RookieSeason,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan player: %w", err)
+		}
+		players = append(players, player)
+	}
+
+	if search != "" && len(players) == 0 {
+		return r.fuzzyAvailable(draftID, filters)
+	}
+
+	return players, nil
+}
+
+// fuzzyAvailable tolerates typos by comparing the search against every
+// player that passes the other filters.
+func (r *PlayerRepository) fuzzyAvailable(draftID int, filters PlayerFilters) ([]*models.Player, error) {
+	unfiltered := filters
+	unfiltered.Search = ""
+	unfiltered.Limit = 0
+	candidates, err := r.GetAvailable(draftID, unfiltered)
+	if err != nil {
+		return nil, err
+	}
+
+	var players []*models.Player
+	for _, player := range candidates {
+		if !names.Similar(filters.Search, player.Name) {
+			continue
+		}
+		players = append(players, player)
+		if filters.Limit > 0 && len(players) == filters.Limit {
+			break
+		}
+	}
+	return players, nil
+}
+
+// hasSearchIndex reports whether the FTS5 player index is usable. SQLite
+// builds without FTS5 can't read it even when the table exists.
+func (r *PlayerRepository) hasSearchIndex() bool {
+	r.search.once.Do(func() {
+		var one int
+		err := r.db.QueryRow(`SELECT 1 FROM players_fts LIMIT 1`).Scan(&one)
+		r.search.available = err == nil || err == sql.ErrNoRows
+	})
+	return r.search.available
+}
+
+// matchQuery turns a normalized search into an FTS5 query matching every
+// word as a prefix.
+func matchQuery(search string) string {
+	words := strings.Fields(search)
+	for i, w := range words {
+		words[i] = `"` + w + `"*`
+	}
+	return strings.Join(words, " ")
+}
+
+// List returns every player in the database.
+func (r *PlayerRepository) List() ([]*models.Player, error) {
+	query := `SELECT * FROM ` + r.playersTable() + ` ORDER BY id`
+	rows, err := r.db.Query(query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list players: %w", err)
+	}
+	defer rows.Close()
+
+	var players []*models.Player
+	for rows.Next() {
+		player := &models.Player{}
+		err := rows.Scan(
+			&player.ID, &player.Name, &player.Team, &player.Position, &player.ByeWeek,
+			&player.DynastyRank, &player.SFRank, &player.StdRank, &player.HalfPPRRank,
+			&player.PPRRank, &player.IsCustom, &player.CreatedAt,
+			&player.Status, &player.StatusNote, &player.StatusUpdatedAt, &player.EligiblePositions, &player.Sport,
+			&player.RookieSeason,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan player: %w", err)
@@ -113,12 +320,22 @@ func (r *PlayerRepository) GetAvailable(draftID int, filters PlayerFilters) ([]*
 }
 
 func (r *PlayerRepository) Create(player *models.Player) error {
+	return insertPlayer(r.db, player)
+}
+
+// execer runs a statement on the database or in a transaction.
+type execer interface {
+	Exec(query string, args ...any) (sql.Result, error)
+}
+
+func insertPlayer(db execer, player *models.Player) error {
 	query := `
-		INSERT INTO players (name, team, position, bye_week, dynasty_rank, sf_rank, std_rank, half_ppr_rank, ppr_rank, is_custom)
-		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		INSERT INTO players (name, team, position, bye_week, dynasty_rank, sf_rank, std_rank, half_ppr_rank, ppr_rank, is_custom, eligible_positions, sport, rookie_season)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, COALESCE(NULLIF(?, ''), 'football'), ?)
 	`
-	result, err := r.db.Exec(query, player.Name, player.Team, player.Position, player.ByeWeek,
-		player.DynastyRank, player.SFRank, player.StdRank, player.HalfPPRRank, player.PPRRank, player.IsCustom)
+	result, err := db.Exec(query, player.Name, player.Team, player.Position, player.ByeWeek,
+		player.DynastyRank, player.SFRank, player.StdRank, player.HalfPPRRank, player.PPRRank, player.IsCustom,
+		player.EligiblePositions, player.Sport, player.RookieSeason)
 	if err != nil {
 		return fmt.Errorf("failed to create player: %w", err)
 	}
@@ -139,5 +356,79 @@ type PlayerFilters struct {
 	ScoringFormat  string
 	IncludeDrafted bool
 	Limit          int
+
+	// Sport keeps players of one sport; empty keeps every sport.
+	Sport string
+
+	// Tag and NoteSearch keep players with a matching note in the draft,
+	// shared or belonging to NotesTeamID.
+	Tag         string
+	NoteSearch  string
+	NotesTeamID int
+
+	// Statuses keeps players with one of the statuses.
+	Statuses []string
+
+	// RookieSeason keeps the players who were rookies that season; 0 keeps
+	// every player.
+	RookieSeason int
+}
+
+// StatusUpdate sets a player's availability status.
+type StatusUpdate struct {
+	PlayerID int
+	Status   string
+	Note     string
+}
+
+// UpdateStatuses sets the status of each player in one transaction,
+// stamping when it changed.
+func (r *PlayerRepository) UpdateStatuses(updates []StatusUpdate) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `UPDATE players SET status = ?, status_note = ?, status_updated_at = CURRENT_TIMESTAMP WHERE id = ?`
+	for _, u := range updates {
+		if _, err := tx.Exec(query, u.Status, u.Note, u.PlayerID); err != nil {
+			return fmt.Errorf("failed to update player status: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
 }
 
+
+// UpdateEligiblePositions sets the positions besides their primary one a
+// player may start at, as a comma-separated list.
+func (r *PlayerRepository) UpdateEligiblePositions(playerID int, positions string) error {
+	if _, err := r.db.Exec(`UPDATE players SET eligible_positions = ? WHERE id = ?`, positions, playerID); err != nil {
+		return fmt.Errorf("failed to update eligible positions: %w", err)
+	}
+	return nil
+}
+
+// SetRookieSeason marks the players as rookies in the season.
+func (r *PlayerRepository) SetRookieSeason(playerIDs []int, season int) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	for _, id := range playerIDs {
+		if _, err := tx.Exec(`UPDATE players SET rookie_season = ? WHERE id = ?`, season, id); err != nil {
+			return fmt.Errorf("failed to mark rookie: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
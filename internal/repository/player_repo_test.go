@@ -0,0 +1,189 @@
+package repository
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/vibes/draft-board/internal/database"
+	"github.com/vibes/draft-board/internal/models"
+)
+
+func TestPlayerRepository_GetAvailableSearch(t *testing.T) {
+	db := database.NewTestDB(t)
+	defer database.CloseTestDB(t, db)
+
+	repo := NewPlayerRepository(db)
+	rank := func(i int) *int { return &i }
+	for _, p := range []*models.Player{
+		{Name: "Ja'Marr Chase", Team: "CIN", Position: "WR", PPRRank: rank(1)},
+		{Name: "Chase Brown", Team: "CIN", Position: "RB", PPRRank: rank(30)},
+		{Name: "José Ramírez", Team: "CLE", Position: "WR", PPRRank: rank(200)},
+		{Name: "Amon-Ra St. Brown", Team: "DET", Position: "WR", PPRRank: rank(5)},
+	} {
+		if err := repo.Create(p); err != nil {
+			t.Fatalf("Failed to create player: %v", err)
+		}
+	}
+
+	tests := []struct {
+		name   string
+		search string
+		want   []string
+	}{
+		{name: "apostrophe dropped", search: "jamarr chase", want: []string{"Ja'Marr Chase"}},
+		{name: "ranked by ADP", search: "chase", want: []string{"Ja'Marr Chase", "Chase Brown"}},
+		{name: "accents folded", search: "jose ramirez", want: []string{"José Ramírez"}},
+		{name: "hyphen and period", search: "amon ra st", want: []string{"Amon-Ra St. Brown"}},
+		{name: "typo", search: "jamar chse", want: []string{"Ja'Marr Chase"}},
+		{name: "team", search: "DET", want: []string{"Amon-Ra St. Brown"}},
+		{name: "punctuation only", search: "'.", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := repo.GetAvailable(1, PlayerFilters{Search: tt.search, ScoringFormat: "PPR"})
+			if err != nil {
+				t.Fatalf("GetAvailable() error = %v", err)
+			}
+			var gotNames []string
+			for _, p := range got {
+				gotNames = append(gotNames, p.Name)
+			}
+			if len(gotNames) != len(tt.want) {
+				t.Fatalf("GetAvailable(%q) = %v, want %v", tt.search, gotNames, tt.want)
+			}
+			for i := range tt.want {
+				if gotNames[i] != tt.want[i] {
+					t.Errorf("GetAvailable(%q) = %v, want %v", tt.search, gotNames, tt.want)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestPlayerRepository_SearchIndexTracksUpdates(t *testing.T) {
+	db := database.NewTestDB(t)
+	defer database.CloseTestDB(t, db)
+
+	repo := NewPlayerRepository(db)
+	player := &models.Player{Name: "Old Name", Team: "BUF", Position: "QB"}
+	if err := repo.Create(player); err != nil {
+		t.Fatalf("Failed to create player: %v", err)
+	}
+	if _, err := db.Exec(`UPDATE players SET name = 'Josh Allen' WHERE id = ?`, player.ID); err != nil {
+		t.Fatalf("Failed to rename player: %v", err)
+	}
+
+	got, err := repo.GetAvailable(1, PlayerFilters{Search: "josh allen"})
+	if err != nil {
+		t.Fatalf("GetAvailable() error = %v", err)
+	}
+	if len(got) != 1 || got[0].ID != player.ID {
+		t.Errorf("GetAvailable(josh allen) = %v, want the renamed player", got)
+	}
+}
+
+func TestPlayerRepository_UpdateStatuses(t *testing.T) {
+	db := database.NewTestDB(t)
+	defer database.CloseTestDB(t, db)
+
+	repo := NewPlayerRepository(db)
+	healthy := &models.Player{Name: "Healthy", Team: "KC", Position: "WR"}
+	hurt := &models.Player{Name: "Hurt", Team: "SF", Position: "RB"}
+	for _, p := range []*models.Player{healthy, hurt} {
+		if err := repo.Create(p); err != nil {
+			t.Fatalf("Failed to create player: %v", err)
+		}
+	}
+
+	if err := repo.UpdateStatuses([]StatusUpdate{{PlayerID: hurt.ID, Status: models.StatusIR, Note: "Achilles"}}); err != nil {
+		t.Fatalf("UpdateStatuses() error = %v", err)
+	}
+
+	got, err := repo.GetByID(hurt.ID)
+	if err != nil {
+		t.Fatalf("GetByID() error = %v", err)
+	}
+	if got.Status != models.StatusIR || got.StatusNote != "Achilles" || got.StatusUpdatedAt == nil {
+		t.Errorf("status = %q, note = %q, updated = %v", got.Status, got.StatusNote, got.StatusUpdatedAt)
+	}
+	if got, _ := repo.GetByID(healthy.ID); got.Status != models.StatusActive || got.StatusUpdatedAt != nil {
+		t.Errorf("untouched player status = %q, updated = %v, want active and never updated", got.Status, got.StatusUpdatedAt)
+	}
+
+	available, err := repo.GetAvailable(1, PlayerFilters{Statuses: []string{models.StatusActive}})
+	if err != nil {
+		t.Fatalf("GetAvailable() error = %v", err)
+	}
+	if len(available) != 1 || available[0].ID != healthy.ID {
+		t.Errorf("GetAvailable(active) = %v, want only the healthy player", available)
+	}
+}
+
+func TestPlayerRepository_GetAvailableEligiblePositions(t *testing.T) {
+	db := database.NewTestDB(t)
+	defer database.CloseTestDB(t, db)
+
+	repo := NewPlayerRepository(db)
+	rank := func(i int) *int { return &i }
+	for _, p := range []*models.Player{
+		{Name: "Tight End", Team: "KCC", Position: "TE", PPRRank: rank(1), EligiblePositions: "WR"},
+		{Name: "Receiver", Team: "CIN", Position: "WR", PPRRank: rank(2)},
+		{Name: "Edge", Team: "PIT", Position: "DL", PPRRank: rank(3), EligiblePositions: "LB"},
+		{Name: "Linebacker", Team: "SFO", Position: "LB", PPRRank: rank(4)},
+	} {
+		if err := repo.Create(p); err != nil {
+			t.Fatalf("Failed to create player: %v", err)
+		}
+	}
+
+	tests := []struct {
+		positions []string
+		want      []string
+	}{
+		{[]string{"WR"}, []string{"Tight End", "Receiver"}},
+		{[]string{"TE"}, []string{"Tight End"}},
+		{[]string{"LB"}, []string{"Edge", "Linebacker"}},
+		{[]string{"DL", "WR"}, []string{"Tight End", "Receiver", "Edge"}},
+	}
+	for _, tt := range tests {
+		players, err := repo.GetAvailable(1, PlayerFilters{Positions: tt.positions, ScoringFormat: "PPR"})
+		if err != nil {
+			t.Fatalf("GetAvailable() error = %v", err)
+		}
+		var got []string
+		for _, p := range players {
+			got = append(got, p.Name)
+		}
+		if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+			t.Errorf("positions %v = %v, want %v", tt.positions, got, tt.want)
+		}
+	}
+}
+
+func TestPlayerRepository_GetAvailableSport(t *testing.T) {
+	db := database.NewTestDB(t)
+	defer database.CloseTestDB(t, db)
+
+	repo := NewPlayerRepository(db)
+	for _, p := range []*models.Player{
+		{Name: "Receiver", Team: "CIN", Position: "WR"},
+		{Name: "Point Guard", Team: "GSW", Position: "PG", Sport: "basketball"},
+		{Name: "Catcher", Team: "KC", Position: "C", Sport: "baseball"},
+	} {
+		if err := repo.Create(p); err != nil {
+			t.Fatalf("Failed to create player: %v", err)
+		}
+	}
+
+	for sport, want := range map[string]string{"football": "Receiver", "basketball": "Point Guard", "baseball": "Catcher"} {
+		players, err := repo.GetAvailable(1, PlayerFilters{Sport: sport})
+		if err != nil {
+			t.Fatalf("GetAvailable() error = %v", err)
+		}
+		if len(players) != 1 || players[0].Name != want || players[0].Sport != sport {
+			t.Errorf("sport %s = %+v, want only %s", sport, players, want)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/vibes/draft-board/internal/models"
+)
+
+type ProjectionRepository struct {
+	db *sql.DB
+}
+
+func NewProjectionRepository(db *sql.DB) *ProjectionRepository {
+	return &ProjectionRepository{db: db}
+}
+
+// Replace stores each projection, replacing any stat line previously
+// imported for the same player. All projections are written in one
+// transaction.
+func (r *ProjectionRepository) Replace(projections []models.Projection) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	for _, p := range projections {
+		if _, err := tx.Exec(`DELETE FROM player_projections WHERE player_id = ?`, p.PlayerID); err != nil {
+			return fmt.Errorf("failed to clear projections: %w", err)
+		}
+		for stat, value := range p.Stats {
+			query := `INSERT INTO player_projections (player_id, stat, value) VALUES (?, ?, ?)`
+			if _, err := tx.Exec(query, p.PlayerID, stat, value); err != nil {
+				return fmt.Errorf("failed to insert projection: %w", err)
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// GetAll returns every stored projection keyed by player ID.
+func (r *ProjectionRepository) GetAll() (map[int]*models.Projection, error) {
+	query := `SELECT player_id, stat, value, updated_at FROM player_projections`
+	rows, err := r.db.Query(query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get projections: %w", err)
+	}
+	defer rows.Close()
+
+	projections := make(map[int]*models.Projection)
+	for rows.Next() {
+		var playerID int
+		var stat string
+		var value float64
+		var updatedAt sql.NullTime
+		if err := rows.Scan(&playerID, &stat, &value, &updatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan projection: %w", err)
+		}
+		p, ok := projections[playerID]
+		if !ok {
+			p = &models.Projection{PlayerID: playerID, Stats: make(map[string]float64)}
+			projections[playerID] = p
+		}
+		p.Stats[stat] = value
+		if updatedAt.Valid && updatedAt.Time.After(p.UpdatedAt) {
+			p.UpdatedAt = updatedAt.Time
+		}
+	}
+
+	return projections, nil
+}
@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/vibes/draft-board/internal/database"
+	"github.com/vibes/draft-board/internal/models"
+)
+
+func TestProjectionRepository_Replace(t *testing.T) {
+	db := database.NewTestDB(t)
+	defer database.CloseTestDB(t, db)
+
+	playerRepo := NewPlayerRepository(db)
+	player := &models.Player{Name: "Josh Allen", Team: "BUF", Position: "QB"}
+	if err := playerRepo.Create(player); err != nil {
+		t.Fatalf("Failed to create player: %v", err)
+	}
+
+	repo := NewProjectionRepository(db)
+	err := repo.Replace([]models.Projection{
+		{PlayerID: player.ID, Stats: map[string]float64{"pass_yds": 4000, "pass_td": 30}},
+	})
+	if err != nil {
+		t.Fatalf("Replace() error = %v", err)
+	}
+
+	// A second import replaces the whole stat line
+	err = repo.Replace([]models.Projection{
+		{PlayerID: player.ID, Stats: map[string]float64{"pass_yds": 4200}},
+	})
+	if err != nil {
+		t.Fatalf("Replace() error = %v", err)
+	}
+
+	all, err := repo.GetAll()
+	if err != nil {
+		t.Fatalf("GetAll() error = %v", err)
+	}
+	proj, ok := all[player.ID]
+	if !ok {
+		t.Fatal("GetAll() missing player projection")
+	}
+	if proj.Stats["pass_yds"] != 4200 {
+		t.Errorf("pass_yds = %v, want 4200", proj.Stats["pass_yds"])
+	}
+	if _, ok := proj.Stats["pass_td"]; ok {
+		t.Error("pass_td should have been replaced")
+	}
+}
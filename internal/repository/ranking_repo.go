@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/vibes/draft-board/internal/models"
+)
+
+type RankingRepository struct {
+	db *sql.DB
+}
+
+func NewRankingRepository(db *sql.DB) *RankingRepository {
+	return &RankingRepository{db: db}
+}
+
+func (r *RankingRepository) GetByDraft(draftID int) ([]models.DraftRanking, error) {
+	query := `SELECT * FROM draft_rankings WHERE draft_id = ? ORDER BY rank`
+	rows, err := r.db.Query(query, draftID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get draft rankings: %w", err)
+	}
+	defer rows.Close()
+
+	var rankings []models.DraftRanking
+	for rows.Next() {
+		var ranking models.DraftRanking
+		if err := rows.Scan(&ranking.ID, &ranking.DraftID, &ranking.PlayerID, &ranking.Rank); err != nil {
+			return nil, fmt.Errorf("failed to scan draft ranking: %w", err)
+		}
+		rankings = append(rankings, ranking)
+	}
+
+	return rankings, nil
+}
+
+// ReplaceForDraft swaps a draft's cheat sheet for the given rankings.
+func (r *RankingRepository) ReplaceForDraft(draftID int, rankings []models.DraftRanking) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(`DELETE FROM draft_rankings WHERE draft_id = ?`, draftID); err != nil {
+		return fmt.Errorf("failed to clear draft rankings: %w", err)
+	}
+	for _, ranking := range rankings {
+		query := `INSERT INTO draft_rankings (draft_id, player_id, rank) VALUES (?, ?, ?)`
+		if _, err := tx.Exec(query, draftID, ranking.PlayerID, ranking.Rank); err != nil {
+			return fmt.Errorf("failed to create draft ranking: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteForDraft drops a draft's cheat sheet so it uses global ranks again.
+func (r *RankingRepository) DeleteForDraft(draftID int) error {
+	query := `DELETE FROM draft_rankings WHERE draft_id = ?`
+	_, err := r.db.Exec(query, draftID)
+	if err != nil {
+		return fmt.Errorf("failed to delete draft rankings: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/vibes/draft-board/internal/database"
+	"github.com/vibes/draft-board/internal/models"
+)
+
+func TestRankingRepository_OverridesAvailableOrder(t *testing.T) {
+	db := database.NewTestDB(t)
+	defer database.CloseTestDB(t, db)
+
+	draftRepo := NewDraftRepository(db)
+	draft := &models.Draft{
+		Name:          "Test League",
+		NumTeams:      10,
+		ScoringFormat: "PPR",
+		DraftType:     "Redraft",
+		Status:        "setup",
+	}
+	if err := draftRepo.Create(draft); err != nil {
+		t.Fatalf("Failed to create draft: %v", err)
+	}
+
+	playerRepo := NewPlayerRepository(db)
+	rank := func(i int) *int { return &i }
+	players := []*models.Player{
+		{Name: "First Global", Team: "KC", Position: "QB", PPRRank: rank(1)},
+		{Name: "Second Global", Team: "BUF", Position: "RB", PPRRank: rank(2)},
+		{Name: "Third Global", Team: "MIA", Position: "WR", PPRRank: rank(3)},
+	}
+	for _, p := range players {
+		if err := playerRepo.Create(p); err != nil {
+			t.Fatalf("Failed to create player: %v", err)
+		}
+	}
+
+	repo := NewRankingRepository(db)
+	if err := repo.ReplaceForDraft(draft.ID, []models.DraftRanking{
+		{PlayerID: players[2].ID, Rank: 1},
+		{PlayerID: players[0].ID, Rank: 2},
+	}); err != nil {
+		t.Fatalf("ReplaceForDraft() error = %v", err)
+	}
+
+	got, err := playerRepo.GetAvailable(draft.ID, PlayerFilters{ScoringFormat: "PPR"})
+	if err != nil {
+		t.Fatalf("GetAvailable() error = %v", err)
+	}
+	want := []string{"Third Global", "First Global", "Second Global"}
+	if len(got) != len(want) {
+		t.Fatalf("GetAvailable() returned %d players, want %d", len(got), len(want))
+	}
+	for i, name := range want {
+		if got[i].Name != name {
+			t.Errorf("GetAvailable()[%d] = %s, want %s", i, got[i].Name, name)
+		}
+	}
+
+	// Other drafts keep the global order
+	got, err = playerRepo.GetAvailable(draft.ID+1, PlayerFilters{ScoringFormat: "PPR"})
+	if err != nil {
+		t.Fatalf("GetAvailable() error = %v", err)
+	}
+	if got[0].Name != "First Global" {
+		t.Errorf("GetAvailable() for other draft starts with %s, want First Global", got[0].Name)
+	}
+
+	if err := repo.DeleteForDraft(draft.ID); err != nil {
+		t.Fatalf("DeleteForDraft() error = %v", err)
+	}
+	rankings, err := repo.GetByDraft(draft.ID)
+	if err != nil || len(rankings) != 0 {
+		t.Errorf("GetByDraft() after delete = %v, %v; want empty", rankings, err)
+	}
+}
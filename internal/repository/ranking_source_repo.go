@@ -0,0 +1,252 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/vibes/draft-board/internal/models"
+)
+
+type RankingSourceRepository struct {
+	db *sql.DB
+}
+
+func NewRankingSourceRepository(db *sql.DB) *RankingSourceRepository {
+	return &RankingSourceRepository{db: db}
+}
+
+func (r *RankingSourceRepository) Create(source *models.RankingSource) error {
+	if source.Kind == "" {
+		source.Kind = models.SourceKindImported
+	}
+	query := `INSERT INTO ranking_sources (name, season, kind) VALUES (?, ?, ?)`
+	result, err := r.db.Exec(query, source.Name, source.Season, source.Kind)
+	if err != nil {
+		return fmt.Errorf("failed to create ranking source: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("failed to get last insert id: %w", err)
+	}
+
+	source.ID = int(id)
+	return nil
+}
+
+func (r *RankingSourceRepository) GetByID(id int) (*models.RankingSource, error) {
+	query := `SELECT * FROM ranking_sources WHERE id = ?`
+	source := &models.RankingSource{}
+	err := r.db.QueryRow(query, id).Scan(&source.ID, &source.Name, &source.CreatedAt, &source.Season, &source.Kind)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("ranking source not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ranking source: %w", err)
+	}
+	return source, nil
+}
+
+// GetByName returns a season's source with the given name, or nil if there
+// is none.
+func (r *RankingSourceRepository) GetByName(name string, season int) (*models.RankingSource, error) {
+	query := `SELECT * FROM ranking_sources WHERE name = ? AND season = ?`
+	source := &models.RankingSource{}
+	err := r.db.QueryRow(query, name, season).Scan(&source.ID, &source.Name, &source.CreatedAt, &source.Season, &source.Kind)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ranking source: %w", err)
+	}
+	return source, nil
+}
+
+// List returns every source, newest season first.
+func (r *RankingSourceRepository) List() ([]*models.RankingSource, error) {
+	return r.listSources(`SELECT * FROM ranking_sources ORDER BY season DESC, name`)
+}
+
+// ListBySeason returns the sources for one season.
+func (r *RankingSourceRepository) ListBySeason(season int) ([]*models.RankingSource, error) {
+	return r.listSources(`SELECT * FROM ranking_sources WHERE season = ? ORDER BY name`, season)
+}
+
+func (r *RankingSourceRepository) listSources(query string, args ...interface{}) ([]*models.RankingSource, error) {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ranking sources: %w", err)
+	}
+	defer rows.Close()
+
+	var sources []*models.RankingSource
+	for rows.Next() {
+		source := &models.RankingSource{}
+		if err := rows.Scan(&source.ID, &source.Name, &source.CreatedAt, &source.Season, &source.Kind); err != nil {
+			return nil, fmt.Errorf("failed to scan ranking source: %w", err)
+		}
+		sources = append(sources, source)
+	}
+
+	return sources, nil
+}
+
+// RankCounts returns how many players each source ranks, by source ID and
+// then format.
+func (r *RankingSourceRepository) RankCounts() (map[int]map[string]int, error) {
+	query := `SELECT source_id, format, COUNT(*) FROM source_ranks GROUP BY source_id, format`
+	rows, err := r.db.Query(query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count source ranks: %w", err)
+	}
+	defer rows.Close()
+
+	counts := make(map[int]map[string]int)
+	for rows.Next() {
+		var sourceID, count int
+		var format string
+		if err := rows.Scan(&sourceID, &format, &count); err != nil {
+			return nil, fmt.Errorf("failed to scan source rank count: %w", err)
+		}
+		if counts[sourceID] == nil {
+			counts[sourceID] = make(map[string]int)
+		}
+		counts[sourceID][format] = count
+	}
+
+	return counts, nil
+}
+
+// Delete removes a source with its ranks. Drafts that used it go back to the
+// player rank columns.
+func (r *RankingSourceRepository) Delete(id int) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(`DELETE FROM source_ranks WHERE source_id = ?`, id); err != nil {
+		return fmt.Errorf("failed to delete source ranks: %w", err)
+	}
+	if _, err := tx.Exec(`DELETE FROM draft_rank_sources WHERE source_id = ?`, id); err != nil {
+		return fmt.Errorf("failed to clear draft rank sources: %w", err)
+	}
+	if _, err := tx.Exec(`DELETE FROM ranking_sources WHERE id = ?`, id); err != nil {
+		return fmt.Errorf("failed to delete ranking source: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// ReplaceRanks swaps a source's ranks in every format the new ranks cover.
+// Formats the new ranks leave out keep their existing ranks.
+func (r *RankingSourceRepository) ReplaceRanks(sourceID int, ranks []models.SourceRank) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	cleared := make(map[string]bool)
+	for _, rank := range ranks {
+		if !cleared[rank.Format] {
+			if _, err := tx.Exec(`DELETE FROM source_ranks WHERE source_id = ? AND format = ?`, sourceID, rank.Format); err != nil {
+				return fmt.Errorf("failed to clear source ranks: %w", err)
+			}
+			cleared[rank.Format] = true
+		}
+		query := `INSERT OR REPLACE INTO source_ranks (source_id, player_id, format, rank) VALUES (?, ?, ?, ?)`
+		if _, err := tx.Exec(query, sourceID, rank.PlayerID, rank.Format, rank.Rank); err != nil {
+			return fmt.Errorf("failed to create source rank: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// GetRanks returns one source's ranks in a format, best first.
+func (r *RankingSourceRepository) GetRanks(sourceID int, format string) ([]models.SourceRank, error) {
+	query := `SELECT * FROM source_ranks WHERE source_id = ? AND format = ? ORDER BY rank`
+	return r.queryRanks(query, sourceID, format)
+}
+
+// GetRanksByFormat returns the ranks in a format from every source for a
+// season that feeds the consensus, which leaves out historical ADP.
+func (r *RankingSourceRepository) GetRanksByFormat(format string, season int) ([]models.SourceRank, error) {
+	query := `
+		SELECT sr.* FROM source_ranks sr
+		JOIN ranking_sources s ON s.id = sr.source_id
+		WHERE sr.format = ? AND s.season = ? AND s.kind != ?
+		ORDER BY sr.source_id, sr.rank
+	`
+	return r.queryRanks(query, format, season, models.SourceKindADP)
+}
+
+func (r *RankingSourceRepository) queryRanks(query string, args ...interface{}) ([]models.SourceRank, error) {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get source ranks: %w", err)
+	}
+	defer rows.Close()
+
+	var ranks []models.SourceRank
+	for rows.Next() {
+		var rank models.SourceRank
+		if err := rows.Scan(&rank.ID, &rank.SourceID, &rank.PlayerID, &rank.Format, &rank.Rank); err != nil {
+			return nil, fmt.Errorf("failed to scan source rank: %w", err)
+		}
+		ranks = append(ranks, rank)
+	}
+
+	return ranks, nil
+}
+
+// GetDraftSource returns the draft's ADP source selection, or nil when the
+// draft uses the player rank columns.
+func (r *RankingSourceRepository) GetDraftSource(draftID int) (*models.DraftRankSource, error) {
+	query := `SELECT * FROM draft_rank_sources WHERE draft_id = ?`
+	selection := &models.DraftRankSource{}
+	var sourceID sql.NullInt64
+	err := r.db.QueryRow(query, draftID).Scan(&selection.ID, &selection.DraftID, &sourceID, &selection.Consensus)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get draft rank source: %w", err)
+	}
+	if sourceID.Valid {
+		id := int(sourceID.Int64)
+		selection.SourceID = &id
+	}
+	return selection, nil
+}
+
+// SaveDraftSource sets the draft's ADP source, replacing any earlier choice.
+func (r *RankingSourceRepository) SaveDraftSource(selection *models.DraftRankSource) error {
+	query := `
+		INSERT INTO draft_rank_sources (draft_id, source_id, consensus) VALUES (?, ?, ?)
+		ON CONFLICT(draft_id) DO UPDATE SET source_id = excluded.source_id, consensus = excluded.consensus
+	`
+	if _, err := r.db.Exec(query, selection.DraftID, selection.SourceID, selection.Consensus); err != nil {
+		return fmt.Errorf("failed to save draft rank source: %w", err)
+	}
+	return nil
+}
+
+// ClearDraftSource puts the draft back on the player rank columns.
+func (r *RankingSourceRepository) ClearDraftSource(draftID int) error {
+	query := `DELETE FROM draft_rank_sources WHERE draft_id = ?`
+	if _, err := r.db.Exec(query, draftID); err != nil {
+		return fmt.Errorf("failed to clear draft rank source: %w", err)
+	}
+	return nil
+}
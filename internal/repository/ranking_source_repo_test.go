@@ -0,0 +1,157 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/vibes/draft-board/internal/database"
+	"github.com/vibes/draft-board/internal/models"
+)
+
+func TestRankingSourceRepository_DraftSourceOrdersAvailable(t *testing.T) {
+	db := database.NewTestDB(t)
+	defer database.CloseTestDB(t, db)
+
+	draftRepo := NewDraftRepository(db)
+	draft := &models.Draft{
+		Name:          "Test League",
+		NumTeams:      10,
+		ScoringFormat: "PPR",
+		DraftType:     "Redraft",
+		Status:        "setup",
+	}
+	if err := draftRepo.Create(draft); err != nil {
+		t.Fatalf("Failed to create draft: %v", err)
+	}
+
+	playerRepo := NewPlayerRepository(db)
+	rank := func(i int) *int { return &i }
+	players := []*models.Player{
+		{Name: "Alpha", Team: "KC", Position: "QB", PPRRank: rank(1)},
+		{Name: "Bravo", Team: "BUF", Position: "RB", PPRRank: rank(2)},
+		{Name: "Charlie", Team: "MIA", Position: "WR", PPRRank: rank(3)},
+	}
+	for _, p := range players {
+		if err := playerRepo.Create(p); err != nil {
+			t.Fatalf("Failed to create player: %v", err)
+		}
+	}
+
+	repo := NewRankingSourceRepository(db)
+	expert := &models.RankingSource{Name: "Expert"}
+	other := &models.RankingSource{Name: "Other"}
+	for _, s := range []*models.RankingSource{expert, other} {
+		if err := repo.Create(s); err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+	}
+	if err := repo.ReplaceRanks(expert.ID, []models.SourceRank{
+		{PlayerID: players[2].ID, Format: models.FormatPPR, Rank: 1},
+		{PlayerID: players[1].ID, Format: models.FormatPPR, Rank: 2},
+		{PlayerID: players[0].ID, Format: models.FormatPPR, Rank: 3},
+	}); err != nil {
+		t.Fatalf("ReplaceRanks() error = %v", err)
+	}
+	if err := repo.ReplaceRanks(other.ID, []models.SourceRank{
+		{PlayerID: players[1].ID, Format: models.FormatPPR, Rank: 1},
+		{PlayerID: players[0].ID, Format: models.FormatPPR, Rank: 2},
+		{PlayerID: players[2].ID, Format: models.FormatPPR, Rank: 9},
+	}); err != nil {
+		t.Fatalf("ReplaceRanks() error = %v", err)
+	}
+
+	order := func() []string {
+		t.Helper()
+		got, err := playerRepo.GetAvailable(draft.ID, PlayerFilters{ScoringFormat: "PPR"})
+		if err != nil {
+			t.Fatalf("GetAvailable() error = %v", err)
+		}
+		var names []string
+		for _, p := range got {
+			names = append(names, p.Name)
+		}
+		return names
+	}
+	check := func(label string, want []string) {
+		t.Helper()
+		got := order()
+		if len(got) != len(want) {
+			t.Fatalf("%s: order = %v, want %v", label, got, want)
+		}
+		for i := range want {
+			if got[i] != want[i] {
+				t.Errorf("%s: order = %v, want %v", label, got, want)
+				return
+			}
+		}
+	}
+
+	check("built-in", []string{"Alpha", "Bravo", "Charlie"})
+
+	if err := repo.SaveDraftSource(&models.DraftRankSource{DraftID: draft.ID, SourceID: &expert.ID}); err != nil {
+		t.Fatalf("SaveDraftSource() error = %v", err)
+	}
+	check("expert", []string{"Charlie", "Bravo", "Alpha"})
+
+	// Means: Bravo 1.5, Alpha 2.5, Charlie 5
+	if err := repo.SaveDraftSource(&models.DraftRankSource{DraftID: draft.ID, Consensus: true}); err != nil {
+		t.Fatalf("SaveDraftSource() error = %v", err)
+	}
+	check("consensus", []string{"Bravo", "Alpha", "Charlie"})
+
+	selection, err := repo.GetDraftSource(draft.ID)
+	if err != nil || selection == nil || !selection.Consensus || selection.SourceID != nil {
+		t.Fatalf("GetDraftSource() = %+v, %v; want consensus", selection, err)
+	}
+
+	if err := repo.SaveDraftSource(&models.DraftRankSource{DraftID: draft.ID, SourceID: &expert.ID}); err != nil {
+		t.Fatalf("SaveDraftSource() error = %v", err)
+	}
+	if err := repo.Delete(expert.ID); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if selection, err := repo.GetDraftSource(draft.ID); err != nil || selection != nil {
+		t.Errorf("GetDraftSource() after delete = %+v, %v; want nil", selection, err)
+	}
+	check("after delete", []string{"Alpha", "Bravo", "Charlie"})
+}
+
+func TestRankingSourceRepository_ConsensusSkipsADP(t *testing.T) {
+	db := database.NewTestDB(t)
+	defer database.CloseTestDB(t, db)
+
+	repo := NewRankingSourceRepository(db)
+	expert := &models.RankingSource{Name: "Expert", Season: 2026}
+	history := &models.RankingSource{Name: "League ADP", Season: 2026, Kind: models.SourceKindADP}
+	for _, s := range []*models.RankingSource{expert, history} {
+		if err := repo.Create(s); err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+		if err := repo.ReplaceRanks(s.ID, []models.SourceRank{{PlayerID: 1, Format: models.FormatPPR, Rank: s.ID * 10}}); err != nil {
+			t.Fatalf("ReplaceRanks() error = %v", err)
+		}
+	}
+
+	got, err := repo.GetByName("Expert", 2026)
+	if err != nil || got.Kind != models.SourceKindImported {
+		t.Errorf("GetByName(Expert) = %+v, %v; want an imported source", got, err)
+	}
+	if got, _ := repo.GetByName("League ADP", 2026); got.Kind != models.SourceKindADP {
+		t.Errorf("League ADP kind = %q, want adp", got.Kind)
+	}
+
+	ranks, err := repo.GetRanksByFormat(models.FormatPPR, 2026)
+	if err != nil {
+		t.Fatalf("GetRanksByFormat() error = %v", err)
+	}
+	if len(ranks) != 1 || ranks[0].SourceID != expert.ID {
+		t.Errorf("GetRanksByFormat() = %+v, want only the expert's rank", ranks)
+	}
+
+	var avg float64
+	if err := db.QueryRow(`SELECT avg_rank FROM consensus_ranks WHERE player_id = 1`).Scan(&avg); err != nil {
+		t.Fatalf("consensus_ranks error = %v", err)
+	}
+	if avg != float64(expert.ID*10) {
+		t.Errorf("consensus avg_rank = %v, want %d", avg, expert.ID*10)
+	}
+}
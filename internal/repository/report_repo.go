@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/vibes/draft-board/internal/models"
+)
+
+type ReportRepository struct {
+	db *sql.DB
+}
+
+func NewReportRepository(db *sql.DB) *ReportRepository {
+	return &ReportRepository{db: db}
+}
+
+// Create stores a draft's report. A draft keeps its first report; later
+// calls leave it untouched.
+func (r *ReportRepository) Create(report *models.DraftReport) error {
+	query := `INSERT OR IGNORE INTO draft_reports (draft_id, report) VALUES (?, ?)`
+	_, err := r.db.Exec(query, report.DraftID, report.Report)
+	if err != nil {
+		return fmt.Errorf("failed to create draft report: %w", err)
+	}
+	return nil
+}
+
+// GetByDraft returns the draft's stored report, or nil if none exists yet.
+func (r *ReportRepository) GetByDraft(draftID int) (*models.DraftReport, error) {
+	query := `SELECT * FROM draft_reports WHERE draft_id = ?`
+	report := &models.DraftReport{}
+	err := r.db.QueryRow(query, draftID).Scan(&report.ID, &report.DraftID, &report.Report, &report.GeneratedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get draft report: %w", err)
+	}
+	return report, nil
+}
@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/vibes/draft-board/internal/database"
+	"github.com/vibes/draft-board/internal/models"
+)
+
+func TestReportRepository_CreateKeepsFirst(t *testing.T) {
+	db := database.NewTestDB(t)
+	defer database.CloseTestDB(t, db)
+
+	draftRepo := NewDraftRepository(db)
+	draft := &models.Draft{
+		Name:          "Test League",
+		NumTeams:      10,
+		ScoringFormat: "PPR",
+		DraftType:     "Redraft",
+		Status:        "completed",
+	}
+	if err := draftRepo.Create(draft); err != nil {
+		t.Fatalf("Failed to create draft: %v", err)
+	}
+
+	repo := NewReportRepository(db)
+	got, err := repo.GetByDraft(draft.ID)
+	if err != nil || got != nil {
+		t.Fatalf("GetByDraft() = %v, %v; want nil, nil", got, err)
+	}
+
+	if err := repo.Create(&models.DraftReport{DraftID: draft.ID, Report: `{"v":1}`}); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if err := repo.Create(&models.DraftReport{DraftID: draft.ID, Report: `{"v":2}`}); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	got, err = repo.GetByDraft(draft.ID)
+	if err != nil {
+		t.Fatalf("GetByDraft() error = %v", err)
+	}
+	if got.Report != `{"v":1}` {
+		t.Errorf("Report = %s, want the first snapshot", got.Report)
+	}
+}
@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/vibes/draft-board/internal/models"
+)
+
+type RosterRepository struct {
+	db *sql.DB
+}
+
+func NewRosterRepository(db *sql.DB) *RosterRepository {
+	return &RosterRepository{db: db}
+}
+
+func (r *RosterRepository) GetByDraft(draftID int) ([]models.RosterSlot, error) {
+	query := `SELECT * FROM roster_slots WHERE draft_id = ? ORDER BY id`
+	rows, err := r.db.Query(query, draftID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get roster slots: %w", err)
+	}
+	defer rows.Close()
+
+	var slots []models.RosterSlot
+	for rows.Next() {
+		var slot models.RosterSlot
+		if err := rows.Scan(&slot.ID, &slot.DraftID, &slot.Slot, &slot.Count); err != nil {
+			return nil, fmt.Errorf("failed to scan roster slot: %w", err)
+		}
+		slots = append(slots, slot)
+	}
+
+	return slots, nil
+}
+
+// ReplaceForDraft swaps a draft's roster slots for the given set.
+func (r *RosterRepository) ReplaceForDraft(draftID int, slots []models.RosterSlot) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(`DELETE FROM roster_slots WHERE draft_id = ?`, draftID); err != nil {
+		return fmt.Errorf("failed to clear roster slots: %w", err)
+	}
+	for i := range slots {
+		query := `INSERT INTO roster_slots (draft_id, slot, count) VALUES (?, ?, ?)`
+		result, err := tx.Exec(query, draftID, slots[i].Slot, slots[i].Count)
+		if err != nil {
+			return fmt.Errorf("failed to create roster slot: %w", err)
+		}
+		id, err := result.LastInsertId()
+		if err != nil {
+			return fmt.Errorf("failed to get last insert id: %w", err)
+		}
+		slots[i].ID = int(id)
+		slots[i].DraftID = draftID
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/vibes/draft-board/internal/database"
+	"github.com/vibes/draft-board/internal/models"
+)
+
+func TestRosterRepository_ReplaceForDraft(t *testing.T) {
+	db := database.NewTestDB(t)
+	defer database.CloseTestDB(t, db)
+
+	draftRepo := NewDraftRepository(db)
+	draft := &models.Draft{
+		Name:          "Test League",
+		NumTeams:      10,
+		ScoringFormat: "PPR",
+		DraftType:     "Redraft",
+		Status:        "setup",
+	}
+	if err := draftRepo.Create(draft); err != nil {
+		t.Fatalf("Failed to create draft: %v", err)
+	}
+
+	repo := NewRosterRepository(db)
+	slots := []models.RosterSlot{{Slot: "QB", Count: 1}, {Slot: "RB", Count: 2}}
+	if err := repo.ReplaceForDraft(draft.ID, slots); err != nil {
+		t.Fatalf("ReplaceForDraft() error = %v", err)
+	}
+	if err := repo.ReplaceForDraft(draft.ID, []models.RosterSlot{{Slot: "QB", Count: 2}}); err != nil {
+		t.Fatalf("ReplaceForDraft() error = %v", err)
+	}
+
+	got, err := repo.GetByDraft(draft.ID)
+	if err != nil {
+		t.Fatalf("GetByDraft() error = %v", err)
+	}
+	if len(got) != 1 || got[0].Slot != "QB" || got[0].Count != 2 {
+		t.Errorf("GetByDraft() = %+v, want single QB slot with count 2", got)
+	}
+}
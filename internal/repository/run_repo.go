@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/vibes/draft-board/internal/models"
+)
+
+type RunSettingsRepository struct {
+	db *sql.DB
+}
+
+func NewRunSettingsRepository(db *sql.DB) *RunSettingsRepository {
+	return &RunSettingsRepository{db: db}
+}
+
+// GetByDraft returns the draft's run alert settings, or nil if it uses the
+// defaults.
+func (r *RunSettingsRepository) GetByDraft(draftID int) (*models.RunSettings, error) {
+	query := `SELECT * FROM run_settings WHERE draft_id = ?`
+	settings := &models.RunSettings{}
+	err := r.db.QueryRow(query, draftID).Scan(
+		&settings.ID, &settings.DraftID, &settings.Window, &settings.Threshold, &settings.TierGap,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get run settings: %w", err)
+	}
+	return settings, nil
+}
+
+func (r *RunSettingsRepository) Save(settings *models.RunSettings) error {
+	query := `
+		INSERT INTO run_settings (draft_id, pick_window, threshold, tier_gap)
+		VALUES (?, ?, ?, ?)
+		ON CONFLICT(draft_id) DO UPDATE SET
+			pick_window = excluded.pick_window,
+			threshold = excluded.threshold,
+			tier_gap = excluded.tier_gap
+	`
+	_, err := r.db.Exec(query, settings.DraftID, settings.Window, settings.Threshold, settings.TierGap)
+	if err != nil {
+		return fmt.Errorf("failed to save run settings: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/vibes/draft-board/internal/models"
+)
+
+type ScoringRepository struct {
+	db *sql.DB
+}
+
+func NewScoringRepository(db *sql.DB) *ScoringRepository {
+	return &ScoringRepository{db: db}
+}
+
+func (r *ScoringRepository) GetByDraft(draftID int) ([]models.ScoringRule, error) {
+	query := `SELECT * FROM scoring_rules WHERE draft_id = ? ORDER BY position, stat`
+	rows, err := r.db.Query(query, draftID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get scoring rules: %w", err)
+	}
+	defer rows.Close()
+
+	var rules []models.ScoringRule
+	for rows.Next() {
+		var rule models.ScoringRule
+		err := rows.Scan(&rule.ID, &rule.DraftID, &rule.Position, &rule.Stat, &rule.Points)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan scoring rule: %w", err)
+		}
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+// ReplaceForDraft swaps a draft's scoring rules for the given set.
+func (r *ScoringRepository) ReplaceForDraft(draftID int, rules []models.ScoringRule) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(`DELETE FROM scoring_rules WHERE draft_id = ?`, draftID); err != nil {
+		return fmt.Errorf("failed to clear scoring rules: %w", err)
+	}
+	for _, rule := range rules {
+		query := `INSERT INTO scoring_rules (draft_id, position, stat, points) VALUES (?, ?, ?, ?)`
+		if _, err := tx.Exec(query, draftID, rule.Position, rule.Stat, rule.Points); err != nil {
+			return fmt.Errorf("failed to create scoring rule: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteForDraft removes a draft's custom rules so it scores with the preset
+// for its scoring format again.
+func (r *ScoringRepository) DeleteForDraft(draftID int) error {
+	query := `DELETE FROM scoring_rules WHERE draft_id = ?`
+	_, err := r.db.Exec(query, draftID)
+	if err != nil {
+		return fmt.Errorf("failed to delete scoring rules: %w", err)
+	}
+	return nil
+}
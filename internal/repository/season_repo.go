@@ -0,0 +1,137 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/vibes/draft-board/internal/models"
+)
+
+type SeasonRepository struct {
+	db *sql.DB
+}
+
+func NewSeasonRepository(db *sql.DB) *SeasonRepository {
+	return &SeasonRepository{db: db}
+}
+
+// Current returns the current season's year. A database without seasons
+// starts in the current calendar year.
+func (r *SeasonRepository) Current() (int, error) {
+	var year int
+	err := r.db.QueryRow(`SELECT year FROM seasons WHERE is_current = 1`).Scan(&year)
+	if err == nil {
+		return year, nil
+	}
+	if err != sql.ErrNoRows {
+		return 0, fmt.Errorf("failed to get current season: %w", err)
+	}
+
+	year = time.Now().Year()
+	query := `INSERT INTO seasons (year, is_current) VALUES (?, 1) ON CONFLICT(year) DO UPDATE SET is_current = 1`
+	if _, err := r.db.Exec(query, year); err != nil {
+		return 0, fmt.Errorf("failed to create current season: %w", err)
+	}
+	return year, nil
+}
+
+// List returns every season, newest first.
+func (r *SeasonRepository) List() ([]*models.Season, error) {
+	if _, err := r.Current(); err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(`SELECT * FROM seasons ORDER BY year DESC`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list seasons: %w", err)
+	}
+	defer rows.Close()
+
+	var seasons []*models.Season
+	for rows.Next() {
+		season := &models.Season{}
+		if err := rows.Scan(&season.ID, &season.Year, &season.IsCurrent, &season.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan season: %w", err)
+		}
+		seasons = append(seasons, season)
+	}
+
+	return seasons, nil
+}
+
+// Rollover archives the current season's players and starts a new season.
+// Players keep their IDs and carry into the new season; with resetRanks
+// their redraft ranks and bye weeks, and the NFL teams' bye weeks, are
+// cleared for the new season's data, while dynasty ranks carry over.
+// Drafts that haven't completed move to the new season, and tiers saved
+// for the built-in ranks or the consensus are kept under the archived
+// season.
+func (r *SeasonRepository) Rollover(to int, resetRanks bool) error {
+	from, err := r.Current()
+	if err != nil {
+		return err
+	}
+	if to <= from {
+		return fmt.Errorf("new season must be after %d", from)
+	}
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	archive := `
+		INSERT OR REPLACE INTO player_seasons
+			(player_id, season, team, bye_week, dynasty_rank, sf_rank, std_rank, half_ppr_rank, ppr_rank)
+		SELECT id, ?, team, bye_week, dynasty_rank, sf_rank, std_rank, half_ppr_rank, ppr_rank FROM players
+	`
+	if _, err := tx.Exec(archive, from); err != nil {
+		return fmt.Errorf("failed to archive players: %w", err)
+	}
+
+	if resetRanks {
+		reset := `UPDATE players SET bye_week = NULL, sf_rank = NULL, std_rank = NULL, half_ppr_rank = NULL, ppr_rank = NULL`
+		if _, err := tx.Exec(reset); err != nil {
+			return fmt.Errorf("failed to reset player ranks: %w", err)
+		}
+		if _, err := tx.Exec(`UPDATE nfl_teams SET bye_week = NULL`); err != nil {
+			return fmt.Errorf("failed to reset team bye weeks: %w", err)
+		}
+	}
+
+	moveDrafts := `UPDATE drafts SET season = ? WHERE season = ? AND status != 'completed' AND COALESCE(completed, 0) = 0`
+	if _, err := tx.Exec(moveDrafts, to, from); err != nil {
+		return fmt.Errorf("failed to move open drafts: %w", err)
+	}
+
+	archiveTiers := `
+		UPDATE player_tiers SET source = source || ':' || ?
+		WHERE source IN (?, ?, ?, ?, ?) OR source IN (?, ?, ?, ?, ?)
+	`
+	args := []interface{}{from}
+	for _, format := range models.RankFormats {
+		args = append(args, format)
+	}
+	for _, format := range models.RankFormats {
+		args = append(args, "consensus:"+format)
+	}
+	if _, err := tx.Exec(archiveTiers, args...); err != nil {
+		return fmt.Errorf("failed to archive tiers: %w", err)
+	}
+
+	if _, err := tx.Exec(`UPDATE seasons SET is_current = 0`); err != nil {
+		return fmt.Errorf("failed to update seasons: %w", err)
+	}
+	query := `INSERT INTO seasons (year, is_current) VALUES (?, 1) ON CONFLICT(year) DO UPDATE SET is_current = 1`
+	if _, err := tx.Exec(query, to); err != nil {
+		return fmt.Errorf("failed to create season: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
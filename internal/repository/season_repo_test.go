@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/vibes/draft-board/internal/database"
+	"github.com/vibes/draft-board/internal/models"
+)
+
+func TestSeasonRepository_Rollover(t *testing.T) {
+	db := database.NewTestDB(t)
+	defer database.CloseTestDB(t, db)
+
+	repo := NewSeasonRepository(db)
+	from, err := repo.Current()
+	if err != nil {
+		t.Fatalf("Current() error = %v", err)
+	}
+
+	playerRepo := NewPlayerRepository(db)
+	rank := func(i int) *int { return &i }
+	player := &models.Player{Name: "Alpha", Team: "KC", Position: "QB", ByeWeek: rank(6), PPRRank: rank(3), DynastyRank: rank(8)}
+	if err := playerRepo.Create(player); err != nil {
+		t.Fatalf("Failed to create player: %v", err)
+	}
+
+	draftRepo := NewDraftRepository(db)
+	open := &models.Draft{Name: "Open", NumTeams: 10, ScoringFormat: "PPR", DraftType: "Redraft", Status: "active", Season: from}
+	done := &models.Draft{Name: "Done", NumTeams: 10, ScoringFormat: "PPR", DraftType: "Redraft", Status: "completed", Season: from}
+	for _, d := range []*models.Draft{open, done} {
+		if err := draftRepo.Create(d); err != nil {
+			t.Fatalf("Failed to create draft: %v", err)
+		}
+	}
+
+	if err := repo.Rollover(from, true); err == nil {
+		t.Error("Rollover() to the current season should fail")
+	}
+	if err := repo.Rollover(from+1, true); err != nil {
+		t.Fatalf("Rollover() error = %v", err)
+	}
+
+	current, err := repo.Current()
+	if err != nil {
+		t.Fatalf("Current() error = %v", err)
+	}
+	if current != from+1 {
+		t.Errorf("Current() = %d, want %d", current, from+1)
+	}
+
+	got, err := playerRepo.GetByID(player.ID)
+	if err != nil {
+		t.Fatalf("GetByID() error = %v", err)
+	}
+	if got.PPRRank != nil || got.ByeWeek != nil {
+		t.Errorf("new season ranks = %v, bye = %v, want cleared", got.PPRRank, got.ByeWeek)
+	}
+	if got.DynastyRank == nil || *got.DynastyRank != 8 {
+		t.Errorf("dynasty rank = %v, want 8 carried over", got.DynastyRank)
+	}
+
+	archived, err := playerRepo.ForSeason(from).GetByID(player.ID)
+	if err != nil {
+		t.Fatalf("ForSeason().GetByID() error = %v", err)
+	}
+	if archived.PPRRank == nil || *archived.PPRRank != 3 || archived.ByeWeek == nil || *archived.ByeWeek != 6 {
+		t.Errorf("archived ranks = %v, bye = %v, want 3 and 6", archived.PPRRank, archived.ByeWeek)
+	}
+
+	gotOpen, _ := draftRepo.GetByID(open.ID)
+	if gotOpen.Season != from+1 {
+		t.Errorf("open draft season = %d, want %d", gotOpen.Season, from+1)
+	}
+	gotDone, _ := draftRepo.GetByID(done.ID)
+	if gotDone.Season != from {
+		t.Errorf("completed draft season = %d, want %d", gotDone.Season, from)
+	}
+
+	seasons, err := repo.List()
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(seasons) != 2 || seasons[0].Year != from+1 || !seasons[0].IsCurrent || seasons[1].IsCurrent {
+		t.Errorf("List() = %+v, want the new season current and first", seasons)
+	}
+}
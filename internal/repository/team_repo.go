@@ -34,7 +34,8 @@ func (r *TeamRepository) Create(team *models.Team) error {
 func (r *TeamRepository) GetByID(id int) (*models.Team, error) {
 	query := `SELECT * FROM teams WHERE id = ?`
 	team := &models.Team{}
-	err := r.db.QueryRow(query, id).Scan(&team.ID, &team.DraftID, &team.TeamName, &team.OwnerName, &team.DraftPosition)
+	err := r.db.QueryRow(query, id).Scan(&team.ID, &team.DraftID, &team.TeamName, &team.OwnerName, &team.DraftPosition,
+		&team.FranchiseID, &team.ManagerID)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, fmt.Errorf("team not found")
@@ -55,7 +56,8 @@ func (r *TeamRepository) GetByDraft(draftID int) ([]models.Team, error) {
 	var teams []models.Team
 	for rows.Next() {
 		var team models.Team
-		err := rows.Scan(&team.ID, &team.DraftID, &team.TeamName, &team.OwnerName, &team.DraftPosition)
+		err := rows.Scan(&team.ID, &team.DraftID, &team.TeamName, &team.OwnerName, &team.DraftPosition,
+			&team.FranchiseID, &team.ManagerID)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan team: %w", err)
 		}
@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/vibes/draft-board/internal/models"
+)
+
+type TemplateRepository struct {
+	db *sql.DB
+}
+
+func NewTemplateRepository(db *sql.DB) *TemplateRepository {
+	return &TemplateRepository{db: db}
+}
+
+func (r *TemplateRepository) Create(template *models.DraftTemplate) error {
+	result, err := r.db.Exec(`INSERT INTO draft_templates (name, config) VALUES (?, ?)`, template.Name, template.Config)
+	if err != nil {
+		return fmt.Errorf("failed to create draft template: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("failed to get last insert id: %w", err)
+	}
+
+	template.ID = int(id)
+	return nil
+}
+
+func (r *TemplateRepository) GetByID(id int) (*models.DraftTemplate, error) {
+	template := &models.DraftTemplate{}
+	err := r.db.QueryRow(`SELECT * FROM draft_templates WHERE id = ?`, id).
+		Scan(&template.ID, &template.Name, &template.Config, &template.CreatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("draft template not found")
+		}
+		return nil, fmt.Errorf("failed to get draft template: %w", err)
+	}
+	return template, nil
+}
+
+// List returns every template by name.
+func (r *TemplateRepository) List() ([]models.DraftTemplate, error) {
+	rows, err := r.db.Query(`SELECT * FROM draft_templates ORDER BY name, id`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list draft templates: %w", err)
+	}
+	defer rows.Close()
+
+	var templates []models.DraftTemplate
+	for rows.Next() {
+		var t models.DraftTemplate
+		if err := rows.Scan(&t.ID, &t.Name, &t.Config, &t.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan draft template: %w", err)
+		}
+		templates = append(templates, t)
+	}
+	return templates, nil
+}
+
+func (r *TemplateRepository) Delete(id int) error {
+	if _, err := r.db.Exec(`DELETE FROM draft_templates WHERE id = ?`, id); err != nil {
+		return fmt.Errorf("failed to delete draft template: %w", err)
+	}
+	return nil
+}
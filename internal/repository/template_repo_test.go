@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/vibes/draft-board/internal/database"
+	"github.com/vibes/draft-board/internal/models"
+)
+
+func TestTemplateRepository(t *testing.T) {
+	db := database.NewTestDB(t)
+	defer database.CloseTestDB(t, db)
+
+	repo := NewTemplateRepository(db)
+	for _, name := range []string{"Keeper League", "Home League"} {
+		if err := repo.Create(&models.DraftTemplate{Name: name, Config: `{"num_teams":10}`}); err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+	}
+
+	templates, err := repo.List()
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(templates) != 2 || templates[0].Name != "Home League" {
+		t.Fatalf("List() = %+v, want both templates by name", templates)
+	}
+
+	got, err := repo.GetByID(templates[1].ID)
+	if err != nil || got.Config != `{"num_teams":10}` {
+		t.Errorf("GetByID() = %+v, %v", got, err)
+	}
+
+	if err := repo.Delete(templates[0].ID); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if _, err := repo.GetByID(templates[0].ID); err == nil {
+		t.Error("deleted template still exists")
+	}
+}
+
+func TestDraftRepository_CreateFromConfig(t *testing.T) {
+	db := database.NewTestDB(t)
+	defer database.CloseTestDB(t, db)
+
+	cfg := &models.DraftConfig{
+		Sport:         "football",
+		NumTeams:      2,
+		ScoringFormat: "PPR",
+		DraftType:     "Redraft",
+		QBSetting:     "SF",
+		SnakeDraft:    true,
+		MaxRounds:     12,
+		Teams: []models.ConfigTeam{
+			{TeamName: "Alpha", OwnerName: "Ann", DraftPosition: 2},
+			{TeamName: "Bravo", OwnerName: "Bob", DraftPosition: 1},
+		},
+		RosterSlots:  []models.ConfigSlot{{Slot: "QB", Count: 2}, {Slot: "BN", Count: 5}},
+		ScoringRules: []models.ConfigRule{{Stat: "rec", Points: 1}, {Position: "TE", Stat: "rec", Points: 1.5}},
+		RunSettings:  &models.ConfigAlerts{Window: 6, Threshold: 3, TierGap: 10},
+	}
+
+	repo := NewDraftRepository(db)
+	draft := cfg.Draft("Next Year")
+	draft.Season = 2026
+	if err := repo.CreateFromConfig(draft, cfg); err != nil {
+		t.Fatalf("CreateFromConfig() error = %v", err)
+	}
+
+	stored, err := repo.GetByID(draft.ID)
+	if err != nil {
+		t.Fatalf("GetByID() error = %v", err)
+	}
+	if stored.Name != "Next Year" || stored.QBSetting != "SF" || stored.MaxRounds != 12 || stored.Status != "setup" {
+		t.Errorf("draft = %+v", stored)
+	}
+
+	teams, err := NewTeamRepository(db).GetByDraft(draft.ID)
+	if err != nil {
+		t.Fatalf("GetByDraft() error = %v", err)
+	}
+	if len(teams) != 2 || teams[0].TeamName != "Bravo" || teams[0].OwnerName != "Bob" {
+		t.Errorf("teams = %+v, want Bravo picking first", teams)
+	}
+
+	slots, _ := NewRosterRepository(db).GetByDraft(draft.ID)
+	rules, _ := NewScoringRepository(db).GetByDraft(draft.ID)
+	runs, _ := NewRunSettingsRepository(db).GetByDraft(draft.ID)
+	if len(slots) != 2 || len(rules) != 2 || runs == nil || runs.Window != 6 {
+		t.Errorf("slots = %+v, rules = %+v, runs = %+v", slots, rules, runs)
+	}
+}
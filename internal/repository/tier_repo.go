@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/vibes/draft-board/internal/models"
+)
+
+type TierRepository struct {
+	db *sql.DB
+}
+
+func NewTierRepository(db *sql.DB) *TierRepository {
+	return &TierRepository{db: db}
+}
+
+func (r *TierRepository) GetBySource(source string) ([]models.PlayerTier, error) {
+	query := `SELECT * FROM player_tiers WHERE source = ? ORDER BY position, tier`
+	rows, err := r.db.Query(query, source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get player tiers: %w", err)
+	}
+	defer rows.Close()
+
+	var tiers []models.PlayerTier
+	for rows.Next() {
+		var tier models.PlayerTier
+		if err := rows.Scan(&tier.ID, &tier.Source, &tier.PlayerID, &tier.Position, &tier.Tier); err != nil {
+			return nil, fmt.Errorf("failed to scan player tier: %w", err)
+		}
+		tiers = append(tiers, tier)
+	}
+
+	return tiers, nil
+}
+
+// ReplaceForSource swaps the stored tiers for one ranking source.
+func (r *TierRepository) ReplaceForSource(source string, tiers []models.PlayerTier) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(`DELETE FROM player_tiers WHERE source = ?`, source); err != nil {
+		return fmt.Errorf("failed to clear player tiers: %w", err)
+	}
+	for _, tier := range tiers {
+		query := `INSERT INTO player_tiers (source, player_id, position, tier) VALUES (?, ?, ?, ?)`
+		if _, err := tx.Exec(query, source, tier.PlayerID, tier.Position, tier.Tier); err != nil {
+			return fmt.Errorf("failed to create player tier: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteForSource drops a source's stored tiers so they are computed again.
+func (r *TierRepository) DeleteForSource(source string) error {
+	query := `DELETE FROM player_tiers WHERE source = ?`
+	_, err := r.db.Exec(query, source)
+	if err != nil {
+		return fmt.Errorf("failed to delete player tiers: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package runs
+
+import (
+	"fmt"
+
+	"github.com/vibes/draft-board/internal/models"
+)
+
+// Alert kinds carried by position-run events.
+const (
+	KindRun       = "run"
+	KindTierBreak = "tier-break"
+)
+
+// DefaultSettings alerts on 4 of the last 6 picks at one position and on
+// ADP gaps of 12 or more.
+func DefaultSettings(draftID int) models.RunSettings {
+	return models.RunSettings{DraftID: draftID, Window: 6, Threshold: 4, TierGap: 12}
+}
+
+// Alert is a detected positional run or tier break.
+type Alert struct {
+	Kind     string `json:"kind"`
+	Position string `json:"position"`
+	Count    int    `json:"count,omitempty"`
+	Window   int    `json:"window,omitempty"`
+	Gap      int    `json:"gap,omitempty"`
+	Message  string `json:"message"`
+}
+
+// Detect reports a run at the most recent pick's position. positions lists
+// the draft's picked positions in pick order; only the last Window count.
+// A run is reported only while the newest pick extends it, so each pick
+// raises at most one alert.
+func Detect(positions []string, settings models.RunSettings) *Alert {
+	if len(positions) == 0 || settings.Window <= 0 {
+		return nil
+	}
+	window := positions
+	if len(window) > settings.Window {
+		window = window[len(window)-settings.Window:]
+	}
+	latest := window[len(window)-1]
+
+	count := 0
+	for _, pos := range window {
+		if pos == latest {
+			count++
+		}
+	}
+	if count < settings.Threshold {
+		return nil
+	}
+	return &Alert{
+		Kind:     KindRun,
+		Position: latest,
+		Count:    count,
+		Window:   len(window),
+		Message:  fmt.Sprintf("%s run: %d of the last %d picks", latest, count, len(window)),
+	}
+}
+
+// DetectTierBreak reports a tier break when the best remaining player at
+// the drafted player's position ranks TierGap or more spots behind them.
+// nextRank is nil when no ranked player remains at the position.
+func DetectTierBreak(position string, draftedRank, nextRank *int, settings models.RunSettings) *Alert {
+	if draftedRank == nil || settings.TierGap <= 0 {
+		return nil
+	}
+	if nextRank == nil {
+		return &Alert{
+			Kind:     KindTierBreak,
+			Position: position,
+			Message:  fmt.Sprintf("Last ranked %s is off the board", position),
+		}
+	}
+	gap := *nextRank - *draftedRank
+	if gap < settings.TierGap {
+		return nil
+	}
+	return &Alert{
+		Kind:     KindTierBreak,
+		Position: position,
+		Gap:      gap,
+		Message:  fmt.Sprintf("%s tier break: next best is %d spots lower", position, gap),
+	}
+}
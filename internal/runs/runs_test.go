@@ -0,0 +1,58 @@
+package runs
+
+import (
+	"testing"
+
+	"github.com/vibes/draft-board/internal/models"
+)
+
+func intPtr(i int) *int { return &i }
+
+func TestDetect(t *testing.T) {
+	settings := models.RunSettings{Window: 6, Threshold: 4}
+
+	tests := []struct {
+		name      string
+		positions []string
+		wantCount int
+	}{
+		{name: "no picks", positions: nil},
+		{name: "below threshold", positions: []string{"RB", "WR", "RB", "QB", "RB"}},
+		{name: "four of six", positions: []string{"RB", "WR", "RB", "QB", "RB", "RB"}, wantCount: 4},
+		{name: "older picks fall out of the window", positions: []string{"RB", "RB", "WR", "WR", "TE", "QB", "RB", "RB"}},
+		{name: "newest pick must extend the run", positions: []string{"RB", "RB", "RB", "RB", "WR"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Detect(tt.positions, settings)
+			if tt.wantCount == 0 {
+				if got != nil {
+					t.Errorf("Detect() = %+v, want nil", got)
+				}
+				return
+			}
+			if got == nil || got.Count != tt.wantCount || got.Kind != KindRun {
+				t.Errorf("Detect() = %+v, want run of %d", got, tt.wantCount)
+			}
+		})
+	}
+}
+
+func TestDetectTierBreak(t *testing.T) {
+	settings := models.RunSettings{TierGap: 10}
+
+	if got := DetectTierBreak("TE", intPtr(20), intPtr(25), settings); got != nil {
+		t.Errorf("small gap = %+v, want nil", got)
+	}
+	got := DetectTierBreak("TE", intPtr(20), intPtr(40), settings)
+	if got == nil || got.Gap != 20 {
+		t.Errorf("big gap = %+v, want gap 20", got)
+	}
+	if got := DetectTierBreak("TE", intPtr(20), nil, settings); got == nil {
+		t.Error("empty position should be a tier break")
+	}
+	if got := DetectTierBreak("TE", nil, intPtr(40), settings); got != nil {
+		t.Errorf("unranked pick = %+v, want nil", got)
+	}
+}
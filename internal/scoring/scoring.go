@@ -0,0 +1,188 @@
+package scoring
+
+import (
+	"sort"
+
+	"github.com/vibes/draft-board/internal/models"
+)
+
+// Stat categories understood by projections imports and scoring rules.
+const (
+	StatPassYds      = "pass_yds"
+	StatPassTD       = "pass_td"
+	StatPassInt      = "pass_int"
+	StatRushYds      = "rush_yds"
+	StatRushTD       = "rush_td"
+	StatReceptions   = "rec"
+	StatRecYds       = "rec_yds"
+	StatRecTD        = "rec_td"
+	StatFumblesLost  = "fumbles_lost"
+	StatTwoPoint     = "two_pt"
+	StatFGMade       = "fg_made"
+	StatFGMissed     = "fg_missed"
+	StatXPMade       = "xp_made"
+	StatDefSack      = "def_sack"
+	StatDefInt       = "def_int"
+	StatDefFumbleRec = "def_fumble_rec"
+	StatDefTD        = "def_td"
+	StatDefSafety    = "def_safety"
+)
+
+// Stats lists every known stat category in display order.
+var Stats = []string{
+	StatPassYds, StatPassTD, StatPassInt,
+	StatRushYds, StatRushTD,
+	StatReceptions, StatRecYds, StatRecTD,
+	StatFumblesLost, StatTwoPoint,
+	StatFGMade, StatFGMissed, StatXPMade,
+	StatDefSack, StatDefInt, StatDefFumbleRec, StatDefTD, StatDefSafety,
+}
+
+// IsStat reports whether name is a known stat category.
+func IsStat(name string) bool {
+	for _, s := range Stats {
+		if s == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Rules maps a stat category to the fantasy points awarded per unit.
+type Rules map[string]float64
+
+// Ruleset is a complete scoring definition: points per stat for every
+// player, with optional per-position overrides (for example a TE premium on
+// receptions).
+type Ruleset struct {
+	Base      Rules            `json:"base"`
+	Positions map[string]Rules `json:"positions,omitempty"`
+}
+
+// PresetNames lists the built-in scoring formats in display order.
+var PresetNames = []string{"Standard", "Half-PPR", "PPR"}
+
+// IsPreset reports whether name is a built-in scoring format.
+func IsPreset(name string) bool {
+	for _, p := range PresetNames {
+		if p == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Preset returns the ruleset for a built-in scoring format. Unknown formats
+// fall back to Standard.
+func Preset(name string) Ruleset {
+	rules := Rules{
+		StatPassYds:      0.04,
+		StatPassTD:       4,
+		StatPassInt:      -2,
+		StatRushYds:      0.1,
+		StatRushTD:       6,
+		StatRecYds:       0.1,
+		StatRecTD:        6,
+		StatFumblesLost:  -2,
+		StatTwoPoint:     2,
+		StatFGMade:       3,
+		StatFGMissed:     -1,
+		StatXPMade:       1,
+		StatDefSack:      1,
+		StatDefInt:       2,
+		StatDefFumbleRec: 2,
+		StatDefTD:        6,
+		StatDefSafety:    2,
+	}
+
+	switch name {
+	case "PPR":
+		rules[StatReceptions] = 1
+	case "Half-PPR":
+		rules[StatReceptions] = 0.5
+	default:
+		rules[StatReceptions] = 0
+	}
+	return Ruleset{Base: rules}
+}
+
+// PointsPer returns the points one unit of stat is worth for a position,
+// preferring a position override to the base value.
+func (rs Ruleset) PointsPer(position, stat string) float64 {
+	if override, ok := rs.Positions[position]; ok {
+		if points, ok := override[stat]; ok {
+			return points
+		}
+	}
+	return rs.Base[stat]
+}
+
+// Points returns the fantasy points a player's stat line is worth.
+func (rs Ruleset) Points(position string, stats map[string]float64) float64 {
+	// Sum in a fixed order so totals are stable across calls.
+	keys := make([]string, 0, len(stats))
+	for stat := range stats {
+		keys = append(keys, stat)
+	}
+	sort.Strings(keys)
+
+	total := 0.0
+	for _, stat := range keys {
+		total += stats[stat] * rs.PointsPer(position, stat)
+	}
+	return total
+}
+
+// FromRules builds a ruleset from stored rules. Rules with an empty position
+// form the base; the rest are position overrides.
+func FromRules(rules []models.ScoringRule) Ruleset {
+	rs := Ruleset{Base: make(Rules)}
+	for _, r := range rules {
+		if r.Position == "" {
+			rs.Base[r.Stat] = r.Points
+			continue
+		}
+		if rs.Positions == nil {
+			rs.Positions = make(map[string]Rules)
+		}
+		if rs.Positions[r.Position] == nil {
+			rs.Positions[r.Position] = make(Rules)
+		}
+		rs.Positions[r.Position][r.Stat] = r.Points
+	}
+	return rs
+}
+
+// ToRules flattens a ruleset into rows for storage.
+func (rs Ruleset) ToRules(draftID int) []models.ScoringRule {
+	var rules []models.ScoringRule
+	for _, stat := range sortedKeys(rs.Base) {
+		rules = append(rules, models.ScoringRule{DraftID: draftID, Stat: stat, Points: rs.Base[stat]})
+	}
+
+	positions := make([]string, 0, len(rs.Positions))
+	for pos := range rs.Positions {
+		positions = append(positions, pos)
+	}
+	sort.Strings(positions)
+	for _, pos := range positions {
+		for _, stat := range sortedKeys(rs.Positions[pos]) {
+			rules = append(rules, models.ScoringRule{
+				DraftID:  draftID,
+				Position: pos,
+				Stat:     stat,
+				Points:   rs.Positions[pos][stat],
+			})
+		}
+	}
+	return rules
+}
+
+func sortedKeys(rules Rules) []string {
+	keys := make([]string, 0, len(rules))
+	for k := range rules {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
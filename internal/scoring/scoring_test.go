@@ -0,0 +1,100 @@
+package scoring
+
+import (
+	"math"
+	"testing"
+)
+
+func TestPoints(t *testing.T) {
+	wr := map[string]float64{
+		StatReceptions:  100,
+		StatRecYds:      1200,
+		StatRecTD:       10,
+		StatFumblesLost: 1,
+	}
+
+	tests := []struct {
+		name   string
+		format string
+		want   float64
+	}{
+		{name: "standard", format: "Standard", want: 178},
+		{name: "half ppr", format: "Half-PPR", want: 228},
+		{name: "ppr", format: "PPR", want: 278},
+		{name: "unknown format falls back to standard", format: "Bogus", want: 178},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Preset(tt.format).Points("WR", wr)
+			if math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("Points() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPoints_Quarterback(t *testing.T) {
+	qb := map[string]float64{
+		StatPassYds:  4000,
+		StatPassTD:   30,
+		StatPassInt:  10,
+		StatRushYds:  500,
+		StatRushTD:   5,
+		StatTwoPoint: 1,
+	}
+	// 160 + 120 - 20 + 50 + 30 + 2
+	if got := Preset("PPR").Points("QB", qb); math.Abs(got-342) > 1e-9 {
+		t.Errorf("Points() = %v, want 342", got)
+	}
+}
+
+func TestIsStat(t *testing.T) {
+	if !IsStat(StatPassYds) {
+		t.Error("IsStat(pass_yds) = false, want true")
+	}
+	if IsStat("dunks") {
+		t.Error("IsStat(dunks) = true, want false")
+	}
+}
+
+func TestRuleset_PositionOverrides(t *testing.T) {
+	rs := Preset("PPR")
+	rs.Base[StatPassTD] = 6
+	rs.Positions = map[string]Rules{"TE": {StatReceptions: 1.5}}
+
+	te := map[string]float64{StatReceptions: 80, StatRecYds: 900}
+	if got := rs.Points("TE", te); math.Abs(got-210) > 1e-9 {
+		t.Errorf("TE Points() = %v, want 210", got)
+	}
+	if got := rs.Points("WR", te); math.Abs(got-170) > 1e-9 {
+		t.Errorf("WR Points() = %v, want 170", got)
+	}
+	if got := rs.PointsPer("QB", StatPassTD); got != 6 {
+		t.Errorf("PointsPer(QB, pass_td) = %v, want 6", got)
+	}
+}
+
+func TestRuleset_RoundTrip(t *testing.T) {
+	rs := Preset("Half-PPR")
+	rs.Positions = map[string]Rules{"TE": {StatReceptions: 1}}
+
+	got := FromRules(rs.ToRules(3))
+	if len(got.Base) != len(rs.Base) {
+		t.Errorf("len(Base) = %d, want %d", len(got.Base), len(rs.Base))
+	}
+	if got.PointsPer("TE", StatReceptions) != 1 || got.PointsPer("RB", StatReceptions) != 0.5 {
+		t.Errorf("round trip lost overrides: %+v", got)
+	}
+}
+
+func TestIsPreset(t *testing.T) {
+	for _, name := range PresetNames {
+		if !IsPreset(name) {
+			t.Errorf("IsPreset(%q) = false, want true", name)
+		}
+	}
+	if IsPreset("Custom") {
+		t.Error("IsPreset(Custom) = true, want false")
+	}
+}
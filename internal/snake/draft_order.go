@@ -41,6 +41,39 @@ func CalculateCurrentTeam(pickNumber int, numTeams int, teams []Team) (*Team, er
 	return nil, fmt.Errorf("no team found for draft position %d", draftPosition)
 }
 
+// CalculatePickOwner returns the team that owns a pick. That is the team
+// whose turn it is, in snake order or, when snakeOrder is false, the same
+// order every round, unless owners, keyed by overall pick number, gives the
+// pick to another team.
+func CalculatePickOwner(pickNumber int, numTeams int, teams []Team, snakeOrder bool, owners map[int]int) (*Team, error) {
+	if teamID, ok := owners[pickNumber]; ok {
+		for _, team := range teams {
+			if team.ID == teamID {
+				return &team, nil
+			}
+		}
+		return nil, fmt.Errorf("no team found with id %d", teamID)
+	}
+	if snakeOrder {
+		return CalculateCurrentTeam(pickNumber, numTeams, teams)
+	}
+
+	if pickNumber < 1 {
+		return nil, errors.New("invalid pick number")
+	}
+	if numTeams <= 0 {
+		return nil, errors.New("invalid number of teams")
+	}
+
+	draftPosition := ((pickNumber - 1) % numTeams) + 1
+	for _, team := range teams {
+		if team.DraftPosition == draftPosition {
+			return &team, nil
+		}
+	}
+	return nil, fmt.Errorf("no team found for draft position %d", draftPosition)
+}
+
 func CalculateRound(pickNumber int, numTeams int) int {
 	if numTeams <= 0 {
 		return 0
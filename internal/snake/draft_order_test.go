@@ -365,3 +365,40 @@ func TestTeamWithNonSequentialDraftPositions(t *testing.T) {
 		})
 	}
 }
+
+func TestCalculatePickOwner(t *testing.T) {
+	teams := []Team{
+		{ID: 10, DraftPosition: 1},
+		{ID: 20, DraftPosition: 2},
+		{ID: 30, DraftPosition: 3},
+	}
+	owners := map[int]int{5: 10}
+
+	tests := []struct {
+		name       string
+		pickNumber int
+		snakeOrder bool
+		want       int
+	}{
+		{"snake round 2 starts with the last team", 4, true, 30},
+		{"linear round 2 starts with the first team", 4, false, 10},
+		{"linear round 2 last pick", 6, false, 30},
+		{"traded pick goes to its owner", 5, false, 10},
+		{"traded pick in snake order", 5, true, 10},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CalculatePickOwner(tt.pickNumber, 3, teams, tt.snakeOrder, owners)
+			if err != nil {
+				t.Fatalf("CalculatePickOwner() error = %v", err)
+			}
+			if got.ID != tt.want {
+				t.Errorf("CalculatePickOwner() = team %d, want %d", got.ID, tt.want)
+			}
+		})
+	}
+
+	if _, err := CalculatePickOwner(1, 3, teams, false, map[int]int{1: 99}); err == nil {
+		t.Error("CalculatePickOwner() should fail for an unknown owner")
+	}
+}
@@ -0,0 +1,375 @@
+package sport
+
+import (
+	"strings"
+
+	"github.com/vibes/draft-board/internal/models"
+	"github.com/vibes/draft-board/internal/nfl"
+)
+
+// Sport keys stored on drafts and players.
+const (
+	Football   = "football"
+	Basketball = "basketball"
+	Baseball   = "baseball"
+)
+
+// Position is a position players of a sport hold. Color is the Tailwind
+// color its badge is drawn in. Optional positions are only rostered by
+// some leagues, like IDP, and are left out of views until drafted.
+type Position struct {
+	Abbr     string
+	Color    string
+	Optional bool
+}
+
+// RankColumn is a player rank column a draft of the sport can order players
+// by. ScoringFormat is the draft scoring format that selects it, as stored
+// on the draft; columns without one are picked by draft type instead.
+type RankColumn struct {
+	Format        string
+	ScoringFormat string
+	Label         string
+}
+
+// Profile describes one sport: the positions players hold, the lineup new
+// drafts start with, the teams players play for and the rank columns drafts
+// order players by.
+type Profile struct {
+	Key       string
+	Name      string
+	Positions []Position
+	Slots     []models.RosterSlot
+	// Flex maps each flex slot to the positions that may fill it.
+	Flex map[string][]string
+	// Superflex profiles add a SUPERFLEX slot after FLEX for Superflex and
+	// 2QB drafts.
+	Superflex   bool
+	Teams       []string
+	ByeWeeks    bool
+	RankColumns []RankColumn
+}
+
+// FootballProfile is the default profile, used by every draft created
+// before drafts had a sport.
+var FootballProfile = &Profile{
+	Key:  Football,
+	Name: "Football",
+	Positions: []Position{
+		{Abbr: "QB", Color: "blue-500"},
+		{Abbr: "RB", Color: "orange-500"},
+		{Abbr: "WR", Color: "green-500"},
+		{Abbr: "TE", Color: "purple-500"},
+		{Abbr: "K", Color: "yellow-500"},
+		{Abbr: "D/ST", Color: "gray-500"},
+		{Abbr: "DL", Color: "indigo-500", Optional: true},
+		{Abbr: "LB", Color: "blue-600", Optional: true},
+		{Abbr: "DB", Color: "emerald-500", Optional: true},
+	},
+	Slots: []models.RosterSlot{
+		{Slot: "QB", Count: 1},
+		{Slot: "RB", Count: 2},
+		{Slot: "WR", Count: 2},
+		{Slot: "TE", Count: 1},
+		{Slot: "FLEX", Count: 1},
+		{Slot: "K", Count: 1},
+		{Slot: "D/ST", Count: 1},
+		{Slot: "BN", Count: 6},
+	},
+	Flex: map[string][]string{
+		"FLEX":      {"RB", "WR", "TE"},
+		"SUPERFLEX": {"QB", "RB", "WR", "TE"},
+		"IDP":       {"DL", "LB", "DB"},
+	},
+	Superflex: true,
+	Teams:     nflTeams(),
+	ByeWeeks:  true,
+	RankColumns: []RankColumn{
+		{Format: models.FormatPPR, ScoringFormat: "PPR", Label: "PPR"},
+		{Format: models.FormatHalfPPR, ScoringFormat: "Half-PPR", Label: "Half-PPR"},
+		{Format: models.FormatStd, ScoringFormat: "Standard", Label: "Standard"},
+		{Format: models.FormatDynasty, Label: "Dynasty"},
+		{Format: models.FormatSF, Label: "Superflex"},
+	},
+}
+
+// BasketballProfile covers points and category basketball leagues.
+var BasketballProfile = &Profile{
+	Key:  Basketball,
+	Name: "Basketball",
+	Positions: []Position{
+		{Abbr: "PG", Color: "blue-500"},
+		{Abbr: "SG", Color: "green-500"},
+		{Abbr: "SF", Color: "orange-500"},
+		{Abbr: "PF", Color: "purple-500"},
+		{Abbr: "C", Color: "red-500"},
+	},
+	Slots: []models.RosterSlot{
+		{Slot: "PG", Count: 1},
+		{Slot: "SG", Count: 1},
+		{Slot: "SF", Count: 1},
+		{Slot: "PF", Count: 1},
+		{Slot: "C", Count: 1},
+		{Slot: "G", Count: 1},
+		{Slot: "F", Count: 1},
+		{Slot: "UTIL", Count: 3},
+		{Slot: "BN", Count: 3},
+	},
+	Flex: map[string][]string{
+		"G":    {"PG", "SG"},
+		"F":    {"SF", "PF"},
+		"UTIL": {"PG", "SG", "SF", "PF", "C"},
+	},
+	Teams: []string{
+		"ATL", "BOS", "BKN", "CHA", "CHI", "CLE", "DAL", "DEN", "DET", "GSW",
+		"HOU", "IND", "LAC", "LAL", "MEM", "MIA", "MIL", "MIN", "NOP", "NYK",
+		"OKC", "ORL", "PHI", "PHX", "POR", "SAC", "SAS", "TOR", "UTA", "WAS",
+	},
+	RankColumns: []RankColumn{
+		{Format: models.FormatStd, ScoringFormat: "Points", Label: "Points"},
+		{Format: models.FormatPPR, ScoringFormat: "Categories", Label: "Categories"},
+		{Format: models.FormatDynasty, Label: "Dynasty"},
+	},
+}
+
+// BaseballProfile covers points and rotisserie baseball leagues.
+var BaseballProfile = &Profile{
+	Key:  Baseball,
+	Name: "Baseball",
+	Positions: []Position{
+		{Abbr: "C", Color: "red-500"},
+		{Abbr: "1B", Color: "orange-500"},
+		{Abbr: "2B", Color: "yellow-500"},
+		{Abbr: "3B", Color: "amber-500"},
+		{Abbr: "SS", Color: "green-500"},
+		{Abbr: "OF", Color: "teal-500"},
+		{Abbr: "DH", Color: "gray-500"},
+		{Abbr: "SP", Color: "blue-500"},
+		{Abbr: "RP", Color: "indigo-500"},
+	},
+	Slots: []models.RosterSlot{
+		{Slot: "C", Count: 1},
+		{Slot: "1B", Count: 1},
+		{Slot: "2B", Count: 1},
+		{Slot: "3B", Count: 1},
+		{Slot: "SS", Count: 1},
+		{Slot: "OF", Count: 3},
+		{Slot: "UTIL", Count: 1},
+		{Slot: "SP", Count: 5},
+		{Slot: "RP", Count: 3},
+		{Slot: "BN", Count: 5},
+	},
+	Flex: map[string][]string{
+		"CI":   {"1B", "3B"},
+		"MI":   {"2B", "SS"},
+		"UTIL": {"C", "1B", "2B", "3B", "SS", "OF", "DH"},
+		"P":    {"SP", "RP"},
+	},
+	Teams: []string{
+		"ARI", "ATH", "ATL", "BAL", "BOS", "CHC", "CWS", "CIN", "CLE", "COL",
+		"DET", "HOU", "KC", "LAA", "LAD", "MIA", "MIL", "MIN", "NYM", "NYY",
+		"PHI", "PIT", "SD", "SEA", "SF", "STL", "TB", "TEX", "TOR", "WSH",
+	},
+	RankColumns: []RankColumn{
+		{Format: models.FormatStd, ScoringFormat: "Points", Label: "Points"},
+		{Format: models.FormatPPR, ScoringFormat: "Roto", Label: "Roto"},
+		{Format: models.FormatDynasty, Label: "Keeper"},
+	},
+}
+
+// Profiles lists every sport, football first.
+var Profiles = []*Profile{FootballProfile, BasketballProfile, BaseballProfile}
+
+func nflTeams() []string {
+	abbrs := make([]string, len(nfl.Teams))
+	for i, team := range nfl.Teams {
+		abbrs[i] = team.Abbr
+	}
+	return abbrs
+}
+
+// Lookup returns the profile for a sport key. An empty key is football.
+func Lookup(key string) (*Profile, bool) {
+	key = strings.ToLower(strings.TrimSpace(key))
+	if key == "" {
+		return FootballProfile, true
+	}
+	for _, p := range Profiles {
+		if p.Key == key {
+			return p, true
+		}
+	}
+	return nil, false
+}
+
+// Get returns the profile for a sport key, falling back to football.
+func Get(key string) *Profile {
+	if p, ok := Lookup(key); ok {
+		return p
+	}
+	return FootballProfile
+}
+
+// PositionAbbrs lists the sport's positions in display order.
+func (p *Profile) PositionAbbrs() []string {
+	abbrs := make([]string, len(p.Positions))
+	for i, pos := range p.Positions {
+		abbrs[i] = pos.Abbr
+	}
+	return abbrs
+}
+
+// HasPosition reports whether position is one of the sport's positions.
+func (p *Profile) HasPosition(position string) bool {
+	for _, pos := range p.Positions {
+		if pos.Abbr == position {
+			return true
+		}
+	}
+	return false
+}
+
+// CorePositions lists the sport's positions other than optional ones such
+// as IDP, in display order.
+func (p *Profile) CorePositions() []string {
+	var positions []string
+	for _, pos := range p.Positions {
+		if !pos.Optional {
+			positions = append(positions, pos.Abbr)
+		}
+	}
+	return positions
+}
+
+// IsOptional reports whether position is only rostered by some leagues.
+func (p *Profile) IsOptional(position string) bool {
+	for _, pos := range p.Positions {
+		if pos.Abbr == position {
+			return pos.Optional
+		}
+	}
+	return false
+}
+
+// DefaultSlots returns the starting lineup and bench a new draft of the
+// sport starts with.
+func (p *Profile) DefaultSlots(qbSetting string) []models.RosterSlot {
+	slots := make([]models.RosterSlot, 0, len(p.Slots)+1)
+	for _, slot := range p.Slots {
+		slots = append(slots, slot)
+		if slot.Slot == "FLEX" && p.Superflex && (qbSetting == "SF" || qbSetting == "2QB" || qbSetting == "Superflex") {
+			slots = append(slots, models.RosterSlot{Slot: "SUPERFLEX", Count: 1})
+		}
+	}
+	return slots
+}
+
+// ScoringFormats lists the rank columns a draft's scoring format can pick.
+func (p *Profile) ScoringFormats() []RankColumn {
+	var columns []RankColumn
+	for _, column := range p.RankColumns {
+		if column.ScoringFormat != "" {
+			columns = append(columns, column)
+		}
+	}
+	return columns
+}
+
+// HasScoringFormat reports whether drafts of the sport can use format.
+func (p *Profile) HasScoringFormat(format string) bool {
+	for _, column := range p.ScoringFormats() {
+		if column.ScoringFormat == format {
+			return true
+		}
+	}
+	return false
+}
+
+// IsScoringFormat reports whether format is a scoring format of any sport.
+func IsScoringFormat(format string) bool {
+	for _, p := range Profiles {
+		if p.HasScoringFormat(format) {
+			return true
+		}
+	}
+	return false
+}
+
+// FormatLabel names a draft scoring format the way the sport does.
+func (p *Profile) FormatLabel(scoringFormat string) string {
+	for _, column := range p.ScoringFormats() {
+		if column.ScoringFormat == scoringFormat {
+			return column.Label
+		}
+	}
+	return scoringFormat
+}
+
+// RankLabel names a rank format the way the sport does, or returns "" when
+// the sport has no such column.
+func (p *Profile) RankLabel(format string) string {
+	for _, column := range p.RankColumns {
+		if column.Format == format {
+			return column.Label
+		}
+	}
+	return ""
+}
+
+// FlexPositions returns the positions that may fill a flex slot, or nil
+// for a slot that only takes its own position. Flex slots mean the same in
+// every sport that has them except UTIL, which takes any hitter in baseball
+// and anyone in basketball; since players only hold their own sport's
+// positions, the union of the two is exact for both.
+func FlexPositions(slot string) []string {
+	var positions []string
+	seen := make(map[string]bool)
+	for _, p := range Profiles {
+		for _, pos := range p.Flex[slot] {
+			if !seen[pos] {
+				seen[pos] = true
+				positions = append(positions, pos)
+			}
+		}
+	}
+	return positions
+}
+
+// PositionColor returns the Tailwind color a position's badge is drawn in.
+// Sports that share a position abbreviation give it the same color.
+func PositionColor(position string) string {
+	for _, p := range Profiles {
+		for _, pos := range p.Positions {
+			if pos.Abbr == position {
+				return pos.Color
+			}
+		}
+	}
+	return "gray-500"
+}
+
+// colorHex holds the hex value of each Tailwind color positions are drawn
+// in, for exports that are not rendered by the browser.
+var colorHex = map[string]string{
+	"amber-500":   "F59E0B",
+	"blue-500":    "3B82F6",
+	"blue-600":    "2563EB",
+	"emerald-500": "10B981",
+	"gray-500":    "6B7280",
+	"green-500":   "22C55E",
+	"indigo-500":  "6366F1",
+	"orange-500":  "F97316",
+	"purple-500":  "A855F7",
+	"red-500":     "EF4444",
+	"teal-500":    "14B8A6",
+	"yellow-500":  "EAB308",
+}
+
+// PositionHex returns PositionColor as an RGB hex string such as "3B82F6",
+// without the leading #.
+func PositionHex(position string) string {
+	if hex, ok := colorHex[PositionColor(position)]; ok {
+		return hex
+	}
+	return colorHex["gray-500"]
+}
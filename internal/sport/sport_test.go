@@ -0,0 +1,152 @@
+package sport
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/vibes/draft-board/internal/models"
+)
+
+func TestLookup(t *testing.T) {
+	tests := []struct {
+		key    string
+		want   *Profile
+		wantOK bool
+	}{
+		{"", FootballProfile, true},
+		{"football", FootballProfile, true},
+		{" Basketball ", BasketballProfile, true},
+		{"baseball", BaseballProfile, true},
+		{"hockey", nil, false},
+	}
+	for _, tt := range tests {
+		got, ok := Lookup(tt.key)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("Lookup(%q) = %v, %v, want %v, %v", tt.key, got, ok, tt.want, tt.wantOK)
+		}
+	}
+	if Get("hockey") != FootballProfile {
+		t.Error("Get() should fall back to football")
+	}
+}
+
+func TestProfiles(t *testing.T) {
+	colors := make(map[string]string)
+	for _, p := range Profiles {
+		for _, pos := range p.Positions {
+			if c, ok := colors[pos.Abbr]; ok && c != pos.Color {
+				t.Errorf("%s is %s in one sport and %s in %s", pos.Abbr, c, pos.Color, p.Key)
+			}
+			colors[pos.Abbr] = pos.Color
+			if _, ok := colorHex[pos.Color]; !ok {
+				t.Errorf("%s color %s has no hex value", pos.Abbr, pos.Color)
+			}
+		}
+	}
+	for _, p := range Profiles {
+		for _, slot := range p.Slots {
+			if slot.Slot != "BN" && !p.HasPosition(slot.Slot) && p.Flex[slot.Slot] == nil {
+				t.Errorf("%s slot %s takes no position", p.Key, slot.Slot)
+			}
+		}
+		for slot, positions := range p.Flex {
+			if _, ok := colors[slot]; ok {
+				t.Errorf("flex slot %s is also a position", slot)
+			}
+			for _, pos := range positions {
+				if !p.HasPosition(pos) {
+					t.Errorf("%s flex slot %s takes unknown position %s", p.Key, slot, pos)
+				}
+			}
+		}
+		if len(p.ScoringFormats()) == 0 {
+			t.Errorf("%s has no scoring formats", p.Key)
+		}
+	}
+}
+
+func TestDefaultSlots(t *testing.T) {
+	count := func(slots []models.RosterSlot, name string) int {
+		for _, s := range slots {
+			if s.Slot == name {
+				return s.Count
+			}
+		}
+		return 0
+	}
+
+	if n := count(FootballProfile.DefaultSlots("1QB"), "SUPERFLEX"); n != 0 {
+		t.Errorf("1QB SUPERFLEX = %d, want 0", n)
+	}
+	if n := count(FootballProfile.DefaultSlots("SF"), "SUPERFLEX"); n != 1 {
+		t.Errorf("SF SUPERFLEX = %d, want 1", n)
+	}
+	if n := count(FootballProfile.DefaultSlots("1QB"), "RB"); n != 2 {
+		t.Errorf("1QB RB = %d, want 2", n)
+	}
+	if n := count(BasketballProfile.DefaultSlots("SF"), "SUPERFLEX"); n != 0 {
+		t.Errorf("basketball SUPERFLEX = %d, want 0", n)
+	}
+}
+
+func TestFlexPositions(t *testing.T) {
+	if got := FlexPositions("FLEX"); !reflect.DeepEqual(got, []string{"RB", "WR", "TE"}) {
+		t.Errorf("FlexPositions(FLEX) = %v", got)
+	}
+	if got := FlexPositions("QB"); got != nil {
+		t.Errorf("FlexPositions(QB) = %v, want nil", got)
+	}
+	util := FlexPositions("UTIL")
+	for _, pos := range []string{"PG", "C", "OF"} {
+		if !contains(util, pos) {
+			t.Errorf("UTIL should take %s", pos)
+		}
+	}
+	if contains(util, "SP") {
+		t.Error("UTIL should not take pitchers")
+	}
+}
+
+func TestFormatLabel(t *testing.T) {
+	if got := FootballProfile.FormatLabel("Half-PPR"); got != "Half-PPR" {
+		t.Errorf("FormatLabel(Half-PPR) = %q, want Half-PPR", got)
+	}
+	if BasketballProfile.HasScoringFormat("PPR") || BaseballProfile.HasScoringFormat("Categories") {
+		t.Error("sports should only offer their own scoring formats")
+	}
+	if !IsScoringFormat("Roto") || IsScoringFormat("Half PPR") {
+		t.Error("IsScoringFormat should know every sport's formats and nothing else")
+	}
+	if got := FootballProfile.RankLabel(models.FormatSF); got != "Superflex" {
+		t.Errorf("RankLabel(sf) = %q, want Superflex", got)
+	}
+}
+
+// TestScoringFormatRanks checks that every scoring format drafts from the
+// rank column its sport lists it with.
+func TestScoringFormatRanks(t *testing.T) {
+	for _, p := range Profiles {
+		for _, column := range p.ScoringFormats() {
+			if got := models.RankFormat("Redraft", column.ScoringFormat); got != column.Format {
+				t.Errorf("%s %s ranks by %q, want %q", p.Name, column.ScoringFormat, got, column.Format)
+			}
+		}
+	}
+}
+
+func TestCorePositions(t *testing.T) {
+	got := FootballProfile.CorePositions()
+	want := []string{"QB", "RB", "WR", "TE", "K", "D/ST"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("CorePositions() = %v, want %v", got, want)
+	}
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
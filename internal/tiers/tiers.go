@@ -0,0 +1,105 @@
+package tiers
+
+import (
+	"math"
+	"sort"
+
+	"github.com/vibes/draft-board/internal/models"
+)
+
+// Ranked is a player's rank in the source being tiered.
+type Ranked struct {
+	PlayerID int
+	Position string
+	Rank     int
+}
+
+// Compute splits each position into tiers at its unusually large rank gaps.
+// A new tier starts wherever the gap to the previous player exceeds the
+// position's mean gap by more than half a standard deviation.
+func Compute(source string, players []Ranked) []models.PlayerTier {
+	byPos := make(map[string][]Ranked)
+	var positions []string
+	for _, p := range players {
+		if _, ok := byPos[p.Position]; !ok {
+			positions = append(positions, p.Position)
+		}
+		byPos[p.Position] = append(byPos[p.Position], p)
+	}
+	sort.Strings(positions)
+
+	var result []models.PlayerTier
+	for _, pos := range positions {
+		ranked := byPos[pos]
+		sort.SliceStable(ranked, func(i, j int) bool { return ranked[i].Rank < ranked[j].Rank })
+
+		threshold := breakThreshold(ranked)
+		tier := 1
+		for i, p := range ranked {
+			if i > 0 && float64(p.Rank-ranked[i-1].Rank) > threshold {
+				tier++
+			}
+			result = append(result, models.PlayerTier{Source: source, PlayerID: p.PlayerID, Position: pos, Tier: tier})
+		}
+	}
+	return result
+}
+
+// breakThreshold is the gap a position needs to start a new tier. Positions
+// with evenly spaced ranks never break.
+func breakThreshold(ranked []Ranked) float64 {
+	if len(ranked) < 2 {
+		return math.Inf(1)
+	}
+	gaps := make([]float64, 0, len(ranked)-1)
+	var sum float64
+	for i := 1; i < len(ranked); i++ {
+		gap := float64(ranked[i].Rank - ranked[i-1].Rank)
+		gaps = append(gaps, gap)
+		sum += gap
+	}
+	mean := sum / float64(len(gaps))
+
+	var variance float64
+	for _, gap := range gaps {
+		variance += (gap - mean) * (gap - mean)
+	}
+	stddev := math.Sqrt(variance / float64(len(gaps)))
+	if stddev == 0 {
+		return math.Inf(1)
+	}
+	return mean + stddev/2
+}
+
+// Remaining counts the players left in a position's best remaining tier.
+type Remaining struct {
+	Position string `json:"position"`
+	Tier     int    `json:"tier"`
+	Count    int    `json:"count"`
+}
+
+// TopRemaining reports, for each position in order, how many available
+// players are left in the best tier still on the board. Positions with no
+// tiered players left are omitted.
+func TopRemaining(positions []string, available []*models.Player, tiers map[int]int) []Remaining {
+	var result []Remaining
+	for _, pos := range positions {
+		best, count := 0, 0
+		for _, p := range available {
+			tier, ok := tiers[p.ID]
+			if !ok || p.Position != pos {
+				continue
+			}
+			switch {
+			case best == 0 || tier < best:
+				best, count = tier, 1
+			case tier == best:
+				count++
+			}
+		}
+		if best > 0 {
+			result = append(result, Remaining{Position: pos, Tier: best, Count: count})
+		}
+	}
+	return result
+}
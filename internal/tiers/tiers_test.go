@@ -0,0 +1,94 @@
+package tiers
+
+import (
+	"testing"
+
+	"github.com/vibes/draft-board/internal/models"
+)
+
+func TestCompute(t *testing.T) {
+	tests := []struct {
+		name    string
+		players []Ranked
+		want    map[int]int
+	}{
+		{
+			name: "breaks at large gaps",
+			players: []Ranked{
+				{PlayerID: 1, Position: "RB", Rank: 1},
+				{PlayerID: 2, Position: "RB", Rank: 2},
+				{PlayerID: 3, Position: "RB", Rank: 4},
+				{PlayerID: 4, Position: "RB", Rank: 20},
+				{PlayerID: 5, Position: "RB", Rank: 22},
+				{PlayerID: 6, Position: "RB", Rank: 45},
+			},
+			want: map[int]int{1: 1, 2: 1, 3: 1, 4: 2, 5: 2, 6: 3},
+		},
+		{
+			name: "positions tiered separately",
+			players: []Ranked{
+				{PlayerID: 1, Position: "QB", Rank: 30},
+				{PlayerID: 2, Position: "WR", Rank: 1},
+				{PlayerID: 3, Position: "QB", Rank: 3},
+				{PlayerID: 4, Position: "WR", Rank: 2},
+			},
+			want: map[int]int{1: 1, 2: 1, 3: 1, 4: 1},
+		},
+		{
+			name: "even spacing is one tier",
+			players: []Ranked{
+				{PlayerID: 1, Position: "TE", Rank: 10},
+				{PlayerID: 2, Position: "TE", Rank: 20},
+				{PlayerID: 3, Position: "TE", Rank: 30},
+			},
+			want: map[int]int{1: 1, 2: 1, 3: 1},
+		},
+		{
+			name:    "empty",
+			players: nil,
+			want:    map[int]int{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Compute("ppr", tt.players)
+			if len(got) != len(tt.want) {
+				t.Fatalf("Compute() returned %d tiers, want %d", len(got), len(tt.want))
+			}
+			for _, pt := range got {
+				if pt.Source != "ppr" {
+					t.Errorf("player %d source = %q, want ppr", pt.PlayerID, pt.Source)
+				}
+				if pt.Tier != tt.want[pt.PlayerID] {
+					t.Errorf("player %d tier = %d, want %d", pt.PlayerID, pt.Tier, tt.want[pt.PlayerID])
+				}
+			}
+		})
+	}
+}
+
+func TestTopRemaining(t *testing.T) {
+	available := []*models.Player{
+		{ID: 1, Position: "RB"},
+		{ID: 2, Position: "RB"},
+		{ID: 3, Position: "RB"},
+		{ID: 4, Position: "WR"},
+		{ID: 5, Position: "QB"},
+	}
+	tiers := map[int]int{1: 2, 2: 2, 3: 3, 4: 1}
+
+	got := TopRemaining([]string{"QB", "RB", "WR"}, available, tiers)
+	want := []Remaining{
+		{Position: "RB", Tier: 2, Count: 2},
+		{Position: "WR", Tier: 1, Count: 1},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("TopRemaining() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("TopRemaining()[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
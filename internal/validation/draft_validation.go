@@ -1,6 +1,9 @@
 package validation
 
-import "github.com/vibes/draft-board/internal/models"
+import (
+	"github.com/vibes/draft-board/internal/models"
+	"github.com/vibes/draft-board/internal/sport"
+)
 
 func ValidateDraft(draft *models.Draft) error {
 	if draft.Name == "" {
@@ -9,14 +12,20 @@ func ValidateDraft(draft *models.Draft) error {
 	if draft.NumTeams < 2 || draft.NumTeams > 14 {
 		return ErrInvalidLeagueSize
 	}
-	validFormats := map[string]bool{"Standard": true, "Half-PPR": true, "PPR": true}
-	if !validFormats[draft.ScoringFormat] {
+	if !sport.IsScoringFormat(draft.ScoringFormat) {
 		return ErrInvalidScoringFormat
 	}
 	validTypes := map[string]bool{"Redraft": true, "Dynasty": true}
 	if !validTypes[draft.DraftType] {
 		return ErrInvalidDraftType
 	}
+	profile, ok := sport.Lookup(draft.Sport)
+	if !ok {
+		return ErrInvalidSport
+	}
+	if !profile.HasScoringFormat(draft.ScoringFormat) {
+		return ErrScoringFormatForSport
+	}
 	return nil
 }
 
@@ -182,6 +182,60 @@ func TestValidateDraft(t *testing.T) {
 			},
 			wantErr: ErrInvalidDraftType,
 		},
+		{
+			name: "valid - basketball points",
+			draft: &models.Draft{
+				Name:          "Hoops",
+				NumTeams:      10,
+				ScoringFormat: "Points",
+				DraftType:     "Redraft",
+				Sport:         "basketball",
+			},
+			wantErr: nil,
+		},
+		{
+			name: "valid - baseball roto",
+			draft: &models.Draft{
+				Name:          "Diamond",
+				NumTeams:      12,
+				ScoringFormat: "Roto",
+				DraftType:     "Redraft",
+				Sport:         "baseball",
+			},
+			wantErr: nil,
+		},
+		{
+			name: "invalid - another sport's format",
+			draft: &models.Draft{
+				Name:          "Gridiron",
+				NumTeams:      12,
+				ScoringFormat: "Categories",
+				DraftType:     "Redraft",
+			},
+			wantErr: ErrScoringFormatForSport,
+		},
+		{
+			name: "invalid - unknown sport",
+			draft: &models.Draft{
+				Name:          "Rink",
+				NumTeams:      10,
+				ScoringFormat: "Standard",
+				DraftType:     "Redraft",
+				Sport:         "hockey",
+			},
+			wantErr: ErrInvalidSport,
+		},
+		{
+			name: "invalid - format the sport lacks",
+			draft: &models.Draft{
+				Name:          "Hoops",
+				NumTeams:      10,
+				ScoringFormat: "Half-PPR",
+				DraftType:     "Redraft",
+				Sport:         "basketball",
+			},
+			wantErr: ErrScoringFormatForSport,
+		},
 	}
 
 	for _, tt := range tests {
@@ -4,8 +4,10 @@ import "errors"
 
 var (
 	ErrInvalidLeagueSize    = errors.New("invalid league size. Must be between 2 and 14 teams")
-	ErrInvalidScoringFormat = errors.New("invalid scoring format. Must be Standard, Half-PPR, or PPR")
+	ErrInvalidScoringFormat = errors.New("invalid scoring format")
 	ErrInvalidDraftType     = errors.New("invalid draft type. Must be Redraft or Dynasty")
+	ErrInvalidSport         = errors.New("invalid sport. Must be football, basketball, or baseball")
+	ErrScoringFormatForSport = errors.New("scoring format is not offered for this sport")
 	ErrDraftNameRequired    = errors.New("draft name is required")
 	ErrTeamNameRequired     = errors.New("team name is required")
 	ErrTeamNameTooLong      = errors.New("team name must be between 1 and 50 characters")
@@ -22,5 +24,11 @@ var (
 	ErrSearchQueryTooLong   = errors.New("search query too long (max 50 characters)")
 	ErrInvalidPosition      = errors.New("invalid position filter")
 	ErrInvalidSortOption     = errors.New("invalid sort option")
+	ErrInvalidScoringStat   = errors.New("unknown scoring stat category")
+	ErrInvalidScoringPos    = errors.New("invalid scoring override position")
+	ErrEmptyScoringRules    = errors.New("scoring rules must set points for at least one stat")
+	ErrInvalidRunWindow     = errors.New("run window must be between 2 and 20 picks")
+	ErrInvalidRunThreshold  = errors.New("run threshold must be between 2 and the window size")
+	ErrInvalidTierGap       = errors.New("tier gap must be between 0 and 100")
 )
 
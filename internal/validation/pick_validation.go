@@ -3,9 +3,13 @@ package validation
 import (
 	"github.com/vibes/draft-board/internal/models"
 	"github.com/vibes/draft-board/internal/snake"
+	"github.com/vibes/draft-board/internal/sport"
 )
 
-func ValidatePick(pick *models.Pick, draft *models.Draft, teams []models.Team, pickCount int) error {
+// ValidatePick checks the pick is the draft's next and belongs to the team
+// that owns it: the team whose turn it is, or the team it was traded to in
+// owners, keyed by overall pick number.
+func ValidatePick(pick *models.Pick, draft *models.Draft, teams []models.Team, pickCount int, owners map[int]int) error {
 	// Validate pick is sequential
 	if pick.OverallPick != pickCount+1 {
 		return ErrInvalidPickNumber
@@ -20,7 +24,7 @@ func ValidatePick(pick *models.Pick, draft *models.Draft, teams []models.Team, p
 		}
 	}
 
-	currentTeam, err := snake.CalculateCurrentTeam(pick.OverallPick, draft.NumTeams, snakeTeams)
+	currentTeam, err := snake.CalculatePickOwner(pick.OverallPick, draft.NumTeams, snakeTeams, draft.Snakes(), owners)
 	if err != nil || currentTeam.ID != pick.TeamID {
 		return ErrNotTeamTurn
 	}
@@ -49,12 +53,8 @@ func ValidateSearchQuery(query string) error {
 	return nil
 }
 
-func ValidatePosition(position string) error {
-	validPositions := map[string]bool{
-		"QB": true, "RB": true, "WR": true, "TE": true,
-		"K": true, "D/ST": true, "DL": true, "LB": true, "DB": true,
-	}
-	if !validPositions[position] {
+func ValidatePosition(profile *sport.Profile, position string) error {
+	if !profile.HasPosition(position) {
 		return ErrInvalidPosition
 	}
 	return nil
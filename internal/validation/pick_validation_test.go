@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/vibes/draft-board/internal/models"
+	"github.com/vibes/draft-board/internal/sport"
 )
 
 func TestValidatePick(t *testing.T) {
@@ -29,8 +30,9 @@ func TestValidatePick(t *testing.T) {
 				OverallPick: 1,
 			},
 			draft: &models.Draft{
-				NumTeams: 4,
-				Status:   "active",
+				NumTeams:   4,
+				SnakeDraft: true,
+				Status:     "active",
 			},
 			teams:     teams,
 			pickCount: 0,
@@ -43,8 +45,9 @@ func TestValidatePick(t *testing.T) {
 				OverallPick: 2,
 			},
 			draft: &models.Draft{
-				NumTeams: 4,
-				Status:   "active",
+				NumTeams:   4,
+				SnakeDraft: true,
+				Status:     "active",
 			},
 			teams:     teams,
 			pickCount: 1,
@@ -57,8 +60,9 @@ func TestValidatePick(t *testing.T) {
 				OverallPick: 5,
 			},
 			draft: &models.Draft{
-				NumTeams: 4,
-				Status:   "active",
+				NumTeams:   4,
+				SnakeDraft: true,
+				Status:     "active",
 			},
 			teams:     teams,
 			pickCount: 4,
@@ -71,8 +75,9 @@ func TestValidatePick(t *testing.T) {
 				OverallPick: 4,
 			},
 			draft: &models.Draft{
-				NumTeams: 4,
-				Status:   "active",
+				NumTeams:   4,
+				SnakeDraft: true,
+				Status:     "active",
 			},
 			teams:     teams,
 			pickCount: 3,
@@ -85,8 +90,9 @@ func TestValidatePick(t *testing.T) {
 				OverallPick: 5, // Should be 1
 			},
 			draft: &models.Draft{
-				NumTeams: 4,
-				Status:   "active",
+				NumTeams:   4,
+				SnakeDraft: true,
+				Status:     "active",
 			},
 			teams:     teams,
 			pickCount: 0,
@@ -99,8 +105,9 @@ func TestValidatePick(t *testing.T) {
 				OverallPick: 4, // Should be 3
 			},
 			draft: &models.Draft{
-				NumTeams: 4,
-				Status:   "active",
+				NumTeams:   4,
+				SnakeDraft: true,
+				Status:     "active",
 			},
 			teams:     teams,
 			pickCount: 2,
@@ -113,8 +120,9 @@ func TestValidatePick(t *testing.T) {
 				OverallPick: 1,
 			},
 			draft: &models.Draft{
-				NumTeams: 4,
-				Status:   "active",
+				NumTeams:   4,
+				SnakeDraft: true,
+				Status:     "active",
 			},
 			teams:     teams,
 			pickCount: 0,
@@ -127,8 +135,9 @@ func TestValidatePick(t *testing.T) {
 				OverallPick: 1,
 			},
 			draft: &models.Draft{
-				NumTeams: 4,
-				Status:   "paused",
+				NumTeams:   4,
+				SnakeDraft: true,
+				Status:     "paused",
 			},
 			teams:     teams,
 			pickCount: 0,
@@ -141,8 +150,9 @@ func TestValidatePick(t *testing.T) {
 				OverallPick: 1,
 			},
 			draft: &models.Draft{
-				NumTeams: 4,
-				Status:   "completed",
+				NumTeams:   4,
+				SnakeDraft: true,
+				Status:     "completed",
 			},
 			teams:     teams,
 			pickCount: 0,
@@ -155,8 +165,9 @@ func TestValidatePick(t *testing.T) {
 				OverallPick: 1,
 			},
 			draft: &models.Draft{
-				NumTeams: 4,
-				Status:   "setup",
+				NumTeams:   4,
+				SnakeDraft: true,
+				Status:     "setup",
 			},
 			teams:     teams,
 			pickCount: 0,
@@ -169,8 +180,9 @@ func TestValidatePick(t *testing.T) {
 				OverallPick: 1,
 			},
 			draft: &models.Draft{
-				NumTeams: 12,
-				Status:   "active",
+				NumTeams:   12,
+				SnakeDraft: true,
+				Status:     "active",
 			},
 			teams: []models.Team{
 				{ID: 1, DraftPosition: 1},
@@ -193,7 +205,7 @@ func TestValidatePick(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := ValidatePick(tt.pick, tt.draft, tt.teams, tt.pickCount)
+			err := ValidatePick(tt.pick, tt.draft, tt.teams, tt.pickCount, nil)
 			if err != tt.wantErr {
 				t.Errorf("ValidatePick() error = %v, wantErr %v", err, tt.wantErr)
 			}
@@ -201,6 +213,27 @@ func TestValidatePick(t *testing.T) {
 	}
 }
 
+func TestValidatePick_RookieDraft(t *testing.T) {
+	teams := []models.Team{
+		{ID: 1, DraftPosition: 1},
+		{ID: 2, DraftPosition: 2},
+	}
+	draft := &models.Draft{NumTeams: 2, Status: "active", Rookie: true}
+	owners := map[int]int{4: 1}
+
+	// Rookie drafts keep the same order every round.
+	if err := ValidatePick(&models.Pick{TeamID: 1, OverallPick: 3}, draft, teams, 2, owners); err != nil {
+		t.Errorf("round 2 first pick: error = %v", err)
+	}
+	// Team 1 owns team 2's traded round 2 pick.
+	if err := ValidatePick(&models.Pick{TeamID: 1, OverallPick: 4}, draft, teams, 3, owners); err != nil {
+		t.Errorf("traded pick: error = %v", err)
+	}
+	if err := ValidatePick(&models.Pick{TeamID: 2, OverallPick: 4}, draft, teams, 3, owners); err != ErrNotTeamTurn {
+		t.Errorf("traded pick by original team: error = %v, want %v", err, ErrNotTeamTurn)
+	}
+}
+
 func TestValidatePlayerNotDrafted(t *testing.T) {
 	draftedPlayers := []int{10, 25, 42, 100, 150}
 
@@ -376,10 +409,22 @@ func TestValidatePosition(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := ValidatePosition(tt.position)
+			err := ValidatePosition(sport.FootballProfile, tt.position)
 			if err != tt.wantErr {
 				t.Errorf("ValidatePosition() error = %v, wantErr %v", err, tt.wantErr)
 			}
 		})
 	}
 }
+
+func TestValidatePositionForSport(t *testing.T) {
+	if err := ValidatePosition(sport.BasketballProfile, "PG"); err != nil {
+		t.Errorf("PG should be a basketball position: %v", err)
+	}
+	if err := ValidatePosition(sport.BasketballProfile, "QB"); err != ErrInvalidPosition {
+		t.Errorf("QB should not be a basketball position, got %v", err)
+	}
+	if err := ValidatePosition(sport.BaseballProfile, "1B"); err != nil {
+		t.Errorf("1B should be a baseball position: %v", err)
+	}
+}
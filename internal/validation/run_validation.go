@@ -0,0 +1,16 @@
+package validation
+
+import "github.com/vibes/draft-board/internal/models"
+
+func ValidateRunSettings(settings models.RunSettings) error {
+	if settings.Window < 2 || settings.Window > 20 {
+		return ErrInvalidRunWindow
+	}
+	if settings.Threshold < 2 || settings.Threshold > settings.Window {
+		return ErrInvalidRunThreshold
+	}
+	if settings.TierGap < 0 || settings.TierGap > 100 {
+		return ErrInvalidTierGap
+	}
+	return nil
+}
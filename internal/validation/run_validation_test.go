@@ -0,0 +1,49 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/vibes/draft-board/internal/models"
+)
+
+func TestValidateRunSettings(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings models.RunSettings
+		wantErr  error
+	}{
+		{
+			name:     "defaults",
+			settings: models.RunSettings{Window: 6, Threshold: 4, TierGap: 12},
+			wantErr:  nil,
+		},
+		{
+			name:     "tier breaks disabled",
+			settings: models.RunSettings{Window: 6, Threshold: 4, TierGap: 0},
+			wantErr:  nil,
+		},
+		{
+			name:     "window too small",
+			settings: models.RunSettings{Window: 1, Threshold: 1},
+			wantErr:  ErrInvalidRunWindow,
+		},
+		{
+			name:     "threshold above window",
+			settings: models.RunSettings{Window: 4, Threshold: 5},
+			wantErr:  ErrInvalidRunThreshold,
+		},
+		{
+			name:     "negative tier gap",
+			settings: models.RunSettings{Window: 6, Threshold: 4, TierGap: -1},
+			wantErr:  ErrInvalidTierGap,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateRunSettings(tt.settings); err != tt.wantErr {
+				t.Errorf("ValidateRunSettings() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package validation
+
+import (
+	"github.com/vibes/draft-board/internal/scoring"
+	"github.com/vibes/draft-board/internal/sport"
+)
+
+// ValidateScoringRules checks a ruleset's stats and that its overrides name
+// positions of the draft's sport, and that it sets at least one rule.
+func ValidateScoringRules(profile *sport.Profile, rules scoring.Ruleset) error {
+	for stat := range rules.Base {
+		if !scoring.IsStat(stat) {
+			return ErrInvalidScoringStat
+		}
+	}
+	for position, overrides := range rules.Positions {
+		if err := ValidatePosition(profile, position); err != nil {
+			return ErrInvalidScoringPos
+		}
+		for stat := range overrides {
+			if !scoring.IsStat(stat) {
+				return ErrInvalidScoringStat
+			}
+		}
+	}
+	// With no rules stored a draft falls back to its format's preset, so
+	// an empty ruleset would silently score by the preset.
+	if len(rules.ToRules(0)) == 0 {
+		return ErrEmptyScoringRules
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/vibes/draft-board/internal/scoring"
+	"github.com/vibes/draft-board/internal/sport"
+)
+
+func TestValidateScoringRules(t *testing.T) {
+	tests := []struct {
+		name    string
+		profile *sport.Profile
+		rules   scoring.Ruleset
+		wantErr error
+	}{
+		{
+			name:    "preset",
+			rules:   scoring.Preset("PPR"),
+			wantErr: nil,
+		},
+		{
+			name: "valid position override",
+			rules: scoring.Ruleset{
+				Base:      scoring.Rules{"pass_td": 6},
+				Positions: map[string]scoring.Rules{"TE": {"rec": 1.5}},
+			},
+			wantErr: nil,
+		},
+		{
+			name:    "unknown base stat",
+			rules:   scoring.Ruleset{Base: scoring.Rules{"dunks": 2}},
+			wantErr: ErrInvalidScoringStat,
+		},
+		{
+			name: "unknown override stat",
+			rules: scoring.Ruleset{
+				Base:      scoring.Rules{},
+				Positions: map[string]scoring.Rules{"WR": {"dunks": 2}},
+			},
+			wantErr: ErrInvalidScoringStat,
+		},
+		{
+			name: "invalid override position",
+			rules: scoring.Ruleset{
+				Base:      scoring.Rules{},
+				Positions: map[string]scoring.Rules{"PG": {"rec": 1}},
+			},
+			wantErr: ErrInvalidScoringPos,
+		},
+		{
+			name:    "override position of the draft's sport",
+			profile: sport.BasketballProfile,
+			rules: scoring.Ruleset{
+				Base:      scoring.Rules{},
+				Positions: map[string]scoring.Rules{"PG": {"rec": 1}},
+			},
+			wantErr: nil,
+		},
+		{
+			name:    "no rules",
+			rules:   scoring.Ruleset{Base: scoring.Rules{}, Positions: map[string]scoring.Rules{"TE": {}}},
+			wantErr: ErrEmptyScoringRules,
+		},
+		{
+			name:    "all zero",
+			rules:   scoring.Ruleset{Base: scoring.Rules{"pass_td": 0}},
+			wantErr: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profile := tt.profile
+			if profile == nil {
+				profile = sport.FootballProfile
+			}
+			if err := ValidateScoringRules(profile, tt.rules); err != tt.wantErr {
+				t.Errorf("ValidateScoringRules() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,393 @@
+// Package xlsx writes simple Excel workbooks: sheets of text and number
+// cells with fonts, fills, borders, column widths and frozen panes. It
+// covers what the draft exports need and nothing more, so drafts can be
+// exported without a spreadsheet library or an outside service.
+package xlsx
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Style is how a cell is drawn. Colors are RGB hex strings such as
+// "3B82F6"; empty means the default.
+type Style struct {
+	Bold   bool
+	Color  string
+	Fill   string
+	Border bool
+	Wrap   bool
+}
+
+// Cell is one cell of a row: text, a number or empty.
+type Cell struct {
+	text   string
+	number float64
+	kind   int
+	style  int
+}
+
+const (
+	emptyCell = iota
+	textCell
+	numberCell
+)
+
+// Text returns a text cell.
+func Text(s string) Cell {
+	return Cell{text: s, kind: textCell}
+}
+
+// Number returns a number cell.
+func Number(n float64) Cell {
+	return Cell{number: n, kind: numberCell}
+}
+
+// Int returns a number cell holding n.
+func Int(n int) Cell {
+	return Number(float64(n))
+}
+
+// Empty returns a blank cell, which may still be styled.
+func Empty() Cell {
+	return Cell{}
+}
+
+// Styled returns the cell drawn in a style returned by Workbook.Style.
+func (c Cell) Styled(style int) Cell {
+	c.style = style
+	return c
+}
+
+// Row is one row of a sheet. A Height of 0 leaves it to the spreadsheet.
+type Row struct {
+	Cells  []Cell
+	Height float64
+}
+
+// Sheet is one worksheet of a workbook.
+type Sheet struct {
+	Name       string
+	rows       []*Row
+	widths     map[int]float64
+	freezeRows int
+	freezeCols int
+}
+
+// AddRow appends a row of cells to the sheet.
+func (s *Sheet) AddRow(cells ...Cell) *Row {
+	row := &Row{Cells: cells}
+	s.rows = append(s.rows, row)
+	return row
+}
+
+// SetWidth sets the width of a column, counted from 0, in characters.
+func (s *Sheet) SetWidth(col int, width float64) {
+	if s.widths == nil {
+		s.widths = make(map[int]float64)
+	}
+	s.widths[col] = width
+}
+
+// Freeze keeps the first rows and columns in view while the rest scrolls.
+func (s *Sheet) Freeze(rows, cols int) {
+	s.freezeRows, s.freezeCols = rows, cols
+}
+
+// Workbook is a set of sheets and the styles their cells use.
+type Workbook struct {
+	sheets []*Sheet
+	styles []Style
+}
+
+// New returns an empty workbook.
+func New() *Workbook {
+	return &Workbook{}
+}
+
+// AddSheet appends a sheet. Names are cut to the 31 characters Excel
+// allows, stripped of the characters it rejects and made unique.
+func (wb *Workbook) AddSheet(name string) *Sheet {
+	name = strings.Map(func(r rune) rune {
+		if strings.ContainsRune(`[]:*?/\`, r) {
+			return -1
+		}
+		return r
+	}, strings.TrimSpace(name))
+	if name == "" {
+		name = "Sheet"
+	}
+	base := truncate(name, 31)
+	name = base
+	for n := 2; wb.hasSheet(name); n++ {
+		suffix := fmt.Sprintf(" (%d)", n)
+		name = strings.TrimSpace(truncate(base, 31-len(suffix))) + suffix
+	}
+
+	sheet := &Sheet{Name: name}
+	wb.sheets = append(wb.sheets, sheet)
+	return sheet
+}
+
+func (wb *Workbook) hasSheet(name string) bool {
+	for _, s := range wb.sheets {
+		if strings.EqualFold(s.Name, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func truncate(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) > n {
+		return string(runes[:n])
+	}
+	return s
+}
+
+// Style registers a cell style and returns the id to pass to Cell.Styled.
+// Registering the same style twice returns the same id.
+func (wb *Workbook) Style(s Style) int {
+	s.Color = strings.ToUpper(strings.TrimPrefix(s.Color, "#"))
+	s.Fill = strings.ToUpper(strings.TrimPrefix(s.Fill, "#"))
+	for i, existing := range wb.styles {
+		if existing == s {
+			return i + 1
+		}
+	}
+	wb.styles = append(wb.styles, s)
+	return len(wb.styles)
+}
+
+// Write writes the workbook as an .xlsx file.
+func (wb *Workbook) Write(w io.Writer) error {
+	if len(wb.sheets) == 0 {
+		return fmt.Errorf("workbook has no sheets")
+	}
+
+	z := zip.NewWriter(w)
+	files := []struct {
+		name    string
+		content string
+	}{
+		{"[Content_Types].xml", wb.contentTypes()},
+		{"_rels/.rels", rootRels},
+		{"xl/workbook.xml", wb.workbook()},
+		{"xl/_rels/workbook.xml.rels", wb.workbookRels()},
+		{"xl/styles.xml", wb.stylesheet()},
+	}
+	for i, sheet := range wb.sheets {
+		files = append(files, struct {
+			name    string
+			content string
+		}{fmt.Sprintf("xl/worksheets/sheet%d.xml", i+1), sheet.xml()})
+	}
+
+	for _, f := range files {
+		fw, err := z.Create(f.name)
+		if err != nil {
+			return fmt.Errorf("failed to add %s: %w", f.name, err)
+		}
+		if _, err := io.WriteString(fw, f.content); err != nil {
+			return fmt.Errorf("failed to write %s: %w", f.name, err)
+		}
+	}
+	return z.Close()
+}
+
+const (
+	xmlHeader = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` + "\n"
+	mainNS    = "http://schemas.openxmlformats.org/spreadsheetml/2006/main"
+	relNS     = "http://schemas.openxmlformats.org/officeDocument/2006/relationships"
+	pkgRelNS  = "http://schemas.openxmlformats.org/package/2006/relationships"
+)
+
+const rootRels = xmlHeader + `<Relationships xmlns="` + pkgRelNS + `">` +
+	`<Relationship Id="rId1" Type="` + relNS + `/officeDocument" Target="xl/workbook.xml"/>` +
+	`</Relationships>`
+
+func (wb *Workbook) contentTypes() string {
+	var b strings.Builder
+	b.WriteString(xmlHeader)
+	b.WriteString(`<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">`)
+	b.WriteString(`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>`)
+	b.WriteString(`<Default Extension="xml" ContentType="application/xml"/>`)
+	b.WriteString(`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>`)
+	b.WriteString(`<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>`)
+	for i := range wb.sheets {
+		fmt.Fprintf(&b, `<Override PartName="/xl/worksheets/sheet%d.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>`, i+1)
+	}
+	b.WriteString(`</Types>`)
+	return b.String()
+}
+
+func (wb *Workbook) workbook() string {
+	var b strings.Builder
+	b.WriteString(xmlHeader)
+	b.WriteString(`<workbook xmlns="` + mainNS + `" xmlns:r="` + relNS + `"><sheets>`)
+	for i, sheet := range wb.sheets {
+		fmt.Fprintf(&b, `<sheet name="%s" sheetId="%d" r:id="rId%d"/>`, escape(sheet.Name), i+1, i+1)
+	}
+	b.WriteString(`</sheets></workbook>`)
+	return b.String()
+}
+
+func (wb *Workbook) workbookRels() string {
+	var b strings.Builder
+	b.WriteString(xmlHeader)
+	b.WriteString(`<Relationships xmlns="` + pkgRelNS + `">`)
+	for i := range wb.sheets {
+		fmt.Fprintf(&b, `<Relationship Id="rId%d" Type="%s/worksheet" Target="worksheets/sheet%d.xml"/>`, i+1, relNS, i+1)
+	}
+	fmt.Fprintf(&b, `<Relationship Id="rId%d" Type="%s/styles" Target="styles.xml"/>`, len(wb.sheets)+1, relNS)
+	b.WriteString(`</Relationships>`)
+	return b.String()
+}
+
+// stylesheet writes one font, fill and border per style after the defaults
+// Excel expects: a plain font, the "none" and "gray125" fills and no border.
+func (wb *Workbook) stylesheet() string {
+	var fonts, fills, borders, xfs strings.Builder
+	fonts.WriteString(`<font><sz val="11"/><name val="Calibri"/></font>`)
+	fills.WriteString(`<fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill>`)
+	borders.WriteString(`<border><left/><right/><top/><bottom/><diagonal/></border>`)
+	xfs.WriteString(`<xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/>`)
+
+	for i, s := range wb.styles {
+		fonts.WriteString(`<font>`)
+		if s.Bold {
+			fonts.WriteString(`<b/>`)
+		}
+		fonts.WriteString(`<sz val="11"/>`)
+		if s.Color != "" {
+			fmt.Fprintf(&fonts, `<color rgb="FF%s"/>`, escape(s.Color))
+		}
+		fonts.WriteString(`<name val="Calibri"/></font>`)
+
+		fillID := 0
+		if s.Fill != "" {
+			fmt.Fprintf(&fills, `<fill><patternFill patternType="solid"><fgColor rgb="FF%s"/><bgColor indexed="64"/></patternFill></fill>`, escape(s.Fill))
+			fillID = strings.Count(fills.String(), "<fill>") - 1
+		}
+
+		borderID := 0
+		if s.Border {
+			borders.WriteString(`<border><left style="thin"><color rgb="FFD1D5DB"/></left><right style="thin"><color rgb="FFD1D5DB"/></right>` +
+				`<top style="thin"><color rgb="FFD1D5DB"/></top><bottom style="thin"><color rgb="FFD1D5DB"/></bottom><diagonal/></border>`)
+			borderID = strings.Count(borders.String(), "<border>") - 1
+		}
+
+		fmt.Fprintf(&xfs, `<xf numFmtId="0" fontId="%d" fillId="%d" borderId="%d" xfId="0" applyFont="1"`, i+1, fillID, borderID)
+		if fillID > 0 {
+			xfs.WriteString(` applyFill="1"`)
+		}
+		if borderID > 0 {
+			xfs.WriteString(` applyBorder="1"`)
+		}
+		if s.Wrap {
+			xfs.WriteString(` applyAlignment="1"><alignment vertical="top" wrapText="1"/></xf>`)
+		} else {
+			xfs.WriteString(`/>`)
+		}
+	}
+
+	var b strings.Builder
+	b.WriteString(xmlHeader)
+	b.WriteString(`<styleSheet xmlns="` + mainNS + `">`)
+	fmt.Fprintf(&b, `<fonts count="%d">%s</fonts>`, len(wb.styles)+1, fonts.String())
+	fmt.Fprintf(&b, `<fills count="%d">%s</fills>`, strings.Count(fills.String(), "<fill>"), fills.String())
+	fmt.Fprintf(&b, `<borders count="%d">%s</borders>`, strings.Count(borders.String(), "<border>"), borders.String())
+	b.WriteString(`<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>`)
+	fmt.Fprintf(&b, `<cellXfs count="%d">%s</cellXfs>`, len(wb.styles)+1, xfs.String())
+	b.WriteString(`<cellStyles count="1"><cellStyle name="Normal" xfId="0" builtinId="0"/></cellStyles>`)
+	b.WriteString(`</styleSheet>`)
+	return b.String()
+}
+
+func (s *Sheet) xml() string {
+	var b strings.Builder
+	b.WriteString(xmlHeader)
+	b.WriteString(`<worksheet xmlns="` + mainNS + `" xmlns:r="` + relNS + `">`)
+
+	if s.freezeRows > 0 || s.freezeCols > 0 {
+		pane := "bottomRight"
+		switch {
+		case s.freezeCols == 0:
+			pane = "bottomLeft"
+		case s.freezeRows == 0:
+			pane = "topRight"
+		}
+		b.WriteString(`<sheetViews><sheetView workbookViewId="0"><pane`)
+		if s.freezeCols > 0 {
+			fmt.Fprintf(&b, ` xSplit="%d"`, s.freezeCols)
+		}
+		if s.freezeRows > 0 {
+			fmt.Fprintf(&b, ` ySplit="%d"`, s.freezeRows)
+		}
+		fmt.Fprintf(&b, ` topLeftCell="%s" activePane="%s" state="frozen"/></sheetView></sheetViews>`, CellRef(s.freezeRows, s.freezeCols), pane)
+	}
+
+	if len(s.widths) > 0 {
+		cols := make([]int, 0, len(s.widths))
+		for col := range s.widths {
+			cols = append(cols, col)
+		}
+		sort.Ints(cols)
+		b.WriteString(`<cols>`)
+		for _, col := range cols {
+			fmt.Fprintf(&b, `<col min="%d" max="%d" width="%s" customWidth="1"/>`, col+1, col+1, strconv.FormatFloat(s.widths[col], 'f', -1, 64))
+		}
+		b.WriteString(`</cols>`)
+	}
+
+	b.WriteString(`<sheetData>`)
+	for r, row := range s.rows {
+		fmt.Fprintf(&b, `<row r="%d"`, r+1)
+		if row.Height > 0 {
+			fmt.Fprintf(&b, ` ht="%s" customHeight="1"`, strconv.FormatFloat(row.Height, 'f', -1, 64))
+		}
+		b.WriteString(`>`)
+		for c, cell := range row.Cells {
+			if cell.kind == emptyCell && cell.style == 0 {
+				continue
+			}
+			fmt.Fprintf(&b, `<c r="%s"`, CellRef(r, c))
+			if cell.style > 0 {
+				fmt.Fprintf(&b, ` s="%d"`, cell.style)
+			}
+			switch cell.kind {
+			case textCell:
+				fmt.Fprintf(&b, ` t="inlineStr"><is><t xml:space="preserve">%s</t></is></c>`, escape(cell.text))
+			case numberCell:
+				fmt.Fprintf(&b, `><v>%s</v></c>`, strconv.FormatFloat(cell.number, 'f', -1, 64))
+			default:
+				b.WriteString(`/>`)
+			}
+		}
+		b.WriteString(`</row>`)
+	}
+	b.WriteString(`</sheetData></worksheet>`)
+	return b.String()
+}
+
+// CellRef returns the A1-style reference of a cell, counting rows and
+// columns from 0: CellRef(0, 0) is "A1" and CellRef(9, 27) is "AB10".
+func CellRef(row, col int) string {
+	name := ""
+	for col++; col > 0; col = (col - 1) / 26 {
+		name = string(rune('A'+(col-1)%26)) + name
+	}
+	return name + strconv.Itoa(row+1)
+}
+
+func escape(s string) string {
+	var b strings.Builder
+	xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
@@ -0,0 +1,143 @@
+package xlsx
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"strings"
+	"testing"
+)
+
+// unzip writes the workbook and returns its files, checking each is well
+// formed XML.
+func unzip(t *testing.T, wb *Workbook) map[string]string {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := wb.Write(&buf); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("not a zip file: %v", err)
+	}
+
+	files := make(map[string]string)
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		dec := xml.NewDecoder(bytes.NewReader(data))
+		for {
+			if _, err := dec.Token(); err == io.EOF {
+				break
+			} else if err != nil {
+				t.Fatalf("%s is not well formed: %v", f.Name, err)
+			}
+		}
+		files[f.Name] = string(data)
+	}
+	return files
+}
+
+func TestWrite(t *testing.T) {
+	wb := New()
+	header := wb.Style(Style{Bold: true, Fill: "#1f2937", Color: "ffffff"})
+	if again := wb.Style(Style{Bold: true, Fill: "1F2937", Color: "FFFFFF"}); again != header {
+		t.Errorf("Style() = %d for the same style, want %d", again, header)
+	}
+	wrap := wb.Style(Style{Fill: "D8E6FD", Border: true, Wrap: true})
+
+	board := wb.AddSheet("Board")
+	board.AddRow(Text("Round").Styled(header), Text("Aces & Co"))
+	board.AddRow(Int(1), Text("Ja'Marr Chase\nWR - CIN").Styled(wrap), Empty(), Number(2.5)).Height = 30
+	board.SetWidth(1, 22)
+	board.Freeze(1, 1)
+	wb.AddSheet("Picks")
+
+	files := unzip(t, wb)
+	for _, name := range []string{"[Content_Types].xml", "_rels/.rels", "xl/workbook.xml", "xl/_rels/workbook.xml.rels", "xl/styles.xml", "xl/worksheets/sheet1.xml", "xl/worksheets/sheet2.xml"} {
+		if _, ok := files[name]; !ok {
+			t.Errorf("missing %s", name)
+		}
+	}
+
+	sheet := files["xl/worksheets/sheet1.xml"]
+	for _, want := range []string{
+		`<c r="A1" s="1" t="inlineStr"><is><t xml:space="preserve">Round</t>`,
+		`Aces &amp; Co`,
+		`<c r="A2"><v>1</v></c>`,
+		`<c r="B2" s="2" t="inlineStr"><is><t xml:space="preserve">Ja&#39;Marr Chase&#xA;WR - CIN</t>`,
+		`<c r="D2"><v>2.5</v></c>`,
+		`<row r="2" ht="30" customHeight="1">`,
+		`<col min="2" max="2" width="22" customWidth="1"/>`,
+		`xSplit="1" ySplit="1" topLeftCell="B2"`,
+	} {
+		if !strings.Contains(sheet, want) {
+			t.Errorf("sheet1 missing %s", want)
+		}
+	}
+	if strings.Contains(sheet, `r="C2"`) {
+		t.Error("unstyled empty cells should be left out")
+	}
+
+	styles := files["xl/styles.xml"]
+	for _, want := range []string{`<cellXfs count="3">`, `<fgColor rgb="FF1F2937"/>`, `<color rgb="FFFFFFFF"/>`, `wrapText="1"`, `<fills count="4">`} {
+		if !strings.Contains(styles, want) {
+			t.Errorf("styles missing %s", want)
+		}
+	}
+	if !strings.Contains(files["xl/workbook.xml"], `<sheet name="Picks" sheetId="2" r:id="rId2"/>`) {
+		t.Errorf("workbook = %s", files["xl/workbook.xml"])
+	}
+}
+
+func TestAddSheetNames(t *testing.T) {
+	wb := New()
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"Board", "Board"},
+		{"board", "board (2)"},
+		{"Q1/Q2 [draft]: picks?", "Q1Q2 draft picks"},
+		{"A very long team name that Excel will not take", "A very long team name that Exce"},
+		{"A very long team name that Excel will not take either", "A very long team name that (2)"},
+		{" ", "Sheet"},
+	}
+	for _, tt := range tests {
+		if got := wb.AddSheet(tt.name).Name; got != tt.want {
+			t.Errorf("AddSheet(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestCellRef(t *testing.T) {
+	tests := []struct {
+		row, col int
+		want     string
+	}{
+		{0, 0, "A1"},
+		{9, 25, "Z10"},
+		{9, 27, "AB10"},
+		{0, 701, "ZZ1"},
+		{0, 702, "AAA1"},
+	}
+	for _, tt := range tests {
+		if got := CellRef(tt.row, tt.col); got != tt.want {
+			t.Errorf("CellRef(%d, %d) = %q, want %q", tt.row, tt.col, got, tt.want)
+		}
+	}
+}
+
+func TestWriteNoSheets(t *testing.T) {
+	if err := New().Write(io.Discard); err == nil {
+		t.Error("Write() with no sheets should fail")
+	}
+}